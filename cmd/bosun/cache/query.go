@@ -0,0 +1,327 @@
+package cache
+
+import (
+	"container/list"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"bosun.org/collect"
+	"bosun.org/metadata"
+	"bosun.org/opentsdb"
+
+	"github.com/golang/groupcache/singleflight"
+)
+
+func init() {
+	metadata.AddMetricMeta("bosun.query_cache.lookups", metadata.Counter, metadata.PerSecond,
+		"Lookups in the query cache by backend and result: hit if the whole range was cached, partial if only the tail was fetched, and miss.")
+	metadata.AddMetricMeta("bosun.query_cache.evictions", metadata.Counter, metadata.PerSecond,
+		"The number of query results evicted from the query cache to stay within its size limit.")
+	metadata.AddMetricMeta("bosun.query_cache.bytes", metadata.Gauge, metadata.Bytes,
+		"The estimated memory used by the query cache.")
+	metadata.AddMetricMeta("bosun.query_cache.entries", metadata.Gauge, metadata.Count,
+		"The number of query results in the query cache.")
+}
+
+// Ranged is the result of a query over a time range. The query cache trims it to
+// shorter ranges and merges it with the result of the same query over a later range.
+// Implementations must not modify the receiver.
+type Ranged interface {
+	// Trim returns the part of the result from start to end, inclusive.
+	Trim(start, end time.Time) Ranged
+	// Merge returns the result combined with tail, the result of the same query over a
+	// later range that may overlap. Points of tail take precedence over those of the
+	// result.
+	Merge(tail Ranged) Ranged
+	// Size returns the approximate memory used by the result in bytes.
+	Size() int64
+}
+
+// QueryCache caches the results of time series queries across check runs, unlike
+// Cache which lives for a single run. A query over a range that ends after that of
+// the cached result of the same query only fetches the missing tail, starting a
+// little before the cached end since the most recent points may have been incomplete.
+type QueryCache struct {
+	maxBytes int64
+	refetch  time.Duration
+	g        singleflight.Group
+
+	sync.Mutex
+	bytes   int64
+	lru     *list.List               // of *queryEntry, most recently used first
+	entries map[string]*list.Element // by backend and key
+	stats   map[string]*QueryCacheStats
+}
+
+type queryEntry struct {
+	backend    string
+	key        string
+	start, end time.Time
+	// span is the longest range requested for the query. Points before end-span
+	// are dropped.
+	span    time.Duration
+	size    int64
+	hits    int64
+	updated time.Time
+	value   Ranged
+}
+
+// QueryCacheStats counts the lookups in the query cache of one backend.
+type QueryCacheStats struct {
+	Hits      int64 // the whole range was cached
+	Partial   int64 // only the tail of the range was fetched
+	Misses    int64
+	Evictions int64
+}
+
+// QueryCacheEntry describes a cached query result.
+type QueryCacheEntry struct {
+	Backend string
+	Key     string
+	Start   time.Time
+	End     time.Time
+	Size    int64
+	Hits    int64
+	Updated time.Time
+}
+
+// QueryCacheStatus is a snapshot of the query cache.
+type QueryCacheStatus struct {
+	MaxBytes int64
+	Refetch  string
+	Bytes    int64
+	Stats    map[string]QueryCacheStats
+	Entries  []QueryCacheEntry // largest first
+}
+
+// NewQueryCache creates a query cache that holds results of up to maxBytes in total,
+// evicting the least recently used. The last refetch of a cached range is fetched
+// again with the missing tail. If maxBytes is not positive, nothing is cached.
+func NewQueryCache(maxBytes int64, refetch time.Duration) *QueryCache {
+	return &QueryCache{
+		maxBytes: maxBytes,
+		refetch:  refetch,
+		lru:      list.New(),
+		entries:  make(map[string]*list.Element),
+		stats:    make(map[string]*QueryCacheStats),
+	}
+}
+
+// Get returns the result of the query identified by backend and key from start to end.
+// The key must identify the query without its time range. fetch is called for the part
+// of the range that is not cached, which is all of it if the range starts before that
+// of the cached result.
+//
+// step is the interval of the buckets the backend aggregates the points in, such as
+// the downsample interval, or 0 for raw points. The missing tail is then fetched from
+// a bucket boundary, and its first bucket is kept from the cache, since it may be a
+// partial aggregate if the backend aligns buckets to the start of the query. A
+// negative step is for results that depend on the whole range, such as aggregates
+// over it, which are only reused for the same range.
+func (c *QueryCache) Get(backend, key string, start, end time.Time, step time.Duration, fetch func(start, end time.Time) (Ranged, error)) (Ranged, error) {
+	if c == nil || c.maxBytes <= 0 {
+		return fetch(start, end)
+	}
+	flight := fmt.Sprintf("%s\n%s\n%d\n%d", backend, key, start.UnixNano(), end.UnixNano())
+	v, err := c.g.Do(flight, func() (interface{}, error) {
+		c.Lock()
+		var cached *queryEntry
+		if el, ok := c.entries[backend+"\n"+key]; ok {
+			cached = el.Value.(*queryEntry)
+			c.lru.MoveToFront(el)
+		}
+		c.Unlock()
+		if cached != nil && step < 0 && start.Equal(cached.start) && end.Equal(cached.end) {
+			c.count(backend, "hit")
+			c.Lock()
+			cached.hits++
+			c.Unlock()
+			return cached.value, nil
+		}
+		// the first bucket has the time of its start, which may be before start
+		trimStart := start
+		if step > 0 {
+			trimStart = alignBucket(start, step)
+		}
+		if cached != nil && step >= 0 && !start.Before(cached.start) {
+			if !end.After(cached.end) {
+				c.count(backend, "hit")
+				c.Lock()
+				cached.hits++
+				c.Unlock()
+				return cached.value.Trim(trimStart, end), nil
+			}
+			from := cached.end.Add(-c.refetch)
+			if step > 0 {
+				from = alignBucket(from, step).Add(-step)
+			}
+			if from.After(start) && !from.Before(cached.start) {
+				tail, err := fetch(from, end)
+				if err != nil {
+					return nil, err
+				}
+				if step > 0 {
+					tail = tail.Trim(from.Add(step), end)
+				}
+				c.count(backend, "partial")
+				merged := cached.value.Merge(tail)
+				c.add(backend, key, cached.start, end, end.Sub(start), step, merged)
+				return merged.Trim(trimStart, end), nil
+			}
+		}
+		r, err := fetch(start, end)
+		if err != nil {
+			return nil, err
+		}
+		c.count(backend, "miss")
+		c.add(backend, key, start, end, end.Sub(start), step, r)
+		return r, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(Ranged), nil
+}
+
+// alignBucket returns the start of the bucket of t, with buckets of length step from
+// the unix epoch.
+func alignBucket(t time.Time, step time.Duration) time.Time {
+	ns := t.UnixNano()
+	ns -= ns % int64(step)
+	if ns > t.UnixNano() {
+		ns -= int64(step)
+	}
+	return time.Unix(0, ns).In(t.Location())
+}
+
+func (c *QueryCache) count(backend, result string) {
+	collect.Add("query_cache.lookups", opentsdb.TagSet{"backend": backend, "result": result}, 1)
+	c.Lock()
+	defer c.Unlock()
+	s := c.backendStats(backend)
+	switch result {
+	case "hit":
+		s.Hits++
+	case "partial":
+		s.Partial++
+	default:
+		s.Misses++
+	}
+}
+
+func (c *QueryCache) backendStats(backend string) *QueryCacheStats {
+	s := c.stats[backend]
+	if s == nil {
+		s = &QueryCacheStats{}
+		c.stats[backend] = s
+	}
+	return s
+}
+
+// add stores the result of a query from start to end. A result that ends before the
+// cached one is not stored, i.e. of an expression evaluated for a past time. Results
+// of a negative step are never trimmed.
+func (c *QueryCache) add(backend, key string, start, end time.Time, span, step time.Duration, v Ranged) {
+	c.Lock()
+	defer c.Unlock()
+	if el, ok := c.entries[backend+"\n"+key]; ok {
+		old := el.Value.(*queryEntry)
+		if end.Before(old.end) {
+			return
+		}
+		if old.span > span && step >= 0 {
+			span = old.span
+		}
+		c.remove(el)
+	}
+	s := end.Add(-span)
+	if step > 0 {
+		s = alignBucket(s, step)
+	}
+	if s.After(start) {
+		v = v.Trim(s, end)
+		start = s
+	}
+	e := &queryEntry{
+		backend: backend,
+		key:     key,
+		start:   start,
+		end:     end,
+		span:    span,
+		size:    v.Size() + int64(len(key)),
+		updated: time.Now().UTC(),
+		value:   v,
+	}
+	if e.size > c.maxBytes {
+		return
+	}
+	c.entries[backend+"\n"+key] = c.lru.PushFront(e)
+	c.bytes += e.size
+	for c.bytes > c.maxBytes {
+		oldest := c.lru.Back()
+		b := oldest.Value.(*queryEntry).backend
+		c.remove(oldest)
+		c.backendStats(b).Evictions++
+		collect.Add("query_cache.evictions", opentsdb.TagSet{"backend": b}, 1)
+	}
+	collect.Put("query_cache.bytes", nil, c.bytes)
+	collect.Put("query_cache.entries", nil, c.lru.Len())
+}
+
+func (c *QueryCache) remove(el *list.Element) {
+	e := c.lru.Remove(el).(*queryEntry)
+	delete(c.entries, e.backend+"\n"+e.key)
+	c.bytes -= e.size
+}
+
+// Flush drops all cached results and returns how many there were.
+func (c *QueryCache) Flush() int {
+	if c == nil {
+		return 0
+	}
+	c.Lock()
+	defer c.Unlock()
+	n := c.lru.Len()
+	c.lru.Init()
+	c.entries = make(map[string]*list.Element)
+	c.bytes = 0
+	collect.Put("query_cache.bytes", nil, c.bytes)
+	collect.Put("query_cache.entries", nil, 0)
+	return n
+}
+
+// Status returns the size, statistics and entries of the cache.
+func (c *QueryCache) Status() *QueryCacheStatus {
+	s := &QueryCacheStatus{
+		Stats: make(map[string]QueryCacheStats),
+	}
+	if c == nil {
+		return s
+	}
+	c.Lock()
+	defer c.Unlock()
+	s.MaxBytes = c.maxBytes
+	s.Refetch = c.refetch.String()
+	s.Bytes = c.bytes
+	for b, st := range c.stats {
+		s.Stats[b] = *st
+	}
+	for el := c.lru.Front(); el != nil; el = el.Next() {
+		e := el.Value.(*queryEntry)
+		s.Entries = append(s.Entries, QueryCacheEntry{
+			Backend: e.backend,
+			Key:     e.key,
+			Start:   e.start,
+			End:     e.end,
+			Size:    e.size,
+			Hits:    e.hits,
+			Updated: e.updated,
+		})
+	}
+	sort.SliceStable(s.Entries, func(i, j int) bool {
+		return s.Entries[i].Size > s.Entries[j].Size
+	})
+	return s
+}
@@ -18,6 +18,7 @@ import (
 	"bosun.org/graphite"
 	"bosun.org/models"
 	"bosun.org/opentsdb"
+	"bosun.org/prom"
 
 	htemplate "html/template"
 	ttemplate "text/template"
@@ -47,6 +48,8 @@ type SystemConfProvider interface {
 	GetRedisHost() string
 	GetRedisDb() int
 	GetRedisPassword() string
+	GetSQLDriver() string
+	GetSQLDataSource() string
 	GetTimeAndDate() []int
 	GetSearchSince() time.Duration
 
@@ -55,6 +58,20 @@ type SystemConfProvider interface {
 	GetUnknownThreshold() int
 	GetMinGroupSize() int
 
+	GetNotificationRetryLimit() int
+	GetNotificationRetryDelay() time.Duration
+	GetNotificationRetention() time.Duration
+
+	GetQueryCacheSize() int64
+	GetQueryCacheRefetch() time.Duration
+
+	GetSnapshotRetention() int
+
+	GetLeaderTimeout() time.Duration
+	GetShardTimeout() time.Duration
+	GetAuditRetention() time.Duration
+	GetAuditSyslog() bool
+
 	GetShortURLKey() string
 	GetInternetProxy() string
 
@@ -78,6 +95,7 @@ type SystemConfProvider interface {
 	GetInfluxContext() client.HTTPConfig
 	GetLogstashContext() expr.LogstashElasticHosts
 	GetElasticContext() expr.ElasticHosts
+	GetPromContext() prom.Context
 	AnnotateEnabled() bool
 
 	MakeLink(string, *url.Values) string
@@ -94,6 +112,45 @@ func ValidateSystemConf(sc SystemConfProvider) error {
 	if sc.GetDefaultRunEvery() <= 0 {
 		return fmt.Errorf("default run every must be greater than 0, is %v", sc.GetDefaultRunEvery())
 	}
+	if sc.GetNotificationRetryLimit() < 0 {
+		return fmt.Errorf("notification retry limit must not be negative, is %v", sc.GetNotificationRetryLimit())
+	}
+	if sc.GetNotificationRetention() < 0 {
+		return fmt.Errorf("notification retention must not be negative, is %v", sc.GetNotificationRetention())
+	}
+	if sc.GetQueryCacheSize() < 0 {
+		return fmt.Errorf("query cache size must not be negative, is %v", sc.GetQueryCacheSize())
+	}
+	if sc.GetQueryCacheRefetch() < 0 {
+		return fmt.Errorf("query cache refetch must not be negative, is %v", sc.GetQueryCacheRefetch())
+	}
+	if sc.GetSnapshotRetention() < 0 {
+		return fmt.Errorf("snapshot retention must not be negative, is %v", sc.GetSnapshotRetention())
+	}
+	if sc.GetLeaderTimeout() < 0 {
+		return fmt.Errorf("leader timeout must not be negative, is %v", sc.GetLeaderTimeout())
+	}
+	if sc.GetLeaderTimeout() > 0 && sc.GetRedisHost() == "" && sc.GetSQLDriver() == "" {
+		return fmt.Errorf("leader election requires RedisHost or SQLDriver, since the embedded ledis can't be shared")
+	}
+	if sc.GetShardTimeout() < 0 {
+		return fmt.Errorf("shard timeout must not be negative, is %v", sc.GetShardTimeout())
+	}
+	if sc.GetShardTimeout() > 0 && sc.GetRedisHost() == "" && sc.GetSQLDriver() == "" {
+		return fmt.Errorf("sharding requires RedisHost or SQLDriver, since the embedded ledis can't be shared")
+	}
+	if sc.GetShardTimeout() > 0 && sc.GetLeaderTimeout() > 0 {
+		return fmt.Errorf("only one of LeaderTimeout and ShardTimeout may be set")
+	}
+	if sc.GetAuditRetention() < 0 {
+		return fmt.Errorf("audit retention must not be negative, is %v", sc.GetAuditRetention())
+	}
+	if sc.GetSQLDriver() != "" && sc.GetRedisHost() != "" {
+		return fmt.Errorf("only one of RedisHost and SQLDriver may be set")
+	}
+	if sc.GetSQLDriver() != "" && sc.GetSQLDataSource() == "" {
+		return fmt.Errorf("must specify SQLDataSource if SQLDriver is specified")
+	}
 	if sc.GetHTTPSListen() != "" && (sc.GetTLSCertFile() == "" || sc.GetTLSKeyFile() == "") {
 		return fmt.Errorf("must specify TLSCertFile and TLSKeyFile if HTTPSListen is specified")
 	}
@@ -210,6 +267,7 @@ type Notification struct {
 	Text string
 	Vars
 	Name         string
+	Type         string `json:",omitempty"`
 	Email        []*mail.Address
 	Post, Get    *url.URL
 	Body         *ttemplate.Template
@@ -220,6 +278,11 @@ type Notification struct {
 	RunOnActions bool
 	UseBody      bool
 
+	// Notifiers are the transports the notification is delivered through. When Type is
+	// empty there is one for each of email, post, get and print that is set.
+	Notifiers []Notifier        `json:"-"`
+	Params    map[string]string `json:"-"`
+
 	NextName        string `json:"-"`
 	RawEmail        string `json:"-"`
 	RawPost, RawGet string `json:"-"`
@@ -355,6 +418,8 @@ type Alert struct {
 	WarnNotification *Notifications
 	Unknown          time.Duration
 	MaxLogFrequency  time.Duration
+	FlapThreshold    int           `json:",omitempty"`
+	FlapWindow       time.Duration `json:",omitempty"`
 	IgnoreUnknown    bool
 	UnknownsNormal   bool
 	UnjoinedOK       bool `json:",omitempty"`
@@ -362,12 +427,79 @@ type Alert struct {
 	RunEvery         int
 	ReturnType       models.FuncType
 
+	// DependsOn are the alerts this alert depends on, declared with dependsOn.
+	DependsOn []*AlertDependency `json:",omitempty"`
+
 	TemplateName string   `json:"-"`
 	RawSquelch   []string `json:"-"`
 
 	Locator `json:"-"`
 }
 
+// AlertDependency is a dependency on another alert. While the parent alert is critical
+// or unevaluated for a group, the alert keys of the dependent alert with the same values
+// for the mapped tags are marked unevaluated.
+type AlertDependency struct {
+	Alert string
+	// Tags maps tag keys of the dependent alert to tag keys of the parent alert. If no
+	// tags are mapped every alert key of the parent suppresses all dependent alert keys.
+	Tags map[string]string `json:",omitempty"`
+}
+
+// Group returns the tags of a dependent alert key that correspond to the group of a
+// parent alert key. ok is false if the parent group lacks a mapped tag. An empty parent
+// group maps to an empty group, which matches every alert key of the dependent alert.
+func (d *AlertDependency) Group(parent opentsdb.TagSet) (group opentsdb.TagSet, ok bool) {
+	group = make(opentsdb.TagSet, len(d.Tags))
+	if len(parent) == 0 {
+		return group, true
+	}
+	for child, p := range d.Tags {
+		v, found := parent[p]
+		if !found {
+			return nil, false
+		}
+		group[child] = v
+	}
+	return group, true
+}
+
+// An AlertTest is a unit test of an alert, declared with a test section. The
+// expressions of the alert are evaluated against fixture series instead of the
+// backends, and the statuses of its alert keys compared with the expected ones.
+type AlertTest struct {
+	Text   string
+	Name   string
+	Alert  string
+	Start  time.Time // the time offsets of the series and expectations are from
+	Data   string    // JSON file of fixture series, relative to the rule file
+	Series []*TestSeries
+	Expect []*TestExpect
+
+	Locator `json:"-"`
+}
+
+// TestSeries is a fixture series of an alert test.
+type TestSeries struct {
+	Backend string // opentsdb or graphite
+	// Metric and Tags identify an OpenTSDB series.
+	Metric string          `json:",omitempty"`
+	Tags   opentsdb.TagSet `json:",omitempty"`
+	// Target is the name of a Graphite series. It is returned for Query, or if
+	// Query is empty for the targets that match it.
+	Target string                    `json:",omitempty"`
+	Query  string                    `json:",omitempty"`
+	Points map[time.Duration]float64 // by offset from the start of the test
+}
+
+// TestExpect is the expected status of an alert key at an offset from the start
+// of an alert test.
+type TestExpect struct {
+	Offset   time.Duration
+	AlertKey models.AlertKey
+	Status   models.Status
+}
+
 // A Locator stores the information about the location of the rule in the underlying
 // rule store
 type Locator interface{}
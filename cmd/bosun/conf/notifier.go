@@ -0,0 +1,79 @@
+package conf
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"bosun.org/models"
+)
+
+// Notifier is a transport that a Notification delivers through. Each Notification
+// holds one Notifier for every transport it uses, created when the rule configuration
+// is loaded.
+type Notifier interface {
+	// Type returns the name the notifier was registered under, i.e. "email".
+	Type() string
+	// Target returns a human readable description of where the notifier delivers to.
+	Target() string
+	// Send delivers the payload and returns an error if delivery failed.
+	Send(p *NotificationPayload, c SystemConfProvider) error
+}
+
+// NotificationPayload is the rendered content of a notification that is passed
+// to each Notifier.
+type NotificationPayload struct {
+	AlertKey     string
+	Subject      string
+	Body         string
+	EmailSubject []byte
+	EmailBody    []byte
+	Attachments  []*models.Attachment
+}
+
+// NotifierFactory creates a Notifier for a notification. params holds the keys of the
+// notification section that are not general notification keywords. The factory should
+// return an error if a required parameter is missing or a parameter is not understood,
+// which will fail loading of the rule configuration.
+type NotifierFactory func(n *Notification, params map[string]string) (Notifier, error)
+
+var notifierFactories = struct {
+	sync.RWMutex
+	m map[string]NotifierFactory
+}{m: make(map[string]NotifierFactory)}
+
+// RegisterNotifier makes a notifier type available to the `type` keyword of notification
+// definitions. It panics if the type is registered twice, and is expected to be called
+// from an init function.
+func RegisterNotifier(typ string, f NotifierFactory) {
+	notifierFactories.Lock()
+	defer notifierFactories.Unlock()
+	if _, ok := notifierFactories.m[typ]; ok {
+		panic(fmt.Sprintf("conf: notifier type %s registered twice", typ))
+	}
+	notifierFactories.m[typ] = f
+}
+
+// NewNotifier creates a notifier of the registered type typ for the notification.
+func NewNotifier(typ string, n *Notification, params map[string]string) (Notifier, error) {
+	notifierFactories.RLock()
+	f, ok := notifierFactories.m[typ]
+	notifierFactories.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown notification type %s, must be one of %s", typ, strings.Join(NotifierTypes(), ", "))
+	}
+	return f(n, params)
+}
+
+// NotifierTypes returns the sorted names of all registered notifier types.
+func NotifierTypes() []string {
+	notifierFactories.RLock()
+	defer notifierFactories.RUnlock()
+	types := make([]string, 0, len(notifierFactories.m))
+	for t := range notifierFactories.m {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
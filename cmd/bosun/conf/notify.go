@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"crypto/tls"
 	"errors"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
@@ -26,26 +27,28 @@ func init() {
 	metadata.AddMetricMeta(
 		"bosun.email.sent_failed", metadata.Counter, metadata.PerSecond,
 		"The number of email notifications that Bosun failed to send.")
-}
 
-// Notify triggers Email/HTTP/Print actions for the Notification object
-func (n *Notification) Notify(subject, body string, emailsubject, emailbody []byte, c SystemConfProvider, ak string, attachments ...*models.Attachment) {
-	if len(n.Email) > 0 {
-		go n.DoEmail(emailsubject, emailbody, c, ak, attachments...)
-	}
-	if n.Post != nil {
-		go n.DoPost(n.GetPayload(subject, body), ak)
-	}
-	if n.Get != nil {
-		go n.DoGet(ak)
-	}
-	if n.Print {
-		if n.UseBody {
-			go n.DoPrint("Subject: " + subject + ", Body: " + body)
-		} else {
-			go n.DoPrint(subject)
+	RegisterNotifier("email", func(n *Notification, params map[string]string) (Notifier, error) {
+		if len(n.Email) == 0 {
+			return nil, fmt.Errorf("email notification requires email")
 		}
-	}
+		return emailNotifier{n}, nil
+	})
+	RegisterNotifier("post", func(n *Notification, params map[string]string) (Notifier, error) {
+		if n.Post == nil {
+			return nil, fmt.Errorf("post notification requires post")
+		}
+		return postNotifier{n}, nil
+	})
+	RegisterNotifier("get", func(n *Notification, params map[string]string) (Notifier, error) {
+		if n.Get == nil {
+			return nil, fmt.Errorf("get notification requires get")
+		}
+		return getNotifier{n}, nil
+	})
+	RegisterNotifier("print", func(n *Notification, params map[string]string) (Notifier, error) {
+		return printNotifier{n}, nil
+	})
 }
 
 func (n *Notification) GetPayload(subject, body string) (payload []byte) {
@@ -56,16 +59,62 @@ func (n *Notification) GetPayload(subject, body string) (payload []byte) {
 	}
 }
 
-func (n *Notification) DoPrint(payload string) {
+type emailNotifier struct{ n *Notification }
+
+func (e emailNotifier) Type() string { return "email" }
+
+func (e emailNotifier) Target() string {
+	to := make([]string, len(e.n.Email))
+	for i, a := range e.n.Email {
+		to[i] = a.Address
+	}
+	return strings.Join(to, ",")
+}
+
+func (e emailNotifier) Send(p *NotificationPayload, c SystemConfProvider) error {
+	return e.n.DoEmail(p.EmailSubject, p.EmailBody, c, p.AlertKey, p.Attachments...)
+}
+
+type postNotifier struct{ n *Notification }
+
+func (pn postNotifier) Type() string   { return "post" }
+func (pn postNotifier) Target() string { return pn.n.Post.String() }
+
+func (pn postNotifier) Send(p *NotificationPayload, c SystemConfProvider) error {
+	return pn.n.DoPost(pn.n.GetPayload(p.Subject, p.Body), p.AlertKey)
+}
+
+type getNotifier struct{ n *Notification }
+
+func (g getNotifier) Type() string   { return "get" }
+func (g getNotifier) Target() string { return g.n.Get.String() }
+
+func (g getNotifier) Send(p *NotificationPayload, c SystemConfProvider) error {
+	return g.n.DoGet(p.AlertKey)
+}
+
+type printNotifier struct{ n *Notification }
+
+func (pn printNotifier) Type() string   { return "print" }
+func (pn printNotifier) Target() string { return "stdout" }
+
+func (pn printNotifier) Send(p *NotificationPayload, c SystemConfProvider) error {
+	if pn.n.UseBody {
+		return pn.n.DoPrint("Subject: " + p.Subject + ", Body: " + p.Body)
+	}
+	return pn.n.DoPrint(p.Subject)
+}
+
+func (n *Notification) DoPrint(payload string) error {
 	slog.Infoln(payload)
+	return nil
 }
 
-func (n *Notification) DoPost(payload []byte, ak string) {
+func (n *Notification) DoPost(payload []byte, ak string) error {
 	if n.Body != nil {
 		buf := new(bytes.Buffer)
 		if err := n.Body.Execute(buf, string(payload)); err != nil {
-			slog.Errorln(err)
-			return
+			return err
 		}
 		payload = buf.Bytes()
 	}
@@ -76,30 +125,29 @@ func (n *Notification) DoPost(payload []byte, ak string) {
 		defer resp.Body.Close()
 	}
 	if err != nil {
-		slog.Error(err)
-		return
+		return err
 	}
 	if resp.StatusCode >= 300 {
-		slog.Errorln("bad response on notification post:", resp.Status)
-	} else {
-		slog.Infof("post notification successful for alert %s. Response code %d.", ak, resp.StatusCode)
+		return fmt.Errorf("bad response on notification post: %s", resp.Status)
 	}
+	slog.Infof("post notification successful for alert %s. Response code %d.", ak, resp.StatusCode)
+	return nil
 }
 
-func (n *Notification) DoGet(ak string) {
+func (n *Notification) DoGet(ak string) error {
 	resp, err := http.Get(n.Get.String())
 	if err != nil {
-		slog.Error(err)
-		return
+		return err
 	}
+	defer resp.Body.Close()
 	if resp.StatusCode >= 300 {
-		slog.Error("bad response on notification get:", resp.Status)
-	} else {
-		slog.Infof("get notification successful for alert %s. Response code %d.", ak, resp.StatusCode)
+		return fmt.Errorf("bad response on notification get: %s", resp.Status)
 	}
+	slog.Infof("get notification successful for alert %s. Response code %d.", ak, resp.StatusCode)
+	return nil
 }
 
-func (n *Notification) DoEmail(subject, body []byte, c SystemConfProvider, ak string, attachments ...*models.Attachment) {
+func (n *Notification) DoEmail(subject, body []byte, c SystemConfProvider, ak string, attachments ...*models.Attachment) error {
 	e := email.NewEmail()
 	e.From = c.GetEmailFrom()
 	for _, a := range n.Email {
@@ -113,11 +161,11 @@ func (n *Notification) DoEmail(subject, body []byte, c SystemConfProvider, ak st
 	e.Headers.Add("X-Bosun-Server", util.Hostname)
 	if err := Send(e, c.GetSMTPHost(), c.GetSMTPUsername(), c.GetSMTPPassword()); err != nil {
 		collect.Add("email.sent_failed", nil, 1)
-		slog.Errorf("failed to send alert %v to %v %v\n", ak, e.To, err)
-		return
+		return err
 	}
 	collect.Add("email.sent", nil, 1)
 	slog.Infof("relayed alert %v to %v sucessfully. Subject: %d bytes. Body: %d bytes.", ak, e.To, len(subject), len(body))
+	return nil
 }
 
 // Send an email using the given host and SMTP auth (optional), returns any
@@ -0,0 +1,550 @@
+package rule
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"bosun.org/cmd/bosun/cache"
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/conf/rule/parse"
+	"bosun.org/cmd/bosun/expr"
+	"bosun.org/graphite"
+	"bosun.org/models"
+	"bosun.org/opentsdb"
+
+	"github.com/influxdata/influxdb/client/v2"
+)
+
+func (c *Conf) loadTest(s *parse.SectionNode) {
+	name := s.Name.Text
+	if _, ok := c.Tests[name]; ok {
+		c.errorf("duplicate test name: %s", name)
+	}
+	t := conf.AlertTest{
+		Name:  name,
+		Start: time.Unix(0, 0).UTC(),
+	}
+	t.Text = s.RawText
+	t.Locator = newSectionLocator(s)
+	for _, p := range c.getPairs(s, nil, sNormal) {
+		c.at(p.node)
+		v := p.val
+		switch p.key {
+		case "alert":
+			if _, ok := c.Alerts[v]; !ok {
+				c.errorf("unknown alert %s", v)
+			}
+			t.Alert = v
+		case "start":
+			start, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				c.error(err)
+			}
+			t.Start = start.UTC()
+		case "data":
+			t.Data = v
+		case "series", "graphite":
+			ts, err := parseTestSeries(p.key, v)
+			if err != nil {
+				c.error(err)
+			}
+			t.Series = append(t.Series, ts)
+		case "expect":
+			if t.Alert == "" {
+				c.errorf("expect must follow alert")
+			}
+			te, err := parseTestExpect(t.Alert, v)
+			if err != nil {
+				c.error(err)
+			}
+			t.Expect = append(t.Expect, te)
+		default:
+			c.errorf("unknown key %s", p.key)
+		}
+	}
+	c.at(s)
+	if t.Alert == "" {
+		c.errorf("missing alert in test %s", name)
+	}
+	if len(t.Expect) == 0 {
+		c.errorf("missing expect in test %s", name)
+	}
+	c.Tests[name] = &t
+}
+
+// parseTestSeries parses an inline fixture series of the form
+// "metric{tags} offset:value ..." for OpenTSDB (key series) or
+// "target offset:value ..." for Graphite (key graphite).
+func parseTestSeries(key, v string) (*conf.TestSeries, error) {
+	fields := strings.Fields(v)
+	if len(fields) < 2 {
+		return nil, fmt.Errorf("%s: expected a series followed by offset:value points", key)
+	}
+	ts := &conf.TestSeries{
+		Points: make(map[time.Duration]float64),
+	}
+	if key == "graphite" {
+		ts.Backend = "graphite"
+		ts.Target = fields[0]
+	} else {
+		ts.Backend = "opentsdb"
+		ts.Metric = fields[0]
+		ts.Tags = make(opentsdb.TagSet)
+		if i := strings.Index(fields[0], "{"); i >= 0 {
+			if !strings.HasSuffix(fields[0], "}") {
+				return nil, fmt.Errorf("series: bad tags in %s", fields[0])
+			}
+			ts.Metric = fields[0][:i]
+			if tags := fields[0][i+1 : len(fields[0])-1]; tags != "" {
+				var err error
+				if ts.Tags, err = opentsdb.ParseTags(tags); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	for _, f := range fields[1:] {
+		sp := strings.SplitN(f, ":", 2)
+		if len(sp) != 2 {
+			return nil, fmt.Errorf("%s: expected offset:value, got %s", key, f)
+		}
+		offset, err := opentsdb.ParseDuration(sp[0])
+		if err != nil {
+			return nil, err
+		}
+		value, err := strconv.ParseFloat(sp[1], 64)
+		if err != nil {
+			return nil, err
+		}
+		ts.Points[time.Duration(offset)] = value
+	}
+	return ts, nil
+}
+
+// parseTestExpect parses an expectation of the form "offset {tags} status".
+func parseTestExpect(alert, v string) (*conf.TestExpect, error) {
+	fields := strings.Fields(v)
+	if len(fields) != 3 || !strings.HasPrefix(fields[1], "{") || !strings.HasSuffix(fields[1], "}") {
+		return nil, fmt.Errorf("expect: expected offset {tags} status, got %s", v)
+	}
+	offset, err := opentsdb.ParseDuration(fields[0])
+	if err != nil {
+		return nil, err
+	}
+	tags := make(opentsdb.TagSet)
+	if t := strings.Trim(fields[1], "{}"); t != "" {
+		if tags, err = opentsdb.ParseTags(t); err != nil {
+			return nil, err
+		}
+	}
+	te := &conf.TestExpect{
+		Offset:   time.Duration(offset),
+		AlertKey: models.NewAlertKey(alert, tags),
+	}
+	switch fields[2] {
+	case "normal":
+		te.Status = models.StNormal
+	case "warning":
+		te.Status = models.StWarning
+	case "critical":
+		te.Status = models.StCritical
+	case "unknown":
+		te.Status = models.StUnknown
+	default:
+		return nil, fmt.Errorf("expect: unknown status %s, must be normal, warning, critical or unknown", fields[2])
+	}
+	return te, nil
+}
+
+// testData is the format of the data file of an alert test. Points are keyed by
+// their offset from the start of the test, such as "90s" or "5m".
+type testData struct {
+	OpenTSDB []struct {
+		Metric string
+		Tags   opentsdb.TagSet
+		Points map[string]float64
+	}
+	Graphite []struct {
+		Query  string
+		Target string
+		Points map[string]float64
+	}
+}
+
+// testSeries returns the inline fixture series of t and those of its data file.
+func (c *Conf) testSeries(t *conf.AlertTest) ([]*conf.TestSeries, error) {
+	series := t.Series
+	if t.Data == "" {
+		return series, nil
+	}
+	name := t.Data
+	if !filepath.IsAbs(name) {
+		name = filepath.Join(filepath.Dir(c.Name), name)
+	}
+	b, err := ioutil.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	var data testData
+	if err := json.Unmarshal(b, &data); err != nil {
+		return nil, fmt.Errorf("%s: %v", name, err)
+	}
+	points := func(m map[string]float64) (map[time.Duration]float64, error) {
+		p := make(map[time.Duration]float64, len(m))
+		for k, v := range m {
+			d, err := opentsdb.ParseDuration(k)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %v", name, err)
+			}
+			p[time.Duration(d)] = v
+		}
+		return p, nil
+	}
+	for _, s := range data.OpenTSDB {
+		p, err := points(s.Points)
+		if err != nil {
+			return nil, err
+		}
+		series = append(series, &conf.TestSeries{Backend: "opentsdb", Metric: s.Metric, Tags: s.Tags, Points: p})
+	}
+	for _, s := range data.Graphite {
+		p, err := points(s.Points)
+		if err != nil {
+			return nil, err
+		}
+		series = append(series, &conf.TestSeries{Backend: "graphite", Query: s.Query, Target: s.Target, Points: p})
+	}
+	return series, nil
+}
+
+// AlertTestResult is the outcome of an alert test. The test passed if it has
+// no error and no failures.
+type AlertTestResult struct {
+	Name     string
+	Failures []string `json:",omitempty"`
+	Error    string   `json:",omitempty"`
+}
+
+// RunTests runs the alert tests of the rule file ordered by name.
+func (c *Conf) RunTests() []*AlertTestResult {
+	var names []string
+	for name := range c.Tests {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var results []*AlertTestResult
+	for _, name := range names {
+		r := &AlertTestResult{Name: name}
+		failures, err := c.RunTest(c.Tests[name])
+		if err != nil {
+			r.Error = err.Error()
+		}
+		r.Failures = failures
+		results = append(results, r)
+	}
+	return results
+}
+
+// RunTest evaluates the alert of t at the offset of each expectation against
+// its fixture series, and returns the expectations that were not met.
+// Dependencies, unknown detection and flapping need the history kept by the
+// scheduler and are not evaluated. An alert key without a result is unknown.
+func (c *Conf) RunTest(t *conf.AlertTest) (failures []string, err error) {
+	a := c.Alerts[t.Alert]
+	series, err := c.testSeries(t)
+	if err != nil {
+		return nil, err
+	}
+	backends := &expr.Backends{
+		TSDBContext:     &testTSDB{start: t.Start, series: series},
+		GraphiteContext: &testGraphite{start: t.Start, series: series},
+		InfluxConfig:    client.HTTPConfig{},
+	}
+	byOffset := make(map[time.Duration][]*conf.TestExpect)
+	var offsets []time.Duration
+	for _, te := range t.Expect {
+		if _, ok := byOffset[te.Offset]; !ok {
+			offsets = append(offsets, te.Offset)
+		}
+		byOffset[te.Offset] = append(byOffset[te.Offset], te)
+	}
+	sort.Slice(offsets, func(i, j int) bool { return offsets[i] < offsets[j] })
+	for _, offset := range offsets {
+		statuses, err := c.testStatuses(a, backends, t.Start.Add(offset))
+		if err != nil {
+			return failures, fmt.Errorf("at %v: %v", offset, err)
+		}
+		for _, te := range byOffset[offset] {
+			got, ok := statuses[te.AlertKey]
+			if !ok {
+				got = models.StUnknown
+			}
+			if got != te.Status {
+				failures = append(failures, fmt.Sprintf("%s at %v: expected %v, got %v", te.AlertKey, offset, te.Status, got))
+			}
+		}
+	}
+	return failures, nil
+}
+
+// testStatuses evaluates the crit and warn expressions of a at now as the
+// scheduler does, and returns the status of each alert key.
+func (c *Conf) testStatuses(a *conf.Alert, backends *expr.Backends, now time.Time) (map[models.AlertKey]models.Status, error) {
+	statuses := make(map[models.AlertKey]models.Status)
+	providers := &expr.BosunProviders{
+		Cache:     cache.New(0),
+		Squelched: c.AlertSquelched(a),
+	}
+	check := func(e *expr.Expr, checkStatus models.Status) error {
+		if e == nil {
+			return nil
+		}
+		results, _, err := e.Execute(backends, providers, nil, now, 0, a.UnjoinedOK)
+		if err != nil {
+			return err
+		}
+		for _, r := range results.Results {
+			if c.Squelched(a, r.Group) {
+				continue
+			}
+			var n float64
+			switch v := r.Value.(type) {
+			case expr.Number:
+				n = float64(v)
+			case expr.Scalar:
+				n = float64(v)
+			default:
+				return fmt.Errorf("expected number or scalar")
+			}
+			ak := models.NewAlertKey(a.Name, r.Group)
+			status := checkStatus
+			if n == 0 && !math.IsNaN(n) {
+				status = models.StNormal
+			}
+			if status > statuses[ak] {
+				statuses[ak] = status
+			}
+		}
+		return nil
+	}
+	if err := check(a.Crit, models.StCritical); err != nil {
+		return nil, err
+	}
+	if err := check(a.Warn, models.StWarning); err != nil {
+		return nil, err
+	}
+	return statuses, nil
+}
+
+// testTSDB is an opentsdb.Context that answers queries with the fixture series
+// of an alert test that match the metric and tag filters of the query. Fixture
+// series are returned as they are, without rate or downsampling, and those with
+// the same group are combined by the aggregator of the query.
+type testTSDB struct {
+	start  time.Time
+	series []*conf.TestSeries
+}
+
+func (t *testTSDB) Version() opentsdb.Version {
+	return opentsdb.Version2_2
+}
+
+func (t *testTSDB) Query(req *opentsdb.Request) (opentsdb.ResponseSet, error) {
+	start, err := opentsdb.ParseTime(req.Start)
+	if err != nil {
+		return nil, err
+	}
+	end := time.Now().UTC()
+	if req.End != nil {
+		if end, err = opentsdb.ParseTime(req.End); err != nil {
+			return nil, err
+		}
+	}
+	var rs opentsdb.ResponseSet
+	for _, q := range req.Queries {
+		type group struct {
+			tags   opentsdb.TagSet
+			values map[int64][]float64
+		}
+		groups := make(map[string]*group)
+		var keys []string
+	Series:
+		for _, s := range t.series {
+			if s.Backend != "opentsdb" || s.Metric != q.Metric {
+				continue
+			}
+			tags := make(opentsdb.TagSet)
+			for _, f := range q.Filters {
+				v, ok := s.Tags[f.TagK]
+				if !ok {
+					continue Series
+				}
+				match, err := filterMatch(f, v)
+				if err != nil {
+					return nil, err
+				}
+				if !match {
+					continue Series
+				}
+				if f.GroupBy {
+					tags[f.TagK] = v
+				}
+			}
+			g := groups[tags.String()]
+			if g == nil {
+				g = &group{tags: tags, values: make(map[int64][]float64)}
+				groups[tags.String()] = g
+				keys = append(keys, tags.String())
+			}
+			for offset, v := range s.Points {
+				ts := t.start.Add(offset)
+				if ts.Before(start) || ts.After(end) {
+					continue
+				}
+				g.values[ts.Unix()] = append(g.values[ts.Unix()], v)
+			}
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			g := groups[k]
+			r := &opentsdb.Response{
+				Metric: q.Metric,
+				Tags:   g.tags,
+				DPS:    make(map[string]opentsdb.Point, len(g.values)),
+			}
+			for ts, x := range g.values {
+				v, err := aggregatePoints(q.Aggregator, x)
+				if err != nil {
+					return nil, err
+				}
+				r.DPS[strconv.FormatInt(ts, 10)] = opentsdb.Point(v)
+			}
+			rs = append(rs, r)
+		}
+	}
+	return rs, nil
+}
+
+// filterMatch reports whether the tag value v matches the filter f.
+func filterMatch(f opentsdb.Filter, v string) (bool, error) {
+	literal := func(fold bool) bool {
+		for _, l := range strings.Split(f.Filter, "|") {
+			if l == v || fold && strings.EqualFold(l, v) {
+				return true
+			}
+		}
+		return false
+	}
+	switch f.Type {
+	case "literal_or":
+		return literal(false), nil
+	case "iliteral_or":
+		return literal(true), nil
+	case "not_literal_or":
+		return !literal(false), nil
+	case "not_iliteral_or":
+		return !literal(true), nil
+	case "wildcard", "iwildcard":
+		re := "^" + strings.Replace(regexp.QuoteMeta(f.Filter), `\*`, ".*", -1) + "$"
+		if f.Type == "iwildcard" {
+			re = "(?i)" + re
+		}
+		return regexp.MatchString(re, v)
+	case "regexp":
+		return regexp.MatchString(f.Filter, v)
+	}
+	return false, fmt.Errorf("test: unsupported filter type %s", f.Type)
+}
+
+// aggregatePoints combines the values of fixture series of the same group at a
+// timestamp.
+func aggregatePoints(aggregator string, x []float64) (float64, error) {
+	if len(x) == 1 {
+		return x[0], nil
+	}
+	v := x[0]
+	switch aggregator {
+	case "sum", "zimsum", "avg":
+		for _, f := range x[1:] {
+			v += f
+		}
+		if aggregator == "avg" {
+			v /= float64(len(x))
+		}
+	case "min", "mimmin":
+		for _, f := range x[1:] {
+			v = math.Min(v, f)
+		}
+	case "max", "mimmax":
+		for _, f := range x[1:] {
+			v = math.Max(v, f)
+		}
+	case "count":
+		v = float64(len(x))
+	default:
+		return 0, fmt.Errorf("test: can not combine fixture series with aggregator %s", aggregator)
+	}
+	return v, nil
+}
+
+// testGraphite is a graphite.Context that answers queries with the fixture
+// series of an alert test. A series with a query is returned for that target, and
+// one without for the targets whose dot separated glob patterns match its name.
+type testGraphite struct {
+	start  time.Time
+	series []*conf.TestSeries
+}
+
+func (t *testGraphite) Query(req *graphite.Request) (graphite.Response, error) {
+	var resp graphite.Response
+	for _, target := range req.Targets {
+		for _, s := range t.series {
+			if s.Backend != "graphite" {
+				continue
+			}
+			if s.Query != "" && s.Query != target || s.Query == "" && !graphiteMatch(target, s.Target) {
+				continue
+			}
+			var offsets []time.Duration
+			for offset := range s.Points {
+				offsets = append(offsets, offset)
+			}
+			sort.Slice(offsets, func(i, j int) bool { return offsets[i] < offsets[j] })
+			gs := graphite.Series{Target: s.Target}
+			for _, offset := range offsets {
+				ts := t.start.Add(offset)
+				if ts.Before(*req.Start) || ts.After(*req.End) {
+					continue
+				}
+				gs.Datapoints = append(gs.Datapoints, graphite.DataPoint{
+					json.Number(strconv.FormatFloat(s.Points[offset], 'f', -1, 64)),
+					json.Number(strconv.FormatInt(ts.Unix(), 10)),
+				})
+			}
+			resp = append(resp, gs)
+		}
+	}
+	return resp, nil
+}
+
+func graphiteMatch(pattern, name string) bool {
+	ps, ns := strings.Split(pattern, "."), strings.Split(name, ".")
+	if len(ps) != len(ns) {
+		return false
+	}
+	for i := range ps {
+		if ok, err := path.Match(ps[i], ns[i]); err != nil || !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,313 @@
+package rule
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	tparse "text/template/parse"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/expr"
+	eparse "bosun.org/cmd/bosun/expr/parse"
+	"bosun.org/cmd/bosun/search"
+	"bosun.org/opentsdb"
+)
+
+// A LintWarning is a problem of a rule configuration that does not keep it from
+// loading, found by Lint.
+type LintWarning struct {
+	Check   string // the check that found the problem, like "unused-macro"
+	Section string // the type of the section with the problem, like "alert"
+	Name    string // the name of the section
+	// Line and Column are the position of the section like in load errors: the
+	// line from 1 and the byte in the line from 0.
+	Line    int
+	Column  int
+	Message string
+}
+
+func (w *LintWarning) String() string {
+	return fmt.Sprintf("%d:%d: %s %s: %s (%s)", w.Line, w.Column, w.Section, w.Name, w.Message, w.Check)
+}
+
+// Lint looks for sections that are never used, notification chains that loop,
+// alerts that can never trigger and notification lookups by tag keys the alert
+// does not have. If s is not nil, it also reports the metrics queried from
+// OpenTSDB and the tag keys of lookups that s has never seen. The warnings are
+// sorted by position.
+func (c *Conf) Lint(s *search.Search) ([]*LintWarning, error) {
+	l := &linter{c: c}
+	l.unusedMacros()
+	l.unusedTemplates()
+	l.unusedNotifications()
+	l.notificationLoops()
+	l.neverTrue()
+	l.lookupTags()
+	if s != nil {
+		if err := l.unknownMetrics(s); err != nil {
+			return nil, err
+		}
+		if err := l.unknownTagKeys(s); err != nil {
+			return nil, err
+		}
+	}
+	sort.SliceStable(l.warnings, func(i, j int) bool {
+		wi, wj := l.warnings[i], l.warnings[j]
+		if wi.Line != wj.Line {
+			return wi.Line < wj.Line
+		}
+		return wi.Column < wj.Column
+	})
+	return l.warnings, nil
+}
+
+type linter struct {
+	c        *Conf
+	warnings []*LintWarning
+}
+
+func (l *linter) warnf(check, section, name string, loc conf.Locator, format string, args ...interface{}) {
+	w := &LintWarning{
+		Check:   check,
+		Section: section,
+		Name:    name,
+		Message: fmt.Sprintf(format, args...),
+	}
+	if loc, ok := loc.(Location); ok {
+		text := l.c.RawText[:getLocationStart(loc)]
+		w.Line = 1 + strings.Count(text, "\n")
+		w.Column = len(text) - strings.LastIndex(text, "\n") - 1
+	}
+	l.warnings = append(l.warnings, w)
+}
+
+func (l *linter) unusedMacros() {
+	for name, m := range l.c.Macros {
+		if !l.c.usedMacros[name] {
+			l.warnf("unused-macro", "macro", name, m.Locator, "macro is never used")
+		}
+	}
+}
+
+func (l *linter) unusedTemplates() {
+	used := make(map[string]bool)
+	var use func(name string)
+	use = func(name string) {
+		t := l.c.Templates[name]
+		if t == nil || used[name] {
+			return
+		}
+		used[name] = true
+		// templates can include the bodies and subjects of others
+		if t.Body != nil {
+			templateRefs(t.Body.Tree.Root, use)
+		}
+		if t.Subject != nil {
+			templateRefs(t.Subject.Tree.Root, use)
+		}
+	}
+	use(l.c.unknownTemplate)
+	for _, a := range l.c.Alerts {
+		use(a.TemplateName)
+	}
+	for name, t := range l.c.Templates {
+		if !used[name] {
+			l.warnf("unused-template", "template", name, t.Locator, "template is never used")
+		}
+	}
+}
+
+// templateRefs calls f with the name of each template invoked below n.
+func templateRefs(n tparse.Node, f func(string)) {
+	switch n := n.(type) {
+	case *tparse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, n := range n.Nodes {
+			templateRefs(n, f)
+		}
+	case *tparse.TemplateNode:
+		f(n.Name)
+	case *tparse.IfNode:
+		templateRefs(n.List, f)
+		templateRefs(n.ElseList, f)
+	case *tparse.RangeNode:
+		templateRefs(n.List, f)
+		templateRefs(n.ElseList, f)
+	case *tparse.WithNode:
+		templateRefs(n.List, f)
+		templateRefs(n.ElseList, f)
+	}
+}
+
+func (l *linter) unusedNotifications() {
+	used := make(map[string]bool)
+	use := func(n *conf.Notification) {
+		for ; n != nil && !used[n.Name]; n = n.Next {
+			used[n.Name] = true
+		}
+	}
+	useAll := func(ns *conf.Notifications) {
+		for _, n := range ns.Notifications {
+			use(n)
+		}
+		for key, lookup := range ns.Lookups {
+			for _, e := range lookup.Entries {
+				v, ok := e.Values[key]
+				if !ok {
+					continue
+				}
+				for _, name := range strings.Split(v, ",") {
+					use(l.c.Notifications[strings.TrimSpace(name)])
+				}
+			}
+		}
+	}
+	for _, a := range l.c.Alerts {
+		useAll(a.CritNotification)
+		useAll(a.WarnNotification)
+	}
+	for name, n := range l.c.Notifications {
+		if !used[name] {
+			l.warnf("unused-notification", "notification", name, n.Locator, "notification is never used")
+		}
+	}
+}
+
+// notificationLoops reports each loop of notification chains once, at the
+// notification of the loop with the lowest name. A loop repeats until the
+// incident is acknowledged or closed.
+func (l *linter) notificationLoops() {
+	for name, n := range l.c.Notifications {
+		chain := []string{name}
+		var every time.Duration
+		loops := false
+		for next := n; next.Next != nil; next = next.Next {
+			every += next.Timeout
+			if next.Next == n {
+				loops = true
+				break
+			}
+			if next.Next.Name < name || len(chain) > len(l.c.Notifications) {
+				break
+			}
+			chain = append(chain, next.Next.Name)
+		}
+		if !loops {
+			continue
+		}
+		chain = append(chain, "..."+name)
+		if every == 0 {
+			l.warnf("notification-loop", "notification", name, n.Locator, "notification chain loops without a timeout: %s", strings.Join(chain, ", "))
+		} else {
+			l.warnf("notification-loop", "notification", name, n.Locator, "notification chain loops every %v: %s", every, strings.Join(chain, ", "))
+		}
+	}
+}
+
+func (l *linter) neverTrue() {
+	for name, a := range l.c.Alerts {
+		for _, e := range []struct {
+			key string
+			e   *expr.Expr
+		}{{"crit", a.Crit}, {"warn", a.Warn}} {
+			if e.e == nil {
+				continue
+			}
+			if v, ok := e.e.Constant(); ok && v == 0 {
+				l.warnf("never-true", "alert", name, a.Locator, "%s is never true: %s", e.key, e.e)
+			}
+		}
+	}
+}
+
+// lookupTags reports notification lookups by tag keys the alert does not have,
+// which only match entries with a wildcard for them.
+func (l *linter) lookupTags() {
+	for name, a := range l.c.Alerts {
+		tags := alertTags(a)
+		if tags == nil {
+			continue
+		}
+		for _, ns := range []struct {
+			key string
+			ns  *conf.Notifications
+		}{{"critNotification", a.CritNotification}, {"warnNotification", a.WarnNotification}} {
+			for _, lookup := range ns.ns.Lookups {
+				for _, tagk := range lookup.Tags {
+					if _, ok := tags[tagk]; !ok {
+						l.warnf("unknown-tag-key", "alert", name, a.Locator, "%s lookup %s uses tag key %s, which the alert does not have", ns.key, lookup.Name, tagk)
+					}
+				}
+			}
+		}
+	}
+}
+
+// unknownMetrics reports the OpenTSDB metrics queried by alerts that s has never
+// seen. Nothing is reported if s has not seen any metric.
+func (l *linter) unknownMetrics(s *search.Search) error {
+	metrics, err := s.UniqueMetrics(0)
+	if err != nil {
+		return err
+	}
+	if len(metrics) == 0 {
+		return nil
+	}
+	known := make(map[string]bool, len(metrics))
+	for _, m := range metrics {
+		known[m] = true
+	}
+	for name, a := range l.c.Alerts {
+		reported := make(map[string]bool)
+		for _, e := range []*expr.Expr{a.Crit, a.Warn, a.Depends} {
+			if e == nil {
+				continue
+			}
+			eparse.Walk(e.Root, func(n eparse.Node) {
+				f, ok := n.(*eparse.FuncNode)
+				if !ok || len(f.Args) == 0 {
+					return
+				}
+				if _, ok := expr.TSDB[f.Name]; !ok {
+					return
+				}
+				arg, ok := f.Args[0].(*eparse.StringNode)
+				if !ok {
+					return
+				}
+				q, err := opentsdb.ParseQuery(arg.Text, opentsdb.Version2_2)
+				if q == nil || err != nil || known[q.Metric] || reported[q.Metric] {
+					return
+				}
+				reported[q.Metric] = true
+				l.warnf("unknown-metric", "alert", name, a.Locator, "metric %s has never been seen", q.Metric)
+			})
+		}
+	}
+	return nil
+}
+
+// unknownTagKeys reports the tag keys of lookups that s has no values of. Nothing
+// is reported if s has not seen any metric.
+func (l *linter) unknownTagKeys(s *search.Search) error {
+	metrics, err := s.UniqueMetrics(0)
+	if err != nil || len(metrics) == 0 {
+		return err
+	}
+	for name, lookup := range l.c.Lookups {
+		for _, tagk := range lookup.Tags {
+			vals, err := s.TagValuesByTagKey(tagk, 0)
+			if err != nil {
+				return err
+			}
+			if len(vals) == 0 {
+				l.warnf("unknown-tag-key", "lookup", name, lookup.Locator, "tag key %s has never been seen", tagk)
+			}
+		}
+	}
+	return nil
+}
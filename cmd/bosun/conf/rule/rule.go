@@ -9,6 +9,7 @@ import (
 	"os"
 	"regexp"
 	"runtime"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -39,6 +40,7 @@ type Conf struct {
 	RawText         string
 	Macros          map[string]*conf.Macro
 	Lookups         map[string]*conf.Lookup
+	Tests           map[string]*conf.AlertTest
 	Squelch         conf.Squelches `json:"-"`
 	NoSleep         bool
 
@@ -59,6 +61,9 @@ type Conf struct {
 	deferredSections map[string][]deferredSection // SectionType:[]deferredSection
 	saveHook         conf.SaveHook                // func that gets called on save if not nil
 	Hash             string
+
+	dependsOnNodes map[*conf.AlertDependency]parse.Node // for errors in loadDependencies
+	usedMacros     map[string]bool                      // for Lint
 }
 
 type deferredSection struct {
@@ -152,8 +157,11 @@ func NewConf(name string, backends conf.EnabledBackends, sysVars map[string]stri
 		subjects:         ttemplate.New(name).Funcs(defaultFuncs),
 		Lookups:          make(map[string]*conf.Lookup),
 		Macros:           make(map[string]*conf.Macro),
+		Tests:            make(map[string]*conf.AlertTest),
 		writeLock:        make(chan bool, 1),
 		deferredSections: make(map[string][]deferredSection),
+		dependsOnNodes:   make(map[*conf.AlertDependency]parse.Node),
+		usedMacros:       make(map[string]bool),
 		backends:         backends,
 		sysVars:          sysVars,
 	}
@@ -194,6 +202,8 @@ func NewConf(name string, backends conf.EnabledBackends, sysVars map[string]stri
 	loadSections("macro")
 	loadSections("lookup")
 	loadSections("alert")
+	c.loadDependencies()
+	loadSections("test")
 
 	c.genHash()
 	return
@@ -231,6 +241,8 @@ func (c *Conf) loadSection(s *parse.SectionNode) {
 		ds.LoadFunc = c.loadMacro
 	case "lookup":
 		ds.LoadFunc = c.loadLookup
+	case "test":
+		ds.LoadFunc = c.loadTest
 	default:
 		c.errorf("unknown section type: %s", s.SectionType.Text)
 	}
@@ -280,6 +292,7 @@ func (c *Conf) getPairs(s *parse.SectionNode, vars conf.Vars, st sectionType) (p
 				if !ok {
 					c.errorf("macro not found: %s", v)
 				}
+				c.usedMacros[v] = true
 				for _, p := range m.Pairs.([]nodePair) {
 					add(p.node, p.key, c.Expand(p.val, vars, ignoreBadExpand))
 				}
@@ -562,6 +575,13 @@ func (c *Conf) loadAlert(s *parse.SectionNode) {
 			a.Warn = c.NewExpr(v)
 		case "depends":
 			a.Depends = c.NewExpr(v)
+		case "dependsOn":
+			dep := parseDependsOn(v)
+			if dep == nil {
+				c.errorf("bad dependsOn %q, expected alert or alert{tagk,tagk=parentTagk}", v)
+			}
+			a.DependsOn = append(a.DependsOn, dep)
+			c.dependsOnNodes[dep] = p.node
 		case "squelch":
 			a.RawSquelch = append(a.RawSquelch, v)
 			if err := a.Squelch.Add(v); err != nil {
@@ -591,6 +611,25 @@ func (c *Conf) loadAlert(s *parse.SectionNode) {
 				c.errorf("max log frequency must be at least 1s")
 			}
 			a.MaxLogFrequency = d
+		case "flapThreshold":
+			var err error
+			a.FlapThreshold, err = strconv.Atoi(v)
+			if err != nil {
+				c.error(err)
+			}
+			if a.FlapThreshold < 2 {
+				c.errorf("flap threshold must be at least 2")
+			}
+		case "flapWindow":
+			od, err := opentsdb.ParseDuration(v)
+			if err != nil {
+				c.error(err)
+			}
+			d := time.Duration(od)
+			if d < time.Second {
+				c.errorf("flap window must be at least 1s")
+			}
+			a.FlapWindow = d
 		case "unjoinedOk":
 			a.UnjoinedOK = true
 		case "ignoreUnknown":
@@ -612,6 +651,12 @@ func (c *Conf) loadAlert(s *parse.SectionNode) {
 	if a.MaxLogFrequency != 0 && !a.Log {
 		c.errorf("maxLogFrequency can only be used on alerts with `log = true`.")
 	}
+	if a.FlapWindow != 0 && a.FlapThreshold == 0 {
+		c.errorf("flapWindow can only be used with flapThreshold")
+	}
+	if a.FlapThreshold != 0 && a.FlapWindow == 0 {
+		a.FlapWindow = time.Hour
+	}
 	c.at(s)
 	if a.Crit == nil && a.Warn == nil {
 		c.errorf("neither crit or warn specified")
@@ -674,6 +719,127 @@ func (c *Conf) loadAlert(s *parse.SectionNode) {
 	c.Alerts[name] = &a
 }
 
+var dependsOnRE = regexp.MustCompile(`^([^{}\s]+)\s*(?:\{([^{}]*)\})?$`)
+
+// parseDependsOn parses the value of a dependsOn key: an alert name that is optionally
+// followed by the tag keys to match in braces. Each tag is either a key the alerts
+// share, or key=parentKey if the parent alert calls it something else. It returns nil
+// if v is malformed.
+func parseDependsOn(v string) *conf.AlertDependency {
+	m := dependsOnRE.FindStringSubmatch(strings.TrimSpace(v))
+	if m == nil {
+		return nil
+	}
+	dep := &conf.AlertDependency{Alert: m[1]}
+	if strings.TrimSpace(m[2]) == "" {
+		return dep
+	}
+	dep.Tags = make(map[string]string)
+	for _, t := range strings.Split(m[2], ",") {
+		kv := strings.SplitN(t, "=", 2)
+		k := strings.TrimSpace(kv[0])
+		pk := k
+		if len(kv) == 2 {
+			pk = strings.TrimSpace(kv[1])
+		}
+		if k == "" || pk == "" {
+			return nil
+		}
+		dep.Tags[k] = pk
+	}
+	return dep
+}
+
+// alertTags returns the tag keys of the alert's crit or warn expression. It returns nil
+// if they can't be determined.
+func alertTags(a *conf.Alert) eparse.Tags {
+	e := a.Crit
+	if e == nil {
+		e = a.Warn
+	}
+	tags, err := e.Root.Tags()
+	if err != nil {
+		return nil
+	}
+	return tags
+}
+
+// loadDependencies resolves the dependsOn keys of all alerts once they are loaded. If
+// no tags are given the tag keys the two alerts have in common are used. A dependency
+// cycle is an error, since the alerts in it could suppress each other indefinitely.
+func (c *Conf) loadDependencies() {
+	names := make([]string, 0, len(c.Alerts))
+	for name := range c.Alerts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		a := c.Alerts[name]
+		for _, dep := range a.DependsOn {
+			c.at(c.dependsOnNodes[dep])
+			parent, ok := c.Alerts[dep.Alert]
+			if !ok {
+				c.errorf("dependsOn: unknown alert %s", dep.Alert)
+			}
+			if parent == a {
+				c.errorf("dependsOn: alert %s can not depend on itself", name)
+			}
+			tags, parentTags := alertTags(a), alertTags(parent)
+			if dep.Tags == nil {
+				dep.Tags = make(map[string]string)
+				for k := range tags.Intersection(parentTags) {
+					dep.Tags[k] = k
+				}
+				continue
+			}
+			for k, pk := range dep.Tags {
+				if _, ok := tags[k]; tags != nil && !ok {
+					c.errorf("dependsOn: alert %s has no tag %s", name, k)
+				}
+				if _, ok := parentTags[pk]; parentTags != nil && !ok {
+					c.errorf("dependsOn: alert %s has no tag %s", dep.Alert, pk)
+				}
+			}
+		}
+	}
+	// depth first search for cycles, the path holds the alerts being visited
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make(map[string]int)
+	var path []string
+	var visit func(name string)
+	visit = func(name string) {
+		state[name] = visiting
+		path = append(path, name)
+		for _, dep := range c.Alerts[name].DependsOn {
+			switch state[dep.Alert] {
+			case visiting:
+				c.at(c.dependsOnNodes[dep])
+				var cycle []string
+				for i, n := range path {
+					if n == dep.Alert {
+						cycle = append(path[i:], dep.Alert)
+						break
+					}
+				}
+				c.errorf("dependsOn cycle: %s", strings.Join(cycle, " -> "))
+			case unvisited:
+				visit(dep.Alert)
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = done
+	}
+	for _, name := range names {
+		if state[name] == unvisited {
+			visit(name)
+		}
+	}
+}
+
 func (c *Conf) loadNotification(s *parse.SectionNode) {
 	name := s.Name.Text
 	if _, ok := c.Notifications[name]; ok {
@@ -701,10 +867,24 @@ func (c *Conf) loadNotification(s *parse.SectionNode) {
 	}
 	c.Notifications[name] = &n
 	pairs := c.getPairs(s, n.Vars, sNormal)
+	for _, p := range pairs {
+		if p.key == "type" {
+			c.at(p.node)
+			n.Type = p.val
+			if !isNotifierType(n.Type) {
+				c.errorf("unknown notification type %s, must be one of %s", n.Type, strings.Join(conf.NotifierTypes(), ", "))
+			}
+		}
+	}
 	for _, p := range pairs {
 		c.at(p.node)
 		v := p.val
+		if builtinNotifierKeys[p.key] && n.Type != "" && n.Type != p.key {
+			c.errorf("key %s is not valid for notification type %s", p.key, n.Type)
+		}
 		switch k := p.key; k {
+		case "type":
+			// handled above
 		case "email":
 			n.RawEmail = v
 			email, err := mail.ParseAddressList(n.RawEmail)
@@ -756,13 +936,60 @@ func (c *Conf) loadNotification(s *parse.SectionNode) {
 		case "useBody":
 			n.UseBody = v == "true"
 		default:
-			c.errorf("unknown key %s", k)
+			if n.Type == "" || builtinNotifierKeys[n.Type] {
+				c.errorf("unknown key %s", k)
+			}
+			if n.Params == nil {
+				n.Params = make(map[string]string)
+			}
+			n.Params[k] = v
 		}
 	}
 	c.at(s)
 	if n.Timeout > 0 && n.Next == nil {
 		c.errorf("timeout specified without next")
 	}
+	types := []string{n.Type}
+	if n.Type == "" {
+		types = types[:0]
+		if n.Email != nil {
+			types = append(types, "email")
+		}
+		if n.Post != nil {
+			types = append(types, "post")
+		}
+		if n.Get != nil {
+			types = append(types, "get")
+		}
+		if n.Print {
+			types = append(types, "print")
+		}
+	}
+	for _, t := range types {
+		nt, err := conf.NewNotifier(t, &n, n.Params)
+		if err != nil {
+			c.error(err)
+		}
+		n.Notifiers = append(n.Notifiers, nt)
+	}
+}
+
+// builtinNotifierKeys are the notification types that are configured with a key of
+// the same name. Notifications without a type create a notifier for each of them that is set.
+var builtinNotifierKeys = map[string]bool{
+	"email": true,
+	"post":  true,
+	"get":   true,
+	"print": true,
+}
+
+func isNotifierType(t string) bool {
+	for _, typ := range conf.NotifierTypes() {
+		if typ == t {
+			return true
+		}
+	}
+	return false
 }
 
 var exRE = regexp.MustCompile(`\$(?:[\w.]+|\{[\w.]+\})`)
@@ -794,7 +1021,7 @@ func (c *Conf) Expand(v string, vars map[string]string, ignoreBadExpand bool) st
 func (c *Conf) seen(v string, m map[string]bool) {
 	if m[v] {
 		switch v {
-		case "squelch", "critNotification", "warnNotification", "graphiteHeader":
+		case "squelch", "critNotification", "warnNotification", "graphiteHeader", "dependsOn", "series", "graphite", "expect":
 			// ignore
 		default:
 			c.errorf("duplicate key: %s", v)
@@ -1021,6 +1248,9 @@ func (c *Conf) GetFuncs(backends conf.EnabledBackends) map[string]eparse.Func {
 	if backends.Annotate {
 		merge(expr.Annotate)
 	}
+	if backends.Prom {
+		merge(expr.Prom)
+	}
 	return funcs
 }
 
@@ -1,12 +1,16 @@
 package rule
 
 import (
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/database"
+	"bosun.org/cmd/bosun/search"
 )
 
 func TestPrint(t *testing.T) {
@@ -63,15 +67,112 @@ func checkMacroVarAlert(t *testing.T, a *conf.Alert) {
 	}
 }
 
+type testNotifier struct{ url string }
+
+func (t testNotifier) Type() string   { return "test" }
+func (t testNotifier) Target() string { return t.url }
+func (t testNotifier) Send(p *conf.NotificationPayload, c conf.SystemConfProvider) error {
+	return nil
+}
+
+func init() {
+	conf.RegisterNotifier("test", func(n *conf.Notification, params map[string]string) (conf.Notifier, error) {
+		if params["url"] == "" {
+			return nil, fmt.Errorf("test notification requires url")
+		}
+		return testNotifier{params["url"]}, nil
+	})
+}
+
+func TestNotifierType(t *testing.T) {
+	c, err := NewConf("test", conf.EnabledBackends{}, nil, `
+		notification legacy {
+			email = a@example.com
+			post = http://example.com/post
+			print = true
+		}
+		notification custom {
+			type = test
+			url = http://example.com/hook
+			next = legacy
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var types []string
+	for _, nt := range c.Notifications["legacy"].Notifiers {
+		types = append(types, nt.Type())
+	}
+	if s := strings.Join(types, ","); s != "email,post,print" {
+		t.Errorf("legacy notifiers: expected email,post,print, got %s", s)
+	}
+	n := c.Notifications["custom"]
+	if len(n.Notifiers) != 1 || n.Notifiers[0].Target() != "http://example.com/hook" {
+		t.Errorf("unexpected custom notifiers: %v", n.Notifiers)
+	}
+	if n.Next == nil || n.Next.Name != "legacy" {
+		t.Errorf("expected custom to chain to legacy")
+	}
+	_, err = NewConf("test", conf.EnabledBackends{}, nil, `
+		notification custom {
+			type = test
+		}
+	`)
+	if err == nil || !strings.HasSuffix(err.Error(), "test notification requires url") {
+		t.Errorf("expected missing url error, got %v", err)
+	}
+}
+
+func TestDependsOn(t *testing.T) {
+	c, err := NewConf("test", conf.EnabledBackends{OpenTSDB: true}, nil, `
+		alert host.down {
+			crit = avg(q("avg:o{host=*,dc=*}", "", ""))
+		}
+		alert disk.full {
+			dependsOn = host.down
+			crit = avg(q("avg:o{host=*,disk=*}", "", ""))
+		}
+		alert app.errors {
+			dependsOn = host.down{server=host}
+			dependsOn = disk.full{server=host}
+			crit = avg(q("avg:o{server=*}", "", ""))
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	deps := c.Alerts["disk.full"].DependsOn
+	if len(deps) != 1 || deps[0].Alert != "host.down" || len(deps[0].Tags) != 1 || deps[0].Tags["host"] != "host" {
+		t.Errorf("disk.full: expected dependency on host.down by host, got %+v", deps[0])
+	}
+	deps = c.Alerts["app.errors"].DependsOn
+	if len(deps) != 2 || deps[0].Tags["server"] != "host" || deps[1].Alert != "disk.full" {
+		t.Errorf("app.errors: unexpected dependencies %+v", deps)
+	}
+	for _, v := range []string{"", "a{", "a{b=}", "a b"} {
+		if dep := parseDependsOn(v); dep != nil {
+			t.Errorf("%q: expected parse error, got %+v", v, dep)
+		}
+	}
+}
+
 func TestInvalid(t *testing.T) {
 	names := map[string]string{
-		"lookup-key-pairs":     "conf: lookup-key-pairs:3:1: at <entry a=3 { }>: lookup tags mismatch, expected {a=,b=}",
-		"number-func-args":     `conf: number-func-args:2:1: at <warn = q("avg:o", ""...>: expr: parse: not enough arguments for q`,
-		"lookup-key-pairs-dup": `conf: lookup-key-pairs-dup:3:1: at <entry b=2,a=1 { }>: duplicate entry`,
-		"crit-warn-unmatching-tags": `conf: crit-warn-unmatching-tags:1:0: at <alert broken {\n	cri...>: crit tags (a,c) and warn tags (c) must be equal`,
-		"depends-no-overlap": `conf: depends-no-overlap:1:0: at <alert broken {\n	dep...>: Depends and crit/warn must share at least one tag.`,
-		"log-no-notification": `conf: log-no-notification:1:0: at <alert a {\n	crit = 1...>: log specified but no notification`,
-		"crit-notification-no-template": `conf: crit-notification-no-template:5:0: at <alert a {\n	crit = 1...>: notifications specified but no template`,
+		"lookup-key-pairs":               "conf: lookup-key-pairs:3:1: at <entry a=3 { }>: lookup tags mismatch, expected {a=,b=}",
+		"number-func-args":               `conf: number-func-args:2:1: at <warn = q("avg:o", ""...>: expr: parse: not enough arguments for q`,
+		"lookup-key-pairs-dup":           `conf: lookup-key-pairs-dup:3:1: at <entry b=2,a=1 { }>: duplicate entry`,
+		"crit-warn-unmatching-tags":      `conf: crit-warn-unmatching-tags:1:0: at <alert broken {\n	cri...>: crit tags (a,c) and warn tags (c) must be equal`,
+		"depends-no-overlap":             `conf: depends-no-overlap:1:0: at <alert broken {\n	dep...>: Depends and crit/warn must share at least one tag.`,
+		"log-no-notification":            `conf: log-no-notification:1:0: at <alert a {\n	crit = 1...>: log specified but no notification`,
+		"crit-notification-no-template":  `conf: crit-notification-no-template:5:0: at <alert a {\n	crit = 1...>: notifications specified but no template`,
+		"notification-unknown-type":      `conf: notification-unknown-type:2:1: at <type = pager>: unknown notification type pager, must be one of email, get, post, print, test`,
+		"notification-type-key-mismatch": `conf: notification-type-key-mismatch:4:1: at <post = http://exampl...>: key post is not valid for notification type email`,
+		"notification-type-missing-key":  `conf: notification-type-missing-key:1:0: at <notification n {\n	t...>: post notification requires post`,
+		"flap-window-no-threshold":       `conf: flap-window-no-threshold:3:1: at <flapWindow = 1h>: flapWindow can only be used with flapThreshold`,
+		"depends-on-cycle":               `conf: depends-on-cycle:7:1: at <dependsOn = a>: dependsOn cycle: a -> c -> b -> a`,
+		"depends-on-unknown-alert":       `conf: depends-on-unknown-alert:2:1: at <dependsOn = missing>: dependsOn: unknown alert missing`,
+		"depends-on-bad-tag":             `conf: depends-on-bad-tag:6:1: at <dependsOn = host.dow...>: dependsOn: alert host.down has no tag disk`,
 	}
 	for fname, reason := range names {
 		path := filepath.Join("invalid", fname)
@@ -89,3 +190,150 @@ func TestInvalid(t *testing.T) {
 		}
 	}
 }
+
+func TestAlertTests(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bosun")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	data := `{"opentsdb": [{"metric": "os.cpu", "tags": {"host": "b", "core": "0"}, "points": {"0": 50, "5m": 85}},
+		{"metric": "os.cpu", "tags": {"host": "b", "core": "1"}, "points": {"0": 70, "5m": 95}}]}`
+	if err := ioutil.WriteFile(filepath.Join(dir, "cpu.json"), []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewConf(filepath.Join(dir, "rules.conf"), conf.EnabledBackends{OpenTSDB: true, Graphite: true}, nil, `
+		alert os.high.cpu {
+			warn = avg(q("avg:os.cpu{host=*}", "1m", "")) > 80
+			crit = avg(q("avg:os.cpu{host=*}", "1m", "")) > 95
+		}
+		alert graphite.cpu {
+			crit = avg(graphite("servers.*.cpu", "1m", "", ".host.")) > 90
+		}
+		test cpu.high {
+			alert = os.high.cpu
+			start = 2017-01-01T00:00:00Z
+			data = cpu.json
+			series = os.cpu{host=a,core=0} 0:10 5m:99 10m:98
+			expect = 0 {host=a} normal
+			expect = 0 {host=b} normal
+			expect = 5m {host=a} critical
+			expect = 5m {host=b} warning
+			expect = 10m {host=a} critical
+			expect = 10m {host=b} normal
+		}
+		test graphite.cpu {
+			alert = graphite.cpu
+			graphite = servers.web01.cpu 0:10 5m:91
+			graphite = servers.web02.cpu 0:10 5m:50
+			expect = 5m {host=web01} critical
+			expect = 5m {host=web02} critical
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	results := c.RunTests()
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+	if r := results[0]; r.Name != "cpu.high" || r.Error != "" || len(r.Failures) != 1 || !strings.Contains(r.Failures[0], "os.high.cpu{host=b} at 10m0s: expected normal, got unknown") {
+		t.Errorf("unexpected result %+v", r)
+	}
+	if r := results[1]; r.Name != "graphite.cpu" || r.Error != "" || len(r.Failures) != 1 || !strings.Contains(r.Failures[0], "graphite.cpu{host=web02} at 5m0s: expected critical, got normal") {
+		t.Errorf("unexpected result %+v", r)
+	}
+	for _, test := range []string{
+		"test t {\n alert = none\n expect = 0 {} normal\n}",
+		"test t {\n alert = os.high.cpu\n}",
+		"test t {\n alert = os.high.cpu\n expect = 0 {host=a} broken\n}",
+		"test t {\n alert = os.high.cpu\n series = os.cpu{host=a} 0=1\n expect = 0 {host=a} normal\n}",
+	} {
+		_, err := NewConf("test", conf.EnabledBackends{OpenTSDB: true}, nil, `
+			alert os.high.cpu {
+				crit = avg(q("avg:os.cpu{host=*}", "1m", "")) > 95
+			}
+		`+test)
+		if err == nil {
+			t.Errorf("%q: expected error", test)
+		}
+	}
+}
+
+func TestLint(t *testing.T) {
+	c, err := NewConf("test", conf.EnabledBackends{OpenTSDB: true}, nil, `macro m {
+	unjoinedOk = true
+}
+macro unused {
+	unjoinedOk = true
+}
+template t {
+	subject = {{template "shared" .}}
+}
+template shared {
+	subject = shared
+}
+template unused {
+	subject = unused
+}
+notification b {
+	print = true
+	next = b
+	timeout = 30m
+}
+notification a {
+	print = true
+	next = b
+	timeout = 1h
+}
+notification unused {
+	print = true
+}
+lookup owner {
+	entry service=web {
+		notification = a
+	}
+}
+alert cpu {
+	macro = m
+	template = t
+	crit = avg(q("avg:os.cpu{host=*}", "1m", "")) > 90
+	critNotification = lookup("owner", "notification")
+}
+alert disabled {
+	crit = 0 && avg(q("avg:os.mem{host=*}", "1m", ""))
+}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	da, closeData, err := database.NewMemoryDataAccess()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeData()
+	if err := da.Search().AddMetric("os.cpu", 1); err != nil {
+		t.Fatal(err)
+	}
+	warnings, err := c.Lint(search.NewSearch(da, true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, w := range warnings {
+		got = append(got, w.String())
+	}
+	expected := []string{
+		"4:0: macro unused: macro is never used (unused-macro)",
+		"13:0: template unused: template is never used (unused-template)",
+		"16:0: notification b: notification chain loops every 30m0s: b, ...b (notification-loop)",
+		"26:0: notification unused: notification is never used (unused-notification)",
+		"29:0: lookup owner: tag key service has never been seen (unknown-tag-key)",
+		"34:0: alert cpu: critNotification lookup owner uses tag key service, which the alert does not have (unknown-tag-key)",
+		"40:0: alert disabled: crit is never true: 0 && avg(q(\"avg:os.mem{host=*}\", \"1m\", \"\")) (never-true)",
+		"40:0: alert disabled: metric os.mem has never been seen (unknown-metric)",
+	}
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("got warnings\n%s\nexpected\n%s", strings.Join(got, "\n"), strings.Join(expected, "\n"))
+	}
+}
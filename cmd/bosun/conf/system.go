@@ -10,6 +10,7 @@ import (
 	"bosun.org/cmd/bosun/expr"
 	"bosun.org/graphite"
 	"bosun.org/opentsdb"
+	"bosun.org/prom"
 	"github.com/BurntSushi/toml"
 	"github.com/influxdata/influxdb/client/v2"
 	elastic "gopkg.in/olivere/elastic.v3"
@@ -36,6 +37,22 @@ type SystemConf struct {
 	CheckFrequency   Duration // Time between alert checks: 5m
 	DefaultRunEvery  int      // Default number of check intervals to run each alert: 1
 
+	NotificationRetryLimit int      // Number of times a failed notification delivery is retried: 5
+	NotificationRetryDelay Duration // Delay before the first retry, doubled for each further attempt: 1m
+	NotificationRetention  Duration // How long failed notifications that are no longer retried are kept, 0 keeps them: 168h (7 days)
+
+	QueryCacheSize    int64    // Memory limit in bytes of the query cache shared by all checks, 0 disables it: 0
+	QueryCacheRefetch Duration // How much of the end of a cached query result is fetched again: 5m
+
+	SnapshotRetention int // Number of alert evaluations to keep the backend responses of for replay, 0 disables recording: 0
+
+	LeaderTimeout Duration // Time after which a follower takes over from a leader that stopped renewing its lock, 0 disables leader election: 0
+
+	ShardTimeout Duration // Time after which the alerts of a worker that stopped renewing its membership move to the others, 0 disables sharding: 0
+
+	AuditRetention Duration // How long events of api requests that change state are kept in the audit log, 0 disables it: 0
+	AuditSyslog    bool     // Also write the audit log to syslog: false
+
 	DBConf DBConf
 
 	SMTPConf SMTPConf
@@ -45,6 +62,7 @@ type SystemConf struct {
 	OpenTSDBConf OpenTSDBConf
 	GraphiteConf GraphiteConf
 	InfluxConf   InfluxConf
+	PromConf     PromConf
 	ElasticConf  map[string]ElasticConf
 	LogStashConf LogStashConf
 
@@ -70,6 +88,7 @@ type EnabledBackends struct {
 	Elastic  bool
 	Logstash bool
 	Annotate bool
+	Prom     bool
 }
 
 // EnabledBackends returns and EnabledBackends struct which contains fields
@@ -82,6 +101,7 @@ func (sc *SystemConf) EnabledBackends() EnabledBackends {
 	b.Logstash = len(sc.LogStashConf.Hosts) != 0
 	b.Elastic = len(sc.ElasticConf["default"].Hosts) != 0
 	b.Annotate = len(sc.AnnotateConf.Hosts) != 0
+	b.Prom = sc.PromConf.Host != ""
 	return b
 }
 
@@ -101,6 +121,13 @@ type GraphiteConf struct {
 	Headers map[string]string
 }
 
+// PromConf contains a string representing the host of a Prometheus compatible
+// server and a map of headers to be sent with each query
+type PromConf struct {
+	Host    string
+	Headers map[string]string
+}
+
 // AnnotateConf contains the elastic configuration to enable Annotations support
 type AnnotateConf struct {
 	Hosts         []string        // CSV of Elastic Hosts, currently the only backend in annotate
@@ -160,6 +187,11 @@ type DBConf struct {
 
 	LedisDir      string
 	LedisBindAddr string
+
+	// SQLDriver selects a sql database instead of redis or ledis, either "sqlite3" or "postgres".
+	// SQLDataSource is the file name for sqlite3 or the connection string for postgres.
+	SQLDriver     string
+	SQLDataSource string
 }
 
 // SMTPConf contains information for the mail server for which bosun will
@@ -171,7 +203,7 @@ type SMTPConf struct {
 	Password  string `json:"-"`
 }
 
-//AuthConf is configuration for bosun's authentication
+// AuthConf is configuration for bosun's authentication
 type AuthConf struct {
 	AuthDisabled bool
 	//Secret string to hash auth tokens. Needed to enable token auth.
@@ -180,6 +212,26 @@ type AuthConf struct {
 	CookieSecret string
 	//LDAP configuration
 	LDAP LDAPConf
+	//OpenID Connect configuration
+	OIDC OIDCConf
+	//Scopes that limit permissions to some of the alerts
+	Scopes []AuthScope
+}
+
+// AuthScope grants permissions for only the alerts it matches. It is granted like a
+// permission named "Scope:<Name>" to groups, users and tokens.
+type AuthScope struct {
+	Name string
+	// Permission bit of the scope, from 0 to 15, which tokens and sessions store.
+	// It must stay the same when other scopes are added or removed.
+	Bit int
+	// Alert name patterns: names, globs like "storage.*" or regular expressions
+	// starting with ~. Empty for all alerts.
+	Alerts []string
+	// Tags alert keys must have, values may be globs. Ex: "team=storage"
+	Tags string
+	// Permissions granted for the alerts: any of "Actions", "Silence" and "Save Config"
+	Role string
 }
 
 type LDAPConf struct {
@@ -200,7 +252,7 @@ type LDAPConf struct {
 	RootSearchPath string
 }
 
-//LDAPGroup is a Group level access specification for ldap
+// LDAPGroup is a Group level access specification for ldap
 type LDAPGroup struct {
 	// group search path string
 	Path string
@@ -208,6 +260,38 @@ type LDAPGroup struct {
 	Role string
 }
 
+// OIDCConf is configuration for logging in with an OpenID Connect identity provider
+type OIDCConf struct {
+	// URL of the identity provider, which must serve /.well-known/openid-configuration
+	Issuer string
+	// client id and secret of bosun registered with the identity provider
+	ClientID     string
+	ClientSecret string
+	// URL of bosun the identity provider redirects back to, like "https://bosun.mycompany.com".
+	// Defaults to the host of the login request.
+	RedirectURL string
+	// scopes to request besides "openid". Default is "profile" and "email"
+	Scopes []string
+	// claim with the username. Default is "preferred_username"
+	UsernameClaim string
+	// claim with the list of groups of the user. Default is "groups"
+	GroupsClaim string
+	// default permission level for anyone who can log in. Try "Reader".
+	DefaultPermission string
+	//List of group level permissions
+	Groups []OIDCGroup
+	//List of user specific permission levels
+	Users map[string]string
+}
+
+// OIDCGroup is a Group level access specification for OpenID Connect
+type OIDCGroup struct {
+	// group name in the groups claim, or "*" for everyone
+	Name string
+	// Access to grant members of group Ex: "Admin"
+	Role string
+}
+
 // GetSystemConfProvider returns the SystemConfProvider interface
 // and validates the logic of the configuration. If the configuration
 // is not valid an error is returned
@@ -233,8 +317,12 @@ func newSystemConf() *SystemConf {
 			LedisDir:      "ledis_data",
 			LedisBindAddr: "127.0.0.1:9565",
 		},
-		MinGroupSize: 5,
-		PingDuration: Duration{Duration: time.Hour * 24},
+		MinGroupSize:           5,
+		NotificationRetryLimit: 5,
+		NotificationRetryDelay: Duration{Duration: time.Minute},
+		NotificationRetention:  Duration{Duration: time.Hour * 24 * 7},
+		PingDuration:           Duration{Duration: time.Hour * 24},
+		QueryCacheRefetch:      Duration{Duration: time.Minute * 5},
 		OpenTSDBConf: OpenTSDBConf{
 			ResponseLimit: 1 << 20, // 1MB
 			Version:       opentsdb.Version2_1,
@@ -358,6 +446,17 @@ func (sc *SystemConf) GetRedisHost() string {
 	return sc.DBConf.RedisHost
 }
 
+// GetSQLDriver returns the database/sql driver to use. If this is set than a sql
+// database is used for storage instead of Redis or Ledis
+func (sc *SystemConf) GetSQLDriver() string {
+	return sc.DBConf.SQLDriver
+}
+
+// GetSQLDataSource returns the data source name that is passed to the sql driver
+func (sc *SystemConf) GetSQLDataSource() string {
+	return sc.DBConf.SQLDataSource
+}
+
 // GetRedisDb returns the redis database number to use
 func (sc *SystemConf) GetRedisDb() int {
 	return sc.DBConf.RedisDb
@@ -415,6 +514,66 @@ func (sc *SystemConf) GetMinGroupSize() int {
 	return sc.MinGroupSize
 }
 
+// GetNotificationRetryLimit returns the number of times a failed notification delivery is
+// retried before giving up
+func (sc *SystemConf) GetNotificationRetryLimit() int {
+	return sc.NotificationRetryLimit
+}
+
+// GetQueryCacheSize returns the memory limit in bytes of the query cache that is shared by
+// all checks. The cache is disabled if it is 0
+func (sc *SystemConf) GetQueryCacheSize() int64 {
+	return sc.QueryCacheSize
+}
+
+// GetQueryCacheRefetch returns how much of the end of a cached query result is fetched
+// again when the query is run for a later time, since the most recent points may have
+// been incomplete
+func (sc *SystemConf) GetQueryCacheRefetch() time.Duration {
+	return sc.QueryCacheRefetch.Duration
+}
+
+// GetSnapshotRetention returns the number of alert evaluations that changed incidents to
+// keep a snapshot of the backend responses of. Recording is disabled if it is 0
+func (sc *SystemConf) GetSnapshotRetention() int {
+	return sc.SnapshotRetention
+}
+
+// GetLeaderTimeout returns how long the leader lock of instances sharing the data store is held
+// without being renewed. Leader election is disabled if it is 0
+func (sc *SystemConf) GetLeaderTimeout() time.Duration {
+	return sc.LeaderTimeout.Duration
+}
+
+// GetShardTimeout returns how long the membership of a worker that evaluates a share of the alerts
+// is kept without being renewed. Sharding is disabled if it is 0
+func (sc *SystemConf) GetShardTimeout() time.Duration {
+	return sc.ShardTimeout.Duration
+}
+
+// GetAuditRetention returns how long events of api requests that change state are kept
+// in the audit log. Zero disables storing them.
+func (sc *SystemConf) GetAuditRetention() time.Duration {
+	return sc.AuditRetention.Duration
+}
+
+// GetAuditSyslog returns whether the audit log is also written to syslog.
+func (sc *SystemConf) GetAuditSyslog() bool {
+	return sc.AuditSyslog
+}
+
+// GetNotificationRetryDelay returns the delay before the first retry of a failed notification
+// delivery. The delay doubles for each further attempt
+func (sc *SystemConf) GetNotificationRetryDelay() time.Duration {
+	return sc.NotificationRetryDelay.Duration
+}
+
+// GetNotificationRetention returns how long failed notifications that are no longer retried
+// are kept. 0 keeps them until they are dismissed
+func (sc *SystemConf) GetNotificationRetention() time.Duration {
+	return sc.NotificationRetention.Duration
+}
+
 // GetShortURLKey returns the API key that should be used to generate https://goo.gl/ shortlinks
 // from Bosun's UI
 func (sc *SystemConf) GetShortURLKey() string {
@@ -504,6 +663,25 @@ func (sc *SystemConf) GetGraphiteContext() graphite.Context {
 	return graphite.Host(sc.GraphiteConf.Host)
 }
 
+// GetPromContext returns a Prometheus context which contains all the information needed
+// to query a Prometheus compatible server. A nil context is returned if PromConf.Host is not set.
+func (sc *SystemConf) GetPromContext() prom.Context {
+	if sc.PromConf.Host == "" {
+		return nil
+	}
+	if len(sc.PromConf.Headers) > 0 {
+		headers := http.Header(make(map[string][]string))
+		for k, v := range sc.PromConf.Headers {
+			headers.Add(k, v)
+		}
+		return prom.HostHeader{
+			Host:   sc.PromConf.Host,
+			Header: headers,
+		}
+	}
+	return prom.Host(sc.PromConf.Host)
+}
+
 // GetInfluxContext returns a Influx context which contains all the information needed
 // to query Influx.
 func (sc *SystemConf) GetInfluxContext() client.HTTPConfig {
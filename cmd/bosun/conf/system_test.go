@@ -21,6 +21,15 @@ func TestSystemToml(t *testing.T) {
 	assert.Equal(t, sc.Ping, true)
 	assert.Equal(t, sc.MinGroupSize, 5)
 	assert.Equal(t, sc.UnknownThreshold, 5)
+	assert.Equal(t, sc.NotificationRetryLimit, 3)
+	assert.Equal(t, sc.NotificationRetryDelay, Duration{Duration: 30 * time.Second})
+	assert.Equal(t, sc.NotificationRetention, Duration{Duration: 72 * time.Hour})
+	assert.Equal(t, sc.QueryCacheSize, int64(268435456))
+	assert.Equal(t, sc.QueryCacheRefetch, Duration{Duration: 10 * time.Minute})
+	assert.Equal(t, sc.SnapshotRetention, 1000)
+	assert.Equal(t, sc.LeaderTimeout, Duration{Duration: 30 * time.Second})
+	assert.Equal(t, sc.AuditRetention, Duration{Duration: 30 * 24 * time.Hour})
+	assert.Equal(t, sc.AuditSyslog, false)
 	assert.Equal(t, sc.SearchSince, Duration{Duration: time.Hour * 72})
 	assert.Equal(t, sc.PingDuration, Duration{Duration: time.Hour * 24}, "PingDuration does not match (should be set by default)")
 	assert.Equal(t, sc.HTTPListen, ":8080", "HTTPListen does not match")
@@ -38,6 +47,9 @@ func TestSystemToml(t *testing.T) {
 		Host:    "localhost:80",
 		Headers: map[string]string{"X-Meow": "Mix"},
 	})
+	assert.Equal(t, sc.PromConf, PromConf{
+		Host: "http://localhost:9090",
+	})
 	assert.Equal(t, sc.ElasticConf, map[string]ElasticConf{
 		"default": {
 			Hosts: []string{"http://ny-lselastic01.example.com:9200", "http://ny-lselastic02.example.com:9200"},
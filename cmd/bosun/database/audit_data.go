@@ -0,0 +1,149 @@
+package database
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+
+	"bosun.org/models"
+	"bosun.org/slog"
+)
+
+/*
+
+auditEvents: ZSET time in unix milliseconds -> json encoded models.AuditEvent
+
+auditEvents:user:{user}, auditEvents:route:{route}: the same as auditEvents for the events of a user or route
+
+auditIndexes: SET of the auditEvents:user and auditEvents:route keys
+
+*/
+
+const (
+	auditEventsKey     = "auditEvents"
+	auditIndexesKey    = "auditIndexes"
+	maxAuditEventIdKey = "maxAuditEventId"
+	// auditPageSize is the number of events read at once while filtering
+	auditPageSize = 1000
+)
+
+func auditUserKey(user string) string {
+	return auditEventsKey + ":user:" + user
+}
+
+func auditRouteKey(route string) string {
+	return auditEventsKey + ":route:" + route
+}
+
+// AuditDataAccess stores the audit log of api requests that change state.
+type AuditDataAccess interface {
+	// AddAuditEvent stores the event, assigning its Id.
+	AddAuditEvent(e *models.AuditEvent) error
+	// GetAuditEvents returns the events selected by the filter, most recent first.
+	GetAuditEvents(f *models.AuditFilter) ([]*models.AuditEvent, error)
+	// DeleteAuditEventsBefore removes the events older than t.
+	DeleteAuditEventsBefore(t time.Time) error
+}
+
+func (d *dataAccess) Audit() AuditDataAccess {
+	return d
+}
+
+func unixMillis(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+func (d *dataAccess) AddAuditEvent(e *models.AuditEvent) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	id, err := redis.Int64(conn.Do("INCR", maxAuditEventIdKey))
+	if err != nil {
+		return slog.Wrap(err)
+	}
+	e.Id = id
+	data, err := json.Marshal(e)
+	if err != nil {
+		return slog.Wrap(err)
+	}
+	for _, key := range []string{auditEventsKey, auditUserKey(e.User), auditRouteKey(e.Route)} {
+		if _, err = conn.Do("ZADD", key, unixMillis(e.Time), data); err != nil {
+			return slog.Wrap(err)
+		}
+	}
+	_, err = conn.Do("SADD", auditIndexesKey, auditUserKey(e.User), auditRouteKey(e.Route))
+	return slog.Wrap(err)
+}
+
+func (d *dataAccess) GetAuditEvents(f *models.AuditFilter) ([]*models.AuditEvent, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	var max, min interface{} = "+inf", "-inf"
+	if !f.End.IsZero() {
+		max = unixMillis(f.End)
+	}
+	if !f.Start.IsZero() {
+		min = unixMillis(f.Start)
+	}
+	// read the index of the user or route, which has only the events of the user or route
+	key := auditEventsKey
+	switch {
+	case f.User != "":
+		key = auditUserKey(f.User)
+	case f.Route != "":
+		key = auditRouteKey(f.Route)
+	}
+	events := []*models.AuditEvent{}
+	for offset := 0; ; offset += auditPageSize {
+		vals, err := redis.Strings(conn.Do("ZREVRANGEBYSCORE", key, max, min, "LIMIT", offset, auditPageSize))
+		if err != nil {
+			return nil, slog.Wrap(err)
+		}
+		for _, v := range vals {
+			e := &models.AuditEvent{}
+			if err := json.Unmarshal([]byte(v), e); err != nil {
+				return nil, slog.Wrap(err)
+			}
+			if !f.Matches(e) {
+				continue
+			}
+			events = append(events, e)
+			if len(events) == f.Limit {
+				return events, nil
+			}
+		}
+		if len(vals) < auditPageSize {
+			return events, nil
+		}
+	}
+}
+
+func (d *dataAccess) DeleteAuditEventsBefore(t time.Time) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	if _, err := conn.Do("ZREMRANGEBYSCORE", auditEventsKey, "-inf", unixMillis(t)-1); err != nil {
+		return slog.Wrap(err)
+	}
+	keys, err := redis.Strings(conn.Do("SMEMBERS", auditIndexesKey))
+	if err != nil {
+		return slog.Wrap(err)
+	}
+	for _, key := range keys {
+		if _, err := conn.Do("ZREMRANGEBYSCORE", key, "-inf", unixMillis(t)-1); err != nil {
+			return slog.Wrap(err)
+		}
+		n, err := redis.Int(conn.Do("ZCARD", key))
+		if err != nil {
+			return slog.Wrap(err)
+		}
+		if n == 0 {
+			if _, err := conn.Do("SREM", auditIndexesKey, key); err != nil {
+				return slog.Wrap(err)
+			}
+		}
+	}
+	return nil
+}
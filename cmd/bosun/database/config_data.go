@@ -3,6 +3,7 @@ package database
 import (
 	"crypto/md5"
 	"encoding/base64"
+	"strconv"
 
 	"github.com/garyburd/redigo/redis"
 
@@ -12,6 +13,10 @@ import (
 type ConfigDataAccess interface {
 	SaveTempConfig(text string) (hash string, err error)
 	GetTempConfig(hash string) (text string, err error)
+
+	// GetScopeBits returns the names of the auth scopes by permission bit, as last set.
+	GetScopeBits() (map[int]string, error)
+	SetScopeBit(bit int, name string) error
 }
 
 func (d *dataAccess) Configs() ConfigDataAccess {
@@ -46,3 +51,32 @@ func (d *dataAccess) GetTempConfig(hash string) (string, error) {
 	_, err = conn.Do("EXPIRE", key, configLifetime)
 	return dat, slog.Wrap(err)
 }
+
+const scopeBitsKey = "authScopeBits"
+
+func (d *dataAccess) GetScopeBits() (map[int]string, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	m, err := redis.StringMap(conn.Do("HGETALL", scopeBitsKey))
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	bits := make(map[int]string, len(m))
+	for k, name := range m {
+		bit, err := strconv.Atoi(k)
+		if err != nil {
+			return nil, slog.Wrap(err)
+		}
+		bits[bit] = name
+	}
+	return bits, nil
+}
+
+func (d *dataAccess) SetScopeBit(bit int, name string) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	_, err := conn.Do("HSET", scopeBitsKey, bit, name)
+	return slog.Wrap(err)
+}
@@ -1,10 +1,15 @@
 //Package database implements all persistent data access for bosun.
 //Internally it runs ledisdb locally, but uses a redis client to access all data.
 //Thus it should be able to migrate to a remote redis instance with minimal effort.
+//Alternatively all data can be stored in a sql database (sqlite or postgres), see NewSQLDataAccess.
 package database
 
 import (
+	"io/ioutil"
 	"log"
+	"net"
+	"os"
+	"regexp"
 	"runtime"
 	"strings"
 	"time"
@@ -12,10 +17,12 @@ import (
 	"bosun.org/collect"
 	"bosun.org/metadata"
 	"bosun.org/opentsdb"
+	"bosun.org/slog"
 	"github.com/garyburd/redigo/redis"
 	"github.com/siddontang/ledisdb/config"
 	"github.com/siddontang/ledisdb/server"
 
+	"github.com/captncraig/easyauth/providers/token"
 	"github.com/captncraig/easyauth/providers/token/redisStore"
 )
 
@@ -23,7 +30,6 @@ var SchemaVersion = int64(1)
 
 // Core data access interface for everything sched needs
 type DataAccess interface {
-	RedisConnector
 	Metadata() MetadataDataAccess
 	Configs() ConfigDataAccess
 	Search() SearchDataAccess
@@ -31,6 +37,10 @@ type DataAccess interface {
 	State() StateDataAccess
 	Silence() SilenceDataAccess
 	Notifications() NotificationDataAccess
+	Leader() LeaderDataAccess
+	Shards() ShardDataAccess
+	Audit() AuditDataAccess
+	Tokens() token.TokenDataAccess
 	Migrate() error
 }
 
@@ -39,10 +49,13 @@ type MetadataDataAccess interface {
 	PutMetricMetadata(metric string, field string, value string) error
 	// Get Metric Metadata for given metric.
 	GetMetricMetadata(metric string) (*MetricMetadata, error)
+	// Get Metric Metadata for all metrics, keyed by metric.
+	GetAllMetricMetadata() (map[string]*MetricMetadata, error)
 
 	PutTagMetadata(tags opentsdb.TagSet, name string, value string, updated time.Time) error
 	GetTagMetadata(tags opentsdb.TagSet, name string) ([]*TagMetadata, error)
 	DeleteTagMetadata(tags opentsdb.TagSet, name string) error
+	GetAllTagMetadata() ([]*TagMetadata, error)
 }
 
 type SearchDataAccess interface {
@@ -98,6 +111,39 @@ func StartLedis(dataDir string, bind string) (stop func(), err error) {
 	return app.Close, nil
 }
 
+// StartTempLedis starts an in-process ledis server on a free local port, with its data in
+// a new temporary directory, and returns a data access to it. stop stops the server and
+// removes the data.
+func StartTempLedis() (da DataAccess, stop func(), err error) {
+	dir, err := ioutil.TempDir("", "bosun-ledis")
+	if err != nil {
+		return nil, nil, err
+	}
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, nil, err
+	}
+	addr := l.Addr().String()
+	l.Close()
+	cfg := config.NewConfigDefault()
+	cfg.DBName = "goleveldb"
+	cfg.Addr = addr
+	cfg.DataDir = dir
+	app, err := server.NewApp(cfg)
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, nil, err
+	}
+	go app.Run()
+	d := newDataAccess(addr, false, 0, "")
+	return d, func() {
+		d.pool.Close()
+		app.Close()
+		os.RemoveAll(dir)
+	}, nil
+}
+
 //RedisConnector is a simple interface so things can get a raw connection (mostly tests), but still discourage it.
 // makes dataAccess interchangable with redis.Pool
 type RedisConnector interface {
@@ -126,6 +172,10 @@ func (d *dataAccess) Get() redis.Conn {
 
 var _ redisStore.Connector = (*dataAccess)(nil) //just a compile time interface check
 
+func (d *dataAccess) Tokens() token.TokenDataAccess {
+	return redisStore.New(d)
+}
+
 //gets name of function that called the currently executing function.
 func myCallerName() string {
 	fpcs := make([]uintptr, 1)
@@ -203,3 +253,41 @@ func (d *dataAccess) HSCAN() string {
 	}
 	return "XHSCAN"
 }
+
+// scanKeys returns all keys starting with prefix. Redis scans all types with SCAN and a glob,
+// ledis needs XSCAN with the data type (KV, HASH, LIST, SET or ZSET) and a regular expression.
+func (d *dataAccess) scanKeys(ledisType, prefix string) ([]string, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	keys := []string{}
+	cursor := "0"
+	if !d.isRedis {
+		cursor = ""
+	}
+	for {
+		var vals []interface{}
+		var err error
+		if d.isRedis {
+			vals, err = redis.Values(conn.Do("SCAN", cursor, "MATCH", prefix+"*", "COUNT", 1000))
+		} else {
+			vals, err = redis.Values(conn.Do("XSCAN", ledisType, cursor, "MATCH", "^"+regexp.QuoteMeta(prefix), "COUNT", 1000))
+		}
+		if err != nil {
+			return nil, slog.Wrap(err)
+		}
+		cursor, err = redis.String(vals[0], nil)
+		if err != nil {
+			return nil, slog.Wrap(err)
+		}
+		found, err := redis.Strings(vals[1], nil)
+		if err != nil {
+			return nil, slog.Wrap(err)
+		}
+		keys = append(keys, found...)
+		if cursor == "" || cursor == "0" {
+			break
+		}
+	}
+	return keys, nil
+}
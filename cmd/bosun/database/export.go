@@ -0,0 +1,301 @@
+package database
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"bosun.org/models"
+	"bosun.org/slog"
+)
+
+/*
+An archive holds the state of a bosun data store as json lines, so that it can be moved
+between data stores (ledis, redis and sql). Every line is an archiveRecord. The first line
+is the header which records the SchemaVersion of the exporting bosun. Records from archives
+with an older schema are migrated by archiveMigrations on import.
+
+Incidents are followed by their rendered templates and notification records, which carry the
+incident id in the record Id.
+*/
+
+// ArchiveVersion is the version of the archive format written by Export.
+const ArchiveVersion = 1
+
+const (
+	archiveHeader             = "header"
+	archiveIncident           = "incident"
+	archiveRenderedTemplates  = "renderedTemplates"
+	archiveNotificationRecord = "notificationRecord"
+	archiveSilence            = "silence"
+	archiveMetricMetadata     = "metricMetadata"
+	archiveTagMetadata        = "tagMetadata"
+	archiveLastInfos          = "lastInfos"
+)
+
+type archiveRecord struct {
+	Type string
+	Id   int64 `json:",omitempty"`
+	Data json.RawMessage
+}
+
+type archiveHeaderData struct {
+	ArchiveVersion int
+	SchemaVersion  int64
+	Created        time.Time
+}
+
+type archiveMetricMetadataData struct {
+	Metric string
+	*MetricMetadata
+}
+
+// ArchiveCounts is the number of records of each type that were exported or imported.
+type ArchiveCounts map[string]int
+
+// archiveMigrations convert records from archives of an older schema. The migration at
+// index i converts records of schema version i to version i+1.
+var archiveMigrations = []func(r *archiveRecord) ([]*archiveRecord, error){
+	migrateArchiveRenderedTemplates,
+}
+
+// Schema version 0 stored the rendered templates inside the incident.
+func migrateArchiveRenderedTemplates(r *archiveRecord) ([]*archiveRecord, error) {
+	if r.Type != archiveIncident {
+		return []*archiveRecord{r}, nil
+	}
+	old := &oldIncidentState{}
+	if err := json.Unmarshal(r.Data, old); err != nil {
+		return nil, err
+	}
+	incident, err := newArchiveRecord(archiveIncident, 0, old.IncidentState)
+	if err != nil {
+		return nil, err
+	}
+	records := []*archiveRecord{incident}
+	if old.RenderedTemplates != nil {
+		rt, err := newArchiveRecord(archiveRenderedTemplates, old.IncidentState.Id, old.RenderedTemplates)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, rt)
+	}
+	return records, nil
+}
+
+func newArchiveRecord(typ string, id int64, data interface{}) (*archiveRecord, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	return &archiveRecord{Type: typ, Id: id, Data: b}, nil
+}
+
+// Export writes the incidents, silences, metadata and last infos of d to w.
+func Export(d DataAccess, w io.Writer) (ArchiveCounts, error) {
+	counts := ArchiveCounts{}
+	enc := json.NewEncoder(w)
+	write := func(typ string, id int64, data interface{}) error {
+		r, err := newArchiveRecord(typ, id, data)
+		if err != nil {
+			return err
+		}
+		counts[typ]++
+		return enc.Encode(r)
+	}
+	if err := write(archiveHeader, 0, archiveHeaderData{
+		ArchiveVersion: ArchiveVersion,
+		SchemaVersion:  SchemaVersion,
+		Created:        time.Now().UTC(),
+	}); err != nil {
+		return nil, err
+	}
+
+	silences, err := d.Silence().ListSilences(0)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range silences {
+		if err := write(archiveSilence, 0, s); err != nil {
+			return nil, err
+		}
+	}
+
+	metrics, err := d.Metadata().GetAllMetricMetadata()
+	if err != nil {
+		return nil, err
+	}
+	for metric, mm := range metrics {
+		if err := write(archiveMetricMetadata, 0, archiveMetricMetadataData{metric, mm}); err != nil {
+			return nil, err
+		}
+	}
+	tags, err := d.Metadata().GetAllTagMetadata()
+	if err != nil {
+		return nil, err
+	}
+	for _, tm := range tags {
+		if err := write(archiveTagMetadata, 0, tm); err != nil {
+			return nil, err
+		}
+	}
+
+	lastInfos, err := d.Search().LoadLastInfos()
+	if err != nil {
+		slog.Warningf("not exporting last infos: %v", err)
+	} else if err := write(archiveLastInfos, 0, lastInfos); err != nil {
+		return nil, err
+	}
+
+	aks, err := d.State().GetAllAlertKeys()
+	if err != nil {
+		return nil, err
+	}
+	for _, ak := range aks {
+		incidents, err := d.State().GetAllIncidentsByAlertKey(ak)
+		if err != nil {
+			return nil, err
+		}
+		// oldest first, so the import recreates the same order
+		sort.Slice(incidents, func(i, j int) bool { return incidents[i].Id < incidents[j].Id })
+		for _, inc := range incidents {
+			if err := write(archiveIncident, 0, inc); err != nil {
+				return nil, err
+			}
+			if rt, err := d.State().GetRenderedTemplates(inc.Id); err == nil {
+				if err := write(archiveRenderedTemplates, inc.Id, rt); err != nil {
+					return nil, err
+				}
+			}
+			records, err := d.State().GetNotificationRecords(inc.Id)
+			if err != nil {
+				return nil, err
+			}
+			for _, r := range records {
+				if err := write(archiveNotificationRecord, inc.Id, r); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	delete(counts, archiveHeader)
+	return counts, nil
+}
+
+// Import restores an archive written by Export into d. Records of archives from an older
+// SchemaVersion are migrated, archives from a newer bosun are rejected.
+func Import(d DataAccess, r io.Reader) (ArchiveCounts, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("empty archive")
+	}
+	header := &archiveRecord{}
+	hd := archiveHeaderData{}
+	if err := json.Unmarshal(scanner.Bytes(), header); err != nil {
+		return nil, err
+	}
+	if header.Type != archiveHeader {
+		return nil, fmt.Errorf("archive does not start with a header")
+	}
+	if err := json.Unmarshal(header.Data, &hd); err != nil {
+		return nil, err
+	}
+	if hd.ArchiveVersion > ArchiveVersion {
+		return nil, fmt.Errorf("archive version %d is newer than the supported version %d", hd.ArchiveVersion, ArchiveVersion)
+	}
+	if hd.SchemaVersion > SchemaVersion {
+		return nil, fmt.Errorf("archive schema version %d is newer than the schema version %d of this bosun", hd.SchemaVersion, SchemaVersion)
+	}
+
+	counts := ArchiveCounts{}
+	line := 1
+	for scanner.Scan() {
+		line++
+		rec := &archiveRecord{}
+		if err := json.Unmarshal(scanner.Bytes(), rec); err != nil {
+			return nil, fmt.Errorf("line %d: %v", line, err)
+		}
+		records := []*archiveRecord{rec}
+		for v := hd.SchemaVersion; v < SchemaVersion && v < int64(len(archiveMigrations)); v++ {
+			var migrated []*archiveRecord
+			for _, r := range records {
+				m, err := archiveMigrations[v](r)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %v", line, err)
+				}
+				migrated = append(migrated, m...)
+			}
+			records = migrated
+		}
+		for _, r := range records {
+			if err := importRecord(d, r); err != nil {
+				return nil, fmt.Errorf("line %d: %v", line, err)
+			}
+			counts[r.Type]++
+		}
+	}
+	return counts, scanner.Err()
+}
+
+func importRecord(d DataAccess, r *archiveRecord) error {
+	switch r.Type {
+	case archiveIncident:
+		inc := &models.IncidentState{}
+		if err := json.Unmarshal(r.Data, inc); err != nil {
+			return err
+		}
+		return d.State().ImportIncidentState(inc)
+	case archiveRenderedTemplates:
+		rt := &models.RenderedTemplates{}
+		if err := json.Unmarshal(r.Data, rt); err != nil {
+			return err
+		}
+		return d.State().SetRenderedTemplates(r.Id, rt)
+	case archiveNotificationRecord:
+		nr := &models.NotificationRecord{}
+		if err := json.Unmarshal(r.Data, nr); err != nil {
+			return err
+		}
+		return d.State().AddNotificationRecord(r.Id, nr)
+	case archiveSilence:
+		s := &models.Silence{}
+		if err := json.Unmarshal(r.Data, s); err != nil {
+			return err
+		}
+		return d.Silence().AddSilence(s)
+	case archiveMetricMetadata:
+		mm := archiveMetricMetadataData{MetricMetadata: &MetricMetadata{}}
+		if err := json.Unmarshal(r.Data, &mm); err != nil {
+			return err
+		}
+		for field, value := range map[string]string{"desc": mm.Desc, "unit": mm.Unit, "rate": mm.Rate} {
+			if value == "" {
+				continue
+			}
+			if err := d.Metadata().PutMetricMetadata(mm.Metric, field, value); err != nil {
+				return err
+			}
+		}
+		return nil
+	case archiveTagMetadata:
+		tm := &TagMetadata{}
+		if err := json.Unmarshal(r.Data, tm); err != nil {
+			return err
+		}
+		return d.Metadata().PutTagMetadata(tm.Tags, tm.Name, tm.Value, time.Unix(tm.LastTouched, 0))
+	case archiveLastInfos:
+		m := map[string]map[string]*LastInfo{}
+		if err := json.Unmarshal(r.Data, &m); err != nil {
+			return err
+		}
+		return d.Search().BackupLastInfos(m)
+	}
+	return fmt.Errorf("unknown record type %s", r.Type)
+}
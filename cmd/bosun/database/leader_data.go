@@ -0,0 +1,150 @@
+package database
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"bosun.org/slog"
+	"github.com/garyburd/redigo/redis"
+)
+
+/*
+
+leader: "token id" of the current leader, expiring when the leader stops renewing it.
+
+leaderToken: INCR counter of the fencing tokens handed out.
+
+*/
+
+const (
+	leaderKey      = "leader"
+	leaderTokenKey = "leaderToken"
+)
+
+// LeaderDataAccess elects the leader of the bosun instances that share the data store. The
+// leader holds a lock that expires unless renewed, together with a fencing token that is
+// higher for each new leader.
+type LeaderDataAccess interface {
+	// AcquireLeader makes id the leader for ttl and returns its token if there is no
+	// leader, or renews the lock if id already leads with token. It returns 0 if another
+	// instance leads.
+	AcquireLeader(id string, token int64, ttl time.Duration) (int64, error)
+	// ReleaseLeader gives up the lock if id leads with token.
+	ReleaseLeader(id string, token int64) error
+	// GetLeader returns the current leader and its token, or an empty id if there is none.
+	GetLeader() (id string, token int64, err error)
+}
+
+func (d *dataAccess) Leader() LeaderDataAccess {
+	return d
+}
+
+var acquireLeaderScript = redis.NewScript(2, `
+local v = redis.call("GET", KEYS[1])
+if v then
+	if v ~= ARGV[2] .. " " .. ARGV[1] then
+		return 0
+	end
+	redis.call("PEXPIRE", KEYS[1], ARGV[3])
+	return tonumber(ARGV[2])
+end
+local token = redis.call("INCR", KEYS[2])
+redis.call("SET", KEYS[1], token .. " " .. ARGV[1], "PX", ARGV[3])
+return token
+`)
+
+var releaseLeaderScript = redis.NewScript(1, `
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// ledisLeaderLock makes the leader operations atomic with ledis, which has no scripting.
+// Leader election rejects the embedded ledis, so only the tests of a single process,
+// which run against ledis, use it.
+var ledisLeaderLock sync.Mutex
+
+func leaderValue(id string, token int64) string {
+	return fmt.Sprintf("%d %s", token, id)
+}
+
+func parseLeaderValue(v string) (id string, token int64, err error) {
+	f := strings.SplitN(v, " ", 2)
+	if len(f) != 2 {
+		return "", 0, fmt.Errorf("bad leader %q", v)
+	}
+	token, err = strconv.ParseInt(f[0], 10, 64)
+	return f[1], token, err
+}
+
+func (d *dataAccess) AcquireLeader(id string, token int64, ttl time.Duration) (int64, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	if d.isRedis {
+		t, err := redis.Int64(acquireLeaderScript.Do(conn, leaderKey, leaderTokenKey, id, token, int64(ttl/time.Millisecond)))
+		return t, slog.Wrap(err)
+	}
+	ledisLeaderLock.Lock()
+	defer ledisLeaderLock.Unlock()
+	secs := int64((ttl + time.Second - 1) / time.Second)
+	v, err := redis.String(conn.Do("GET", leaderKey))
+	switch {
+	case err == redis.ErrNil:
+		if token, err = redis.Int64(conn.Do("INCR", leaderTokenKey)); err != nil {
+			return 0, slog.Wrap(err)
+		}
+		if _, err = conn.Do("SET", leaderKey, leaderValue(id, token)); err != nil {
+			return 0, slog.Wrap(err)
+		}
+	case err != nil:
+		return 0, slog.Wrap(err)
+	case v != leaderValue(id, token):
+		return 0, nil
+	}
+	_, err = conn.Do("EXPIRE", leaderKey, secs)
+	return token, slog.Wrap(err)
+}
+
+func (d *dataAccess) ReleaseLeader(id string, token int64) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	if d.isRedis {
+		_, err := releaseLeaderScript.Do(conn, leaderKey, leaderValue(id, token))
+		return slog.Wrap(err)
+	}
+	ledisLeaderLock.Lock()
+	defer ledisLeaderLock.Unlock()
+	v, err := redis.String(conn.Do("GET", leaderKey))
+	if err == redis.ErrNil {
+		return nil
+	}
+	if err != nil {
+		return slog.Wrap(err)
+	}
+	if v != leaderValue(id, token) {
+		return nil
+	}
+	_, err = conn.Do("DEL", leaderKey)
+	return slog.Wrap(err)
+}
+
+func (d *dataAccess) GetLeader() (string, int64, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	v, err := redis.String(conn.Do("GET", leaderKey))
+	if err == redis.ErrNil {
+		return "", 0, nil
+	}
+	if err != nil {
+		return "", 0, slog.Wrap(err)
+	}
+	id, token, err := parseLeaderValue(v)
+	return id, token, slog.Wrap(err)
+}
@@ -52,3 +52,23 @@ func (d *dataAccess) GetMetricMetadata(metric string) (*MetricMetadata, error) {
 	}
 	return mm, nil
 }
+
+// GetAllMetricMetadata returns the metadata of every metric, keyed by metric.
+func (d *dataAccess) GetAllMetricMetadata() (map[string]*MetricMetadata, error) {
+	keys, err := d.scanKeys("HASH", "mmeta:")
+	if err != nil {
+		return nil, err
+	}
+	all := make(map[string]*MetricMetadata, len(keys))
+	for _, key := range keys {
+		metric := key[len("mmeta:"):]
+		mm, err := d.GetMetricMetadata(metric)
+		if err != nil {
+			return nil, err
+		}
+		if mm != nil {
+			all[metric] = mm
+		}
+	}
+	return all, nil
+}
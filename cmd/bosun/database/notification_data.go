@@ -1,7 +1,9 @@
 package database
 
 import (
+	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -17,10 +19,16 @@ pendingNotifications: ZSET timestamp ak:notification
 
 notsByAlert:alert SET of notifications possible per alert. used to clear alerts by alert key
 
+failedNotifications: HASH of failed notification id -> json encoded models.FailedNotification
+failedNotificationsDue: ZSET next attempt timestamp -> failed notification id. Ids are removed when retries are exhausted.
+
 */
 
 const (
-	pendingNotificationsKey = "pendingNotifications"
+	pendingNotificationsKey    = "pendingNotifications"
+	failedNotificationsKey     = "failedNotifications"
+	failedNotificationsDueKey  = "failedNotificationsDue"
+	maxFailedNotificationIdKey = "maxFailedNotificationId"
 )
 
 func notsByAlertKeyKey(ak models.AlertKey) string {
@@ -38,7 +46,18 @@ type NotificationDataAccess interface {
 
 	ClearNotifications(ak models.AlertKey) error
 
+	// ClearNotification removes the pending notification of the alert key, whenever it is due.
+	ClearNotification(ak models.AlertKey, notification string) error
+
 	GetNextNotificationTime() (time.Time, error)
+
+	// PutFailedNotification stores a failed delivery, assigning an Id if it does not have one.
+	PutFailedNotification(f *models.FailedNotification) error
+	// GetDueFailedNotifications returns failed deliveries whose next attempt is due.
+	GetDueFailedNotifications() ([]*models.FailedNotification, error)
+	// GetFailedNotifications returns all failed deliveries, including those that will not be retried.
+	GetFailedNotifications() ([]*models.FailedNotification, error)
+	DeleteFailedNotification(id int64) error
 }
 
 func (d *dataAccess) Notifications() NotificationDataAccess {
@@ -109,6 +128,14 @@ func (d *dataAccess) ClearNotifications(ak models.AlertKey) error {
 	return slog.Wrap(err)
 }
 
+func (d *dataAccess) ClearNotification(ak models.AlertKey, notification string) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	_, err := conn.Do("ZREM", pendingNotificationsKey, fmt.Sprintf("%s:%s", ak, notification))
+	return slog.Wrap(err)
+}
+
 func (d *dataAccess) GetNextNotificationTime() (time.Time, error) {
 	conn := d.Get()
 	defer conn.Close()
@@ -124,3 +151,93 @@ func (d *dataAccess) GetNextNotificationTime() (time.Time, error) {
 	}
 	return t, nil
 }
+
+func (d *dataAccess) PutFailedNotification(f *models.FailedNotification) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	if f.Id == 0 {
+		id, err := redis.Int64(conn.Do("INCR", maxFailedNotificationIdKey))
+		if err != nil {
+			return slog.Wrap(err)
+		}
+		f.Id = id
+	}
+	data, err := json.Marshal(f)
+	if err != nil {
+		return slog.Wrap(err)
+	}
+	if _, err = conn.Do("HSET", failedNotificationsKey, f.Id, data); err != nil {
+		return slog.Wrap(err)
+	}
+	if f.GaveUp() {
+		_, err = conn.Do("ZREM", failedNotificationsDueKey, f.Id)
+	} else {
+		_, err = conn.Do("ZADD", failedNotificationsDueKey, f.NextAttempt.UTC().Unix(), f.Id)
+	}
+	return slog.Wrap(err)
+}
+
+func (d *dataAccess) GetDueFailedNotifications() ([]*models.FailedNotification, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	ids, err := redis.Values(conn.Do("ZRANGEBYSCORE", failedNotificationsDueKey, 0, time.Now().UTC().Unix()))
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	vals, err := redis.Strings(conn.Do("HMGET", append([]interface{}{failedNotificationsKey}, ids...)...))
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	return unmarshalFailedNotifications(vals)
+}
+
+func (d *dataAccess) GetFailedNotifications() ([]*models.FailedNotification, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	vals, err := redis.StringMap(conn.Do("HGETALL", failedNotificationsKey))
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	data := make([]string, 0, len(vals))
+	for _, v := range vals {
+		data = append(data, v)
+	}
+	fs, err := unmarshalFailedNotifications(data)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(fs, func(i, j int) bool { return fs[i].Id < fs[j].Id })
+	return fs, nil
+}
+
+func unmarshalFailedNotifications(data []string) ([]*models.FailedNotification, error) {
+	fs := make([]*models.FailedNotification, 0, len(data))
+	for _, v := range data {
+		if v == "" {
+			continue
+		}
+		f := &models.FailedNotification{}
+		if err := json.Unmarshal([]byte(v), f); err != nil {
+			return nil, slog.Wrap(err)
+		}
+		fs = append(fs, f)
+	}
+	return fs, nil
+}
+
+func (d *dataAccess) DeleteFailedNotification(id int64) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	if _, err := conn.Do("ZREM", failedNotificationsDueKey, id); err != nil {
+		return slog.Wrap(err)
+	}
+	_, err := conn.Do("HDEL", failedNotificationsKey, id)
+	return slog.Wrap(err)
+}
@@ -0,0 +1,144 @@
+package database
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"bosun.org/slog"
+	"github.com/garyburd/redigo/redis"
+)
+
+/*
+
+shardWorkers: ZSET expiry in unix milliseconds -> id of a worker that evaluates a share of the alerts
+
+alertLease:{name}: id of the worker that holds the lease of the alert, expiring unless renewed
+
+*/
+
+const shardWorkersKey = "shardWorkers"
+
+func alertLeaseKey(alert string) string {
+	return "alertLease:" + alert
+}
+
+// ShardDataAccess keeps the membership of the bosun workers that share the alerts
+// between them. A membership expires unless renewed.
+type ShardDataAccess interface {
+	// PutShardWorker adds id to the workers for ttl, or renews its membership.
+	PutShardWorker(id string, ttl time.Duration) error
+	// DeleteShardWorker removes id from the workers.
+	DeleteShardWorker(id string) error
+	// GetShardWorkers returns the ids of the workers whose membership has not expired,
+	// sorted.
+	GetShardWorkers() ([]string, error)
+
+	// AcquireAlertLease gives id the lease of the alert for ttl if no other worker holds
+	// it, or renews it if id holds it. It returns false if another worker holds it.
+	AcquireAlertLease(alert, id string, ttl time.Duration) (bool, error)
+	// ReleaseAlertLease gives up the lease of the alert if id holds it.
+	ReleaseAlertLease(alert, id string) error
+}
+
+func (d *dataAccess) Shards() ShardDataAccess {
+	return d
+}
+
+func nowMillis() int64 {
+	return time.Now().UnixNano() / int64(time.Millisecond)
+}
+
+func (d *dataAccess) PutShardWorker(id string, ttl time.Duration) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	now := nowMillis()
+	if _, err := conn.Do("ZADD", shardWorkersKey, now+int64(ttl/time.Millisecond), id); err != nil {
+		return slog.Wrap(err)
+	}
+	_, err := conn.Do("ZREMRANGEBYSCORE", shardWorkersKey, 0, now)
+	return slog.Wrap(err)
+}
+
+func (d *dataAccess) DeleteShardWorker(id string) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	_, err := conn.Do("ZREM", shardWorkersKey, id)
+	return slog.Wrap(err)
+}
+
+func (d *dataAccess) GetShardWorkers() ([]string, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	ids, err := redis.Strings(conn.Do("ZRANGEBYSCORE", shardWorkersKey, nowMillis()+1, "+inf"))
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+var acquireAlertLeaseScript = redis.NewScript(1, `
+local v = redis.call("GET", KEYS[1])
+if v and v ~= ARGV[1] then
+	return 0
+end
+redis.call("SET", KEYS[1], ARGV[1], "PX", ARGV[2])
+return 1
+`)
+
+// ledisAlertLeaseLock makes the lease operations atomic with ledis, like ledisLeaderLock.
+var ledisAlertLeaseLock sync.Mutex
+
+func (d *dataAccess) AcquireAlertLease(alert, id string, ttl time.Duration) (bool, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	if d.isRedis {
+		ok, err := redis.Bool(acquireAlertLeaseScript.Do(conn, alertLeaseKey(alert), id, int64(ttl/time.Millisecond)))
+		return ok, slog.Wrap(err)
+	}
+	ledisAlertLeaseLock.Lock()
+	defer ledisAlertLeaseLock.Unlock()
+	v, err := redis.String(conn.Do("GET", alertLeaseKey(alert)))
+	switch {
+	case err == redis.ErrNil:
+		if _, err = conn.Do("SET", alertLeaseKey(alert), id); err != nil {
+			return false, slog.Wrap(err)
+		}
+	case err != nil:
+		return false, slog.Wrap(err)
+	case v != id:
+		return false, nil
+	}
+	_, err = conn.Do("EXPIRE", alertLeaseKey(alert), int64((ttl+time.Second-1)/time.Second))
+	return true, slog.Wrap(err)
+}
+
+func (d *dataAccess) ReleaseAlertLease(alert, id string) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	if d.isRedis {
+		// the same compare and delete as for the leader lock
+		_, err := releaseLeaderScript.Do(conn, alertLeaseKey(alert), id)
+		return slog.Wrap(err)
+	}
+	ledisAlertLeaseLock.Lock()
+	defer ledisAlertLeaseLock.Unlock()
+	v, err := redis.String(conn.Do("GET", alertLeaseKey(alert)))
+	if err == redis.ErrNil {
+		return nil
+	}
+	if err != nil {
+		return slog.Wrap(err)
+	}
+	if v != id {
+		return nil
+	}
+	_, err = conn.Do("DEL", alertLeaseKey(alert))
+	return slog.Wrap(err)
+}
@@ -79,6 +79,7 @@ func getSilences(ids []string, conn redis.Conn) ([]*models.Silence, error) {
 		if err := json.Unmarshal([]byte(j), s); err != nil {
 			return nil, err
 		}
+		s.Compile()
 		silences = append(silences, s)
 	}
 	return silences, nil
@@ -0,0 +1,252 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"bosun.org/collect"
+	"bosun.org/metadata"
+	"bosun.org/opentsdb"
+	"bosun.org/slog"
+
+	"github.com/captncraig/easyauth/providers/token"
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+/*
+The sql implementation stores the same data as the redis implementation in relational tables.
+Structured values (incidents, silences, rendered templates, ...) are stored as json text so
+the schema only needs TEXT and BIGINT columns and works unchanged with sqlite and postgres.
+Booleans are stored as 0 or 1. Ids are assigned from the sequences table, which mirrors
+the INCR counters used with redis.
+*/
+
+// SQLDrivers are the database/sql driver names that NewSQLDataAccess supports.
+var SQLDrivers = []string{"postgres", "sqlite3"}
+
+var sqlSchema = []string{
+	`CREATE TABLE IF NOT EXISTS schema_version (version BIGINT NOT NULL)`,
+	`CREATE TABLE IF NOT EXISTS sequences (name TEXT PRIMARY KEY, value BIGINT NOT NULL)`,
+
+	`CREATE TABLE IF NOT EXISTS temp_configs (hash TEXT PRIMARY KEY, text TEXT NOT NULL, expires BIGINT NOT NULL)`,
+	`CREATE TABLE IF NOT EXISTS auth_scope_bits (bit BIGINT PRIMARY KEY, name TEXT NOT NULL)`,
+
+	`CREATE TABLE IF NOT EXISTS metric_metadata (metric TEXT PRIMARY KEY, descr TEXT NOT NULL, unit TEXT NOT NULL, rate TEXT NOT NULL, last_touched BIGINT NOT NULL)`,
+	`CREATE TABLE IF NOT EXISTS tag_metadata (tags TEXT NOT NULL, name TEXT NOT NULL, value TEXT NOT NULL, last_touched BIGINT NOT NULL, PRIMARY KEY (tags, name))`,
+	`CREATE TABLE IF NOT EXISTS tag_metadata_index (tagk TEXT NOT NULL, tagv TEXT NOT NULL, tags TEXT NOT NULL, name TEXT NOT NULL, PRIMARY KEY (tagk, tagv, tags, name))`,
+
+	`CREATE TABLE IF NOT EXISTS search_metrics_by_tag (tagk TEXT NOT NULL, tagv TEXT NOT NULL, metric TEXT NOT NULL, time BIGINT NOT NULL, PRIMARY KEY (tagk, tagv, metric))`,
+	`CREATE TABLE IF NOT EXISTS search_tagk (metric TEXT NOT NULL, tagk TEXT NOT NULL, time BIGINT NOT NULL, PRIMARY KEY (metric, tagk))`,
+	`CREATE TABLE IF NOT EXISTS search_tagv (metric TEXT NOT NULL, tagk TEXT NOT NULL, tagv TEXT NOT NULL, time BIGINT NOT NULL, PRIMARY KEY (metric, tagk, tagv))`,
+	`CREATE TABLE IF NOT EXISTS search_metrics (metric TEXT PRIMARY KEY, time BIGINT NOT NULL)`,
+	`CREATE TABLE IF NOT EXISTS search_tagsets (metric TEXT NOT NULL, tagset TEXT NOT NULL, time BIGINT NOT NULL, PRIMARY KEY (metric, tagset))`,
+	`CREATE TABLE IF NOT EXISTS search_last (id BIGINT PRIMARY KEY, data TEXT NOT NULL)`,
+
+	`CREATE TABLE IF NOT EXISTS failing_alerts (alert TEXT PRIMARY KEY)`,
+	`CREATE TABLE IF NOT EXISTS alert_errors (id BIGINT PRIMARY KEY, alert TEXT NOT NULL, first_time BIGINT NOT NULL, last_time BIGINT NOT NULL, count BIGINT NOT NULL, message TEXT NOT NULL)`,
+	`CREATE INDEX IF NOT EXISTS alert_errors_alert ON alert_errors (alert)`,
+
+	`CREATE TABLE IF NOT EXISTS silences (id TEXT PRIMARY KEY, end_time BIGINT NOT NULL, data TEXT NOT NULL)`,
+
+	`CREATE TABLE IF NOT EXISTS pending_notifications (alert_key TEXT NOT NULL, notification TEXT NOT NULL, due BIGINT NOT NULL, PRIMARY KEY (alert_key, notification))`,
+	`CREATE TABLE IF NOT EXISTS failed_notifications (id BIGINT PRIMARY KEY, next_attempt BIGINT, data TEXT NOT NULL)`,
+
+	`CREATE TABLE IF NOT EXISTS incidents (id BIGINT PRIMARY KEY, alert_key TEXT NOT NULL, open BIGINT NOT NULL, data TEXT NOT NULL)`,
+	`CREATE INDEX IF NOT EXISTS incidents_alert_key ON incidents (alert_key)`,
+	`CREATE TABLE IF NOT EXISTS rendered_templates (incident_id BIGINT PRIMARY KEY, data TEXT NOT NULL)`,
+	`CREATE TABLE IF NOT EXISTS notification_records (id BIGINT PRIMARY KEY, incident_id BIGINT NOT NULL, data TEXT NOT NULL)`,
+	`CREATE INDEX IF NOT EXISTS notification_records_incident ON notification_records (incident_id)`,
+	`CREATE TABLE IF NOT EXISTS run_snapshots (id BIGINT PRIMARY KEY, stored BIGINT NOT NULL, data TEXT NOT NULL)`,
+	`CREATE TABLE IF NOT EXISTS incident_snapshots (incident_id BIGINT PRIMARY KEY, snapshot_id BIGINT NOT NULL)`,
+	`CREATE TABLE IF NOT EXISTS alert_keys (alert_key TEXT PRIMARY KEY, alert TEXT NOT NULL, unknown BIGINT NOT NULL DEFAULT 0, unevaluated BIGINT NOT NULL DEFAULT 0, last_touched BIGINT)`,
+	`CREATE INDEX IF NOT EXISTS alert_keys_alert ON alert_keys (alert)`,
+
+	`CREATE TABLE IF NOT EXISTS leader (id BIGINT PRIMARY KEY, holder TEXT NOT NULL, token BIGINT NOT NULL, expires BIGINT NOT NULL)`,
+	`CREATE TABLE IF NOT EXISTS shard_workers (id TEXT PRIMARY KEY, expires BIGINT NOT NULL)`,
+	`CREATE TABLE IF NOT EXISTS alert_leases (alert TEXT PRIMARY KEY, holder TEXT NOT NULL, expires BIGINT NOT NULL)`,
+
+	`CREATE TABLE IF NOT EXISTS audit_events (id BIGINT PRIMARY KEY, time BIGINT NOT NULL, username TEXT NOT NULL, route TEXT NOT NULL, data TEXT NOT NULL)`,
+	`CREATE INDEX IF NOT EXISTS audit_events_time ON audit_events (time)`,
+
+	`CREATE TABLE IF NOT EXISTS tokens (hash TEXT PRIMARY KEY, data TEXT NOT NULL, last_used BIGINT)`,
+}
+
+type sqlDataAccess struct {
+	db       *sql.DB
+	postgres bool
+}
+
+// NewSQLDataAccess creates a data access object that stores everything in a sql database
+// through database/sql. driver must be one of SQLDrivers and dataSource is passed to the
+// driver unchanged, i.e. a file name for sqlite3 or a connection string for postgres.
+func NewSQLDataAccess(driver, dataSource string) (DataAccess, error) {
+	known := false
+	for _, d := range SQLDrivers {
+		known = known || d == driver
+	}
+	if !known {
+		return nil, fmt.Errorf("unknown sql driver %s, must be one of %s", driver, strings.Join(SQLDrivers, ", "))
+	}
+	if driver == "sqlite3" && !sqliteSupported {
+		return nil, fmt.Errorf("sql driver sqlite3 needs a bosun built with cgo (CGO_ENABLED=1)")
+	}
+	db, err := sql.Open(driver, dataSource)
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	if driver == "sqlite3" {
+		// sqlite only allows a single writer, so serialize access through one connection
+		// instead of failing with "database is locked".
+		db.SetMaxOpenConns(1)
+	}
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, slog.Wrap(err)
+	}
+	return &sqlDataAccess{db: db, postgres: driver == "postgres"}, nil
+}
+
+// NewMemoryDataAccess creates a data access object that keeps everything in an in-memory
+// sqlite database, for throw-away state. close releases the database.
+func NewMemoryDataAccess() (da DataAccess, close func() error, err error) {
+	da, err = NewSQLDataAccess("sqlite3", ":memory:")
+	if err != nil {
+		return nil, nil, err
+	}
+	d := da.(*sqlDataAccess)
+	if err := d.Migrate(); err != nil {
+		d.db.Close()
+		return nil, nil, err
+	}
+	return d, d.db.Close, nil
+}
+
+func init() {
+	collect.AggregateMeta("bosun.sql", metadata.MilliSecond, "time in milliseconds per sql data access call.")
+}
+
+// q rewrites the ? placeholders of a query to the numbered placeholders postgres expects.
+func (d *sqlDataAccess) q(query string) string {
+	if !d.postgres {
+		return query
+	}
+	var b strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// sqlQueryer is implemented by both *sql.DB and *sql.Tx.
+type sqlQueryer interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+	QueryRow(query string, args ...interface{}) *sql.Row
+}
+
+// timer starts a timer for bosun stats on the calling operation, like Get does for redis.
+func (d *sqlDataAccess) timer() func() {
+	return collect.StartTimer("sql", opentsdb.TagSet{"op": myCallerName()})
+}
+
+func (d *sqlDataAccess) exec(query string, args ...interface{}) error {
+	_, err := d.db.Exec(d.q(query), args...)
+	return slog.Wrap(err)
+}
+
+func (d *sqlDataAccess) transact(f func(tx *sql.Tx) error) error {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return slog.Wrap(err)
+	}
+	if err := f(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return slog.Wrap(tx.Commit())
+}
+
+// nextId increments and returns the named sequence.
+func (d *sqlDataAccess) nextId(tx sqlQueryer, name string) (int64, error) {
+	if _, err := tx.Exec(d.q(`INSERT INTO sequences (name, value) VALUES (?, 0) ON CONFLICT (name) DO NOTHING`), name); err != nil {
+		return 0, slog.Wrap(err)
+	}
+	if _, err := tx.Exec(d.q(`UPDATE sequences SET value = value + 1 WHERE name = ?`), name); err != nil {
+		return 0, slog.Wrap(err)
+	}
+	var id int64
+	err := tx.QueryRow(d.q(`SELECT value FROM sequences WHERE name = ?`), name).Scan(&id)
+	return id, slog.Wrap(err)
+}
+
+// queryStrings runs a query selecting a single text column.
+func (d *sqlDataAccess) queryStrings(query string, args ...interface{}) ([]string, error) {
+	rows, err := d.db.Query(d.q(query), args...)
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	defer rows.Close()
+	var vals []string
+	for rows.Next() {
+		var v string
+		if err := rows.Scan(&v); err != nil {
+			return nil, slog.Wrap(err)
+		}
+		vals = append(vals, v)
+	}
+	return vals, slog.Wrap(rows.Err())
+}
+
+// queryInt64Map runs a query selecting a text and an integer column.
+func (d *sqlDataAccess) queryInt64Map(query string, args ...interface{}) (map[string]int64, error) {
+	rows, err := d.db.Query(d.q(query), args...)
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	defer rows.Close()
+	m := make(map[string]int64)
+	for rows.Next() {
+		var k string
+		var v int64
+		if err := rows.Scan(&k, &v); err != nil {
+			return nil, slog.Wrap(err)
+		}
+		m[k] = v
+	}
+	return m, slog.Wrap(rows.Err())
+}
+
+func boolInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func (d *sqlDataAccess) Migrate() error {
+	slog.Infoln("checking sql schema")
+	for _, stmt := range sqlSchema {
+		if err := d.exec(stmt); err != nil {
+			return err
+		}
+	}
+	var version int64
+	err := d.db.QueryRow(`SELECT version FROM schema_version`).Scan(&version)
+	if err == sql.ErrNoRows {
+		return d.exec(`INSERT INTO schema_version (version) VALUES (?)`, SchemaVersion)
+	}
+	return slog.Wrap(err)
+}
+
+func (d *sqlDataAccess) Tokens() token.TokenDataAccess {
+	return d
+}
@@ -0,0 +1,88 @@
+package database
+
+import (
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"bosun.org/models"
+	"bosun.org/slog"
+)
+
+// The columns besides data are those AuditFilter selects by, times are unix
+// milliseconds like the scores of the redis implementation.
+
+func (d *sqlDataAccess) Audit() AuditDataAccess {
+	return d
+}
+
+func (d *sqlDataAccess) AddAuditEvent(e *models.AuditEvent) error {
+	defer d.timer()()
+
+	return d.transact(func(tx *sql.Tx) error {
+		id, err := d.nextId(tx, "auditEvent")
+		if err != nil {
+			return err
+		}
+		e.Id = id
+		data, err := json.Marshal(e)
+		if err != nil {
+			return slog.Wrap(err)
+		}
+		_, err = tx.Exec(d.q(`INSERT INTO audit_events (id, time, username, route, data) VALUES (?, ?, ?, ?, ?)`),
+			e.Id, unixMillis(e.Time), e.User, e.Route, string(data))
+		return slog.Wrap(err)
+	})
+}
+
+func (d *sqlDataAccess) GetAuditEvents(f *models.AuditFilter) ([]*models.AuditEvent, error) {
+	defer d.timer()()
+
+	var where []string
+	var args []interface{}
+	if !f.Start.IsZero() {
+		where = append(where, "time >= ?")
+		args = append(args, unixMillis(f.Start))
+	}
+	if !f.End.IsZero() {
+		where = append(where, "time <= ?")
+		args = append(args, unixMillis(f.End))
+	}
+	if f.User != "" {
+		where = append(where, "username = ?")
+		args = append(args, f.User)
+	}
+	if f.Route != "" {
+		where = append(where, "route = ?")
+		args = append(args, f.Route)
+	}
+	query := `SELECT data FROM audit_events`
+	if len(where) > 0 {
+		query += ` WHERE ` + strings.Join(where, " AND ")
+	}
+	query += ` ORDER BY time DESC, id DESC`
+	if f.Limit > 0 {
+		query += ` LIMIT ?`
+		args = append(args, f.Limit)
+	}
+	data, err := d.queryStrings(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]*models.AuditEvent, 0, len(data))
+	for _, v := range data {
+		e := &models.AuditEvent{}
+		if err := json.Unmarshal([]byte(v), e); err != nil {
+			return nil, slog.Wrap(err)
+		}
+		events = append(events, e)
+	}
+	return events, nil
+}
+
+func (d *sqlDataAccess) DeleteAuditEventsBefore(t time.Time) error {
+	defer d.timer()()
+
+	return d.exec(`DELETE FROM audit_events WHERE time < ?`, unixMillis(t))
+}
@@ -0,0 +1,72 @@
+package database
+
+import (
+	"crypto/md5"
+	"database/sql"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"bosun.org/slog"
+)
+
+func (d *sqlDataAccess) Configs() ConfigDataAccess {
+	return d
+}
+
+func (d *sqlDataAccess) SaveTempConfig(text string) (string, error) {
+	defer d.timer()()
+
+	sig := md5.Sum([]byte(text))
+	b64 := base64.StdEncoding.EncodeToString(sig[0:8])
+	now := time.Now().UTC().Unix()
+	if err := d.exec(`DELETE FROM temp_configs WHERE expires < ?`, now); err != nil {
+		return "", err
+	}
+	err := d.exec(`INSERT INTO temp_configs (hash, text, expires) VALUES (?, ?, ?)
+		ON CONFLICT (hash) DO UPDATE SET text = excluded.text, expires = excluded.expires`,
+		b64, text, now+configLifetime)
+	return b64, err
+}
+
+func (d *sqlDataAccess) GetTempConfig(hash string) (string, error) {
+	defer d.timer()()
+
+	var text string
+	now := time.Now().UTC().Unix()
+	err := d.db.QueryRow(d.q(`SELECT text FROM temp_configs WHERE hash = ? AND expires >= ?`), hash, now).Scan(&text)
+	if err == sql.ErrNoRows {
+		return "", fmt.Errorf("temp config %s not found", hash)
+	}
+	if err != nil {
+		return "", slog.Wrap(err)
+	}
+	return text, d.exec(`UPDATE temp_configs SET expires = ? WHERE hash = ?`, now+configLifetime, hash)
+}
+
+func (d *sqlDataAccess) GetScopeBits() (map[int]string, error) {
+	defer d.timer()()
+
+	rows, err := d.db.Query(`SELECT bit, name FROM auth_scope_bits`)
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	defer rows.Close()
+	bits := make(map[int]string)
+	for rows.Next() {
+		var bit int
+		var name string
+		if err := rows.Scan(&bit, &name); err != nil {
+			return nil, slog.Wrap(err)
+		}
+		bits[bit] = name
+	}
+	return bits, slog.Wrap(rows.Err())
+}
+
+func (d *sqlDataAccess) SetScopeBit(bit int, name string) error {
+	defer d.timer()()
+
+	return d.exec(`INSERT INTO auth_scope_bits (bit, name) VALUES (?, ?)
+		ON CONFLICT (bit) DO UPDATE SET name = excluded.name`, bit, name)
+}
@@ -0,0 +1,137 @@
+package database
+
+import (
+	"database/sql"
+	"time"
+
+	"bosun.org/models"
+	"bosun.org/slog"
+)
+
+func (d *sqlDataAccess) Errors() ErrorDataAccess {
+	return d
+}
+
+func (d *sqlDataAccess) MarkAlertSuccess(name string) error {
+	defer d.timer()()
+
+	return d.exec(`DELETE FROM failing_alerts WHERE alert = ?`, name)
+}
+
+// MarkAlertFailure coalesces consecutive failures with the same message into a single
+// error event, like the redis implementation.
+func (d *sqlDataAccess) MarkAlertFailure(name string, msg string) error {
+	defer d.timer()()
+
+	now := time.Now().UTC().Truncate(time.Second).Unix()
+	return d.transact(func(tx *sql.Tx) error {
+		var failing int
+		if err := tx.QueryRow(d.q(`SELECT COUNT(*) FROM failing_alerts WHERE alert = ?`), name).Scan(&failing); err != nil {
+			return slog.Wrap(err)
+		}
+		if failing > 0 {
+			var id int64
+			var message string
+			err := tx.QueryRow(d.q(`SELECT id, message FROM alert_errors WHERE alert = ? ORDER BY id DESC LIMIT 1`), name).Scan(&id, &message)
+			if err != nil && err != sql.ErrNoRows {
+				return slog.Wrap(err)
+			}
+			if err == nil && message == msg {
+				_, err = tx.Exec(d.q(`UPDATE alert_errors SET count = count + 1, last_time = ? WHERE id = ?`), now, id)
+				return slog.Wrap(err)
+			}
+		} else if _, err := tx.Exec(d.q(`INSERT INTO failing_alerts (alert) VALUES (?)`), name); err != nil {
+			return slog.Wrap(err)
+		}
+		id, err := d.nextId(tx, "alertError")
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(d.q(`INSERT INTO alert_errors (id, alert, first_time, last_time, count, message) VALUES (?, ?, ?, ?, 1, ?)`),
+			id, name, now, now, msg)
+		return slog.Wrap(err)
+	})
+}
+
+func (d *sqlDataAccess) GetFailingAlertCounts() (int, int, error) {
+	defer d.timer()()
+
+	var failing int
+	var events sql.NullInt64
+	if err := d.db.QueryRow(`SELECT COUNT(*) FROM failing_alerts`).Scan(&failing); err != nil {
+		return 0, 0, slog.Wrap(err)
+	}
+	if err := d.db.QueryRow(`SELECT SUM(count) FROM alert_errors`).Scan(&events); err != nil {
+		return 0, 0, slog.Wrap(err)
+	}
+	return failing, int(events.Int64), nil
+}
+
+func (d *sqlDataAccess) GetFailingAlerts() (map[string]bool, error) {
+	defer d.timer()()
+
+	alerts, err := d.queryStrings(`SELECT alert FROM failing_alerts`)
+	if err != nil {
+		return nil, err
+	}
+	r := make(map[string]bool, len(alerts))
+	for _, a := range alerts {
+		r[a] = true
+	}
+	return r, nil
+}
+
+func (d *sqlDataAccess) IsAlertFailing(name string) (bool, error) {
+	defer d.timer()()
+
+	var failing int
+	err := d.db.QueryRow(d.q(`SELECT COUNT(*) FROM failing_alerts WHERE alert = ?`), name).Scan(&failing)
+	return failing > 0, slog.Wrap(err)
+}
+
+func (d *sqlDataAccess) GetFullErrorHistory() (map[string][]*models.AlertError, error) {
+	defer d.timer()()
+
+	rows, err := d.db.Query(`SELECT alert, first_time, last_time, count, message FROM alert_errors ORDER BY id DESC`)
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	defer rows.Close()
+	results := make(map[string][]*models.AlertError)
+	for rows.Next() {
+		var alert string
+		var first, last int64
+		ae := &models.AlertError{}
+		if err := rows.Scan(&alert, &first, &last, &ae.Count, &ae.Message); err != nil {
+			return nil, slog.Wrap(err)
+		}
+		ae.FirstTime = time.Unix(first, 0).UTC()
+		ae.LastTime = time.Unix(last, 0).UTC()
+		results[alert] = append(results[alert], ae)
+	}
+	return results, slog.Wrap(rows.Err())
+}
+
+func (d *sqlDataAccess) ClearAlert(name string) error {
+	defer d.timer()()
+
+	return d.transact(func(tx *sql.Tx) error {
+		if _, err := tx.Exec(d.q(`DELETE FROM failing_alerts WHERE alert = ?`), name); err != nil {
+			return slog.Wrap(err)
+		}
+		_, err := tx.Exec(d.q(`DELETE FROM alert_errors WHERE alert = ?`), name)
+		return slog.Wrap(err)
+	})
+}
+
+func (d *sqlDataAccess) ClearAll() error {
+	defer d.timer()()
+
+	return d.transact(func(tx *sql.Tx) error {
+		if _, err := tx.Exec(`DELETE FROM failing_alerts`); err != nil {
+			return slog.Wrap(err)
+		}
+		_, err := tx.Exec(`DELETE FROM alert_errors`)
+		return slog.Wrap(err)
+	})
+}
@@ -0,0 +1,67 @@
+package database
+
+import (
+	"database/sql"
+	"time"
+
+	"bosun.org/slog"
+)
+
+// The lock is the single row of the leader table. Its expiry is in unix milliseconds of
+// the clocks of the instances, which should therefore be in sync.
+
+func (d *sqlDataAccess) Leader() LeaderDataAccess {
+	return d
+}
+
+func (d *sqlDataAccess) AcquireLeader(id string, token int64, ttl time.Duration) (int64, error) {
+	defer d.timer()()
+
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	expires := now + int64(ttl/time.Millisecond)
+	var acquired int64
+	err := d.transact(func(tx *sql.Tx) error {
+		res, err := tx.Exec(d.q(`UPDATE leader SET expires = ? WHERE id = 1 AND holder = ? AND token = ? AND expires > ?`), expires, id, token, now)
+		if err != nil {
+			return slog.Wrap(err)
+		}
+		if n, err := res.RowsAffected(); err != nil || n == 1 {
+			acquired = token
+			return slog.Wrap(err)
+		}
+		if _, err := tx.Exec(d.q(`INSERT INTO leader (id, holder, token, expires) VALUES (1, '', 0, 0) ON CONFLICT (id) DO NOTHING`)); err != nil {
+			return slog.Wrap(err)
+		}
+		res, err = tx.Exec(d.q(`UPDATE leader SET holder = ?, token = token + 1, expires = ? WHERE id = 1 AND expires <= ?`), id, expires, now)
+		if err != nil {
+			return slog.Wrap(err)
+		}
+		if n, err := res.RowsAffected(); err != nil || n == 0 {
+			return slog.Wrap(err)
+		}
+		return slog.Wrap(tx.QueryRow(`SELECT token FROM leader WHERE id = 1`).Scan(&acquired))
+	})
+	if err != nil {
+		return 0, err
+	}
+	return acquired, nil
+}
+
+func (d *sqlDataAccess) ReleaseLeader(id string, token int64) error {
+	defer d.timer()()
+
+	return d.exec(`UPDATE leader SET expires = 0 WHERE id = 1 AND holder = ? AND token = ?`, id, token)
+}
+
+func (d *sqlDataAccess) GetLeader() (string, int64, error) {
+	defer d.timer()()
+
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	var id string
+	var token int64
+	err := d.db.QueryRow(d.q(`SELECT holder, token FROM leader WHERE id = 1 AND expires > ?`), now).Scan(&id, &token)
+	if err == sql.ErrNoRows {
+		return "", 0, nil
+	}
+	return id, token, slog.Wrap(err)
+}
@@ -0,0 +1,166 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"bosun.org/opentsdb"
+	"bosun.org/slog"
+)
+
+func (d *sqlDataAccess) Metadata() MetadataDataAccess {
+	return d
+}
+
+func (d *sqlDataAccess) PutMetricMetadata(metric string, field string, value string) error {
+	defer d.timer()()
+
+	// desc is a reserved word in sql
+	column := map[string]string{"desc": "descr", "unit": "unit", "rate": "rate"}[field]
+	if column == "" {
+		return fmt.Errorf("Unknown metric metadata field: %s", field)
+	}
+	now := time.Now().UTC().Unix()
+	return d.transact(func(tx *sql.Tx) error {
+		if _, err := tx.Exec(d.q(`INSERT INTO metric_metadata (metric, descr, unit, rate, last_touched) VALUES (?, '', '', '', ?)
+			ON CONFLICT (metric) DO NOTHING`), metric, now); err != nil {
+			return slog.Wrap(err)
+		}
+		_, err := tx.Exec(d.q(`UPDATE metric_metadata SET `+column+` = ?, last_touched = ? WHERE metric = ?`), value, now, metric)
+		return slog.Wrap(err)
+	})
+}
+
+func (d *sqlDataAccess) GetMetricMetadata(metric string) (*MetricMetadata, error) {
+	defer d.timer()()
+
+	mm := &MetricMetadata{}
+	err := d.db.QueryRow(d.q(`SELECT descr, unit, rate, last_touched FROM metric_metadata WHERE metric = ?`), metric).
+		Scan(&mm.Desc, &mm.Unit, &mm.Rate, &mm.LastTouched)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	return mm, nil
+}
+
+func (d *sqlDataAccess) GetAllMetricMetadata() (map[string]*MetricMetadata, error) {
+	defer d.timer()()
+
+	rows, err := d.db.Query(`SELECT metric, descr, unit, rate, last_touched FROM metric_metadata`)
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	defer rows.Close()
+	all := make(map[string]*MetricMetadata)
+	for rows.Next() {
+		var metric string
+		mm := &MetricMetadata{}
+		if err := rows.Scan(&metric, &mm.Desc, &mm.Unit, &mm.Rate, &mm.LastTouched); err != nil {
+			return nil, slog.Wrap(err)
+		}
+		all[metric] = mm
+	}
+	return all, slog.Wrap(rows.Err())
+}
+
+func (d *sqlDataAccess) PutTagMetadata(tags opentsdb.TagSet, name string, value string, updated time.Time) error {
+	defer d.timer()()
+
+	key := tags.Tags()
+	return d.transact(func(tx *sql.Tx) error {
+		if _, err := tx.Exec(d.q(`INSERT INTO tag_metadata (tags, name, value, last_touched) VALUES (?, ?, ?, ?)
+			ON CONFLICT (tags, name) DO UPDATE SET value = excluded.value, last_touched = excluded.last_touched`),
+			key, name, value, updated.UTC().Unix()); err != nil {
+			return slog.Wrap(err)
+		}
+		for tagK, tagV := range tags {
+			if _, err := tx.Exec(d.q(`INSERT INTO tag_metadata_index (tagk, tagv, tags, name) VALUES (?, ?, ?, ?)
+				ON CONFLICT (tagk, tagv, tags, name) DO NOTHING`), tagK, tagV, key, name); err != nil {
+				return slog.Wrap(err)
+			}
+		}
+		return nil
+	})
+}
+
+func (d *sqlDataAccess) DeleteTagMetadata(tags opentsdb.TagSet, name string) error {
+	defer d.timer()()
+
+	key := tags.Tags()
+	return d.transact(func(tx *sql.Tx) error {
+		if _, err := tx.Exec(d.q(`DELETE FROM tag_metadata WHERE tags = ? AND name = ?`), key, name); err != nil {
+			return slog.Wrap(err)
+		}
+		_, err := tx.Exec(d.q(`DELETE FROM tag_metadata_index WHERE tags = ? AND name = ?`), key, name)
+		return slog.Wrap(err)
+	})
+}
+
+// GetTagMetadata returns the metadata of every tag set that includes all of tags. The index
+// narrows the candidates down by one of the tags, the rest are checked here.
+func (d *sqlDataAccess) GetTagMetadata(tags opentsdb.TagSet, name string) ([]*TagMetadata, error) {
+	defer d.timer()()
+
+	if len(tags) == 0 {
+		return nil, fmt.Errorf("tag metadata lookups require at least one tag")
+	}
+	var tagK, tagV string
+	for tagK, tagV = range tags {
+		break
+	}
+	query := `SELECT m.tags, m.name, m.value, m.last_touched FROM tag_metadata_index i
+		JOIN tag_metadata m ON m.tags = i.tags AND m.name = i.name
+		WHERE i.tagk = ? AND i.tagv = ?`
+	args := []interface{}{tagK, tagV}
+	if name != "" {
+		query += ` AND i.name = ?`
+		args = append(args, name)
+	}
+	rows, err := d.db.Query(d.q(query), args...)
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	defer rows.Close()
+	data := []*TagMetadata{}
+	for rows.Next() {
+		var ts string
+		m := &TagMetadata{}
+		if err := rows.Scan(&ts, &m.Name, &m.Value, &m.LastTouched); err != nil {
+			return nil, slog.Wrap(err)
+		}
+		if m.Tags, err = opentsdb.ParseTags(ts); err != nil {
+			return nil, err
+		}
+		if m.Tags.Subset(tags) {
+			data = append(data, m)
+		}
+	}
+	return data, slog.Wrap(rows.Err())
+}
+
+func (d *sqlDataAccess) GetAllTagMetadata() ([]*TagMetadata, error) {
+	defer d.timer()()
+
+	rows, err := d.db.Query(`SELECT tags, name, value, last_touched FROM tag_metadata`)
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	defer rows.Close()
+	data := []*TagMetadata{}
+	for rows.Next() {
+		var ts string
+		m := &TagMetadata{}
+		if err := rows.Scan(&ts, &m.Name, &m.Value, &m.LastTouched); err != nil {
+			return nil, slog.Wrap(err)
+		}
+		if m.Tags, err = opentsdb.ParseTags(ts); err != nil {
+			return nil, err
+		}
+		data = append(data, m)
+	}
+	return data, slog.Wrap(rows.Err())
+}
@@ -0,0 +1,129 @@
+package database
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"bosun.org/models"
+	"bosun.org/slog"
+)
+
+func (d *sqlDataAccess) Notifications() NotificationDataAccess {
+	return d
+}
+
+func (d *sqlDataAccess) InsertNotification(ak models.AlertKey, notification string, dueAt time.Time) error {
+	defer d.timer()()
+
+	return d.exec(`INSERT INTO pending_notifications (alert_key, notification, due) VALUES (?, ?, ?)
+		ON CONFLICT (alert_key, notification) DO UPDATE SET due = excluded.due`,
+		string(ak), notification, dueAt.UTC().Unix())
+}
+
+func (d *sqlDataAccess) GetDueNotifications() (map[models.AlertKey]map[string]time.Time, error) {
+	defer d.timer()()
+
+	rows, err := d.db.Query(d.q(`SELECT alert_key, notification, due FROM pending_notifications WHERE due <= ?`), time.Now().UTC().Unix())
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	defer rows.Close()
+	results := map[models.AlertKey]map[string]time.Time{}
+	for rows.Next() {
+		var ak, not string
+		var due int64
+		if err := rows.Scan(&ak, &not, &due); err != nil {
+			return nil, slog.Wrap(err)
+		}
+		if results[models.AlertKey(ak)] == nil {
+			results[models.AlertKey(ak)] = map[string]time.Time{}
+		}
+		results[models.AlertKey(ak)][not] = time.Unix(due, 0).UTC()
+	}
+	return results, slog.Wrap(rows.Err())
+}
+
+func (d *sqlDataAccess) ClearNotificationsBefore(t time.Time) error {
+	defer d.timer()()
+
+	return d.exec(`DELETE FROM pending_notifications WHERE due <= ?`, t.UTC().Unix())
+}
+
+func (d *sqlDataAccess) ClearNotifications(ak models.AlertKey) error {
+	defer d.timer()()
+
+	return d.exec(`DELETE FROM pending_notifications WHERE alert_key = ?`, string(ak))
+}
+
+func (d *sqlDataAccess) ClearNotification(ak models.AlertKey, notification string) error {
+	defer d.timer()()
+
+	return d.exec(`DELETE FROM pending_notifications WHERE alert_key = ? AND notification = ?`, string(ak), notification)
+}
+
+func (d *sqlDataAccess) GetNextNotificationTime() (time.Time, error) {
+	defer d.timer()()
+
+	var due sql.NullInt64
+	if err := d.db.QueryRow(`SELECT MIN(due) FROM pending_notifications`).Scan(&due); err != nil {
+		return time.Time{}, slog.Wrap(err)
+	}
+	// default time is one hour from now if no pending notifications exist
+	if !due.Valid {
+		return time.Now().UTC().Add(time.Hour).Truncate(time.Second), nil
+	}
+	return time.Unix(due.Int64, 0).UTC(), nil
+}
+
+func (d *sqlDataAccess) PutFailedNotification(f *models.FailedNotification) error {
+	defer d.timer()()
+
+	return d.transact(func(tx *sql.Tx) error {
+		if f.Id == 0 {
+			id, err := d.nextId(tx, "failedNotification")
+			if err != nil {
+				return err
+			}
+			f.Id = id
+		}
+		data, err := json.Marshal(f)
+		if err != nil {
+			return slog.Wrap(err)
+		}
+		var next sql.NullInt64
+		if !f.GaveUp() {
+			next = sql.NullInt64{Int64: f.NextAttempt.UTC().Unix(), Valid: true}
+		}
+		_, err = tx.Exec(d.q(`INSERT INTO failed_notifications (id, next_attempt, data) VALUES (?, ?, ?)
+			ON CONFLICT (id) DO UPDATE SET next_attempt = excluded.next_attempt, data = excluded.data`),
+			f.Id, next, string(data))
+		return slog.Wrap(err)
+	})
+}
+
+func (d *sqlDataAccess) getFailedNotifications(query string, args ...interface{}) ([]*models.FailedNotification, error) {
+	data, err := d.queryStrings(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalFailedNotifications(data)
+}
+
+func (d *sqlDataAccess) GetDueFailedNotifications() ([]*models.FailedNotification, error) {
+	defer d.timer()()
+
+	return d.getFailedNotifications(`SELECT data FROM failed_notifications WHERE next_attempt <= ? ORDER BY next_attempt`, time.Now().UTC().Unix())
+}
+
+func (d *sqlDataAccess) GetFailedNotifications() ([]*models.FailedNotification, error) {
+	defer d.timer()()
+
+	return d.getFailedNotifications(`SELECT data FROM failed_notifications ORDER BY id`)
+}
+
+func (d *sqlDataAccess) DeleteFailedNotification(id int64) error {
+	defer d.timer()()
+
+	return d.exec(`DELETE FROM failed_notifications WHERE id = ?`, id)
+}
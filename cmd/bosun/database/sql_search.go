@@ -0,0 +1,117 @@
+package database
+
+import (
+	"encoding/json"
+
+	"bosun.org/opentsdb"
+	"bosun.org/slog"
+)
+
+func (d *sqlDataAccess) Search() SearchDataAccess {
+	return d
+}
+
+func (d *sqlDataAccess) AddMetricForTag(tagK, tagV, metric string, time int64) error {
+	defer d.timer()()
+
+	return d.exec(`INSERT INTO search_metrics_by_tag (tagk, tagv, metric, time) VALUES (?, ?, ?, ?)
+		ON CONFLICT (tagk, tagv, metric) DO UPDATE SET time = excluded.time`, tagK, tagV, metric, time)
+}
+
+func (d *sqlDataAccess) GetMetricsForTag(tagK, tagV string) (map[string]int64, error) {
+	defer d.timer()()
+
+	return d.queryInt64Map(`SELECT metric, time FROM search_metrics_by_tag WHERE tagk = ? AND tagv = ?`, tagK, tagV)
+}
+
+func (d *sqlDataAccess) AddTagKeyForMetric(metric, tagK string, time int64) error {
+	defer d.timer()()
+
+	return d.exec(`INSERT INTO search_tagk (metric, tagk, time) VALUES (?, ?, ?)
+		ON CONFLICT (metric, tagk) DO UPDATE SET time = excluded.time`, metric, tagK, time)
+}
+
+func (d *sqlDataAccess) GetTagKeysForMetric(metric string) (map[string]int64, error) {
+	defer d.timer()()
+
+	return d.queryInt64Map(`SELECT tagk, time FROM search_tagk WHERE metric = ?`, metric)
+}
+
+func (d *sqlDataAccess) AddMetric(metric string, time int64) error {
+	defer d.timer()()
+
+	return d.exec(`INSERT INTO search_metrics (metric, time) VALUES (?, ?)
+		ON CONFLICT (metric) DO UPDATE SET time = excluded.time`, metric, time)
+}
+
+func (d *sqlDataAccess) GetAllMetrics() (map[string]int64, error) {
+	defer d.timer()()
+
+	return d.queryInt64Map(`SELECT metric, time FROM search_metrics`)
+}
+
+func (d *sqlDataAccess) AddTagValue(metric, tagK, tagV string, time int64) error {
+	defer d.timer()()
+
+	return d.exec(`INSERT INTO search_tagv (metric, tagk, tagv, time) VALUES (?, ?, ?, ?)
+		ON CONFLICT (metric, tagk, tagv) DO UPDATE SET time = excluded.time`, metric, tagK, tagV, time)
+}
+
+func (d *sqlDataAccess) GetTagValues(metric, tagK string) (map[string]int64, error) {
+	defer d.timer()()
+
+	return d.queryInt64Map(`SELECT tagv, time FROM search_tagv WHERE metric = ? AND tagk = ?`, metric, tagK)
+}
+
+func (d *sqlDataAccess) AddMetricTagSet(metric, tagSet string, time int64) error {
+	defer d.timer()()
+
+	return d.exec(`INSERT INTO search_tagsets (metric, tagset, time) VALUES (?, ?, ?)
+		ON CONFLICT (metric, tagset) DO UPDATE SET time = excluded.time`, metric, tagSet, time)
+}
+
+func (d *sqlDataAccess) GetMetricTagSets(metric string, tags opentsdb.TagSet) (map[string]int64, error) {
+	defer d.timer()()
+
+	all, err := d.queryInt64Map(`SELECT tagset, time FROM search_tagsets WHERE metric = ?`, metric)
+	if err != nil {
+		return nil, err
+	}
+	result := map[string]int64{}
+	for mts, t := range all {
+		ts, err := opentsdb.ParseTags(mts)
+		if err != nil {
+			return nil, slog.Wrap(err)
+		}
+		if ts.Subset(tags) {
+			result[mts] = t
+		}
+	}
+	return result, nil
+}
+
+func (d *sqlDataAccess) BackupLastInfos(m map[string]map[string]*LastInfo) error {
+	defer d.timer()()
+
+	dat, err := json.Marshal(m)
+	if err != nil {
+		return slog.Wrap(err)
+	}
+	return d.exec(`INSERT INTO search_last (id, data) VALUES (1, ?)
+		ON CONFLICT (id) DO UPDATE SET data = excluded.data`, string(dat))
+}
+
+func (d *sqlDataAccess) LoadLastInfos() (map[string]map[string]*LastInfo, error) {
+	defer d.timer()()
+
+	var dat string
+	err := d.db.QueryRow(`SELECT data FROM search_last WHERE id = 1`).Scan(&dat)
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	var m map[string]map[string]*LastInfo
+	if err = json.Unmarshal([]byte(dat), &m); err != nil {
+		return nil, slog.Wrap(err)
+	}
+	return m, nil
+}
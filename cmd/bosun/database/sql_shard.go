@@ -0,0 +1,74 @@
+package database
+
+import (
+	"database/sql"
+	"time"
+
+	"bosun.org/slog"
+)
+
+// Like the leader lock, the memberships expire in unix milliseconds of the clocks of
+// the workers.
+
+func (d *sqlDataAccess) Shards() ShardDataAccess {
+	return d
+}
+
+func (d *sqlDataAccess) PutShardWorker(id string, ttl time.Duration) error {
+	defer d.timer()()
+
+	now := nowMillis()
+	return d.transact(func(tx *sql.Tx) error {
+		if _, err := tx.Exec(d.q(`INSERT INTO shard_workers (id, expires) VALUES (?, ?)
+			ON CONFLICT (id) DO UPDATE SET expires = excluded.expires`), id, now+int64(ttl/time.Millisecond)); err != nil {
+			return slog.Wrap(err)
+		}
+		_, err := tx.Exec(d.q(`DELETE FROM shard_workers WHERE expires <= ?`), now)
+		return slog.Wrap(err)
+	})
+}
+
+func (d *sqlDataAccess) DeleteShardWorker(id string) error {
+	defer d.timer()()
+
+	return d.exec(`DELETE FROM shard_workers WHERE id = ?`, id)
+}
+
+func (d *sqlDataAccess) GetShardWorkers() ([]string, error) {
+	defer d.timer()()
+
+	rows, err := d.db.Query(d.q(`SELECT id FROM shard_workers WHERE expires > ? ORDER BY id`), nowMillis())
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	defer rows.Close()
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, slog.Wrap(err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, slog.Wrap(rows.Err())
+}
+
+func (d *sqlDataAccess) AcquireAlertLease(alert, id string, ttl time.Duration) (bool, error) {
+	defer d.timer()()
+
+	now := nowMillis()
+	res, err := d.db.Exec(d.q(`INSERT INTO alert_leases (alert, holder, expires) VALUES (?, ?, ?)
+		ON CONFLICT (alert) DO UPDATE SET holder = excluded.holder, expires = excluded.expires
+		WHERE alert_leases.holder = excluded.holder OR alert_leases.expires <= ?`), alert, id, now+int64(ttl/time.Millisecond), now)
+	if err != nil {
+		return false, slog.Wrap(err)
+	}
+	n, err := res.RowsAffected()
+	return n == 1, slog.Wrap(err)
+}
+
+func (d *sqlDataAccess) ReleaseAlertLease(alert, id string) error {
+	defer d.timer()()
+
+	return d.exec(`DELETE FROM alert_leases WHERE alert = ? AND holder = ?`, alert, id)
+}
@@ -0,0 +1,80 @@
+package database
+
+import (
+	"encoding/json"
+	"time"
+
+	"bosun.org/models"
+	"bosun.org/slog"
+)
+
+func (d *sqlDataAccess) Silence() SilenceDataAccess {
+	return d
+}
+
+func (d *sqlDataAccess) getSilences(query string, args ...interface{}) ([]*models.Silence, error) {
+	jsons, err := d.queryStrings(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	silences := make([]*models.Silence, 0, len(jsons))
+	for _, j := range jsons {
+		s := &models.Silence{}
+		if err := json.Unmarshal([]byte(j), s); err != nil {
+			return nil, slog.Wrap(err)
+		}
+		s.Compile()
+		silences = append(silences, s)
+	}
+	return silences, nil
+}
+
+func (d *sqlDataAccess) GetActiveSilences() ([]*models.Silence, error) {
+	defer d.timer()()
+
+	now := time.Now().UTC()
+	silences, err := d.getSilences(`SELECT data FROM silences WHERE end_time >= ?`, now.Unix())
+	if err != nil {
+		return nil, err
+	}
+	filtered := make([]*models.Silence, 0, len(silences))
+	for _, s := range silences {
+		if s.Start.After(now) {
+			continue
+		}
+		filtered = append(filtered, s)
+	}
+	return filtered, nil
+}
+
+func (d *sqlDataAccess) AddSilence(s *models.Silence) error {
+	defer d.timer()()
+
+	dat, err := json.Marshal(s)
+	if err != nil {
+		return slog.Wrap(err)
+	}
+	return d.exec(`INSERT INTO silences (id, end_time, data) VALUES (?, ?, ?)
+		ON CONFLICT (id) DO UPDATE SET end_time = excluded.end_time, data = excluded.data`,
+		s.ID(), s.End.UTC().Unix(), string(dat))
+}
+
+func (d *sqlDataAccess) DeleteSilence(id string) error {
+	defer d.timer()()
+
+	return d.exec(`DELETE FROM silences WHERE id = ?`, id)
+}
+
+func (d *sqlDataAccess) ListSilences(endingAfter int64) (map[string]*models.Silence, error) {
+	defer d.timer()()
+
+	silences, err := d.getSilences(`SELECT data FROM silences WHERE end_time >= ?`, endingAfter)
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[string]*models.Silence, len(silences))
+	for _, s := range silences {
+		m[s.ID()] = s
+	}
+	return m, nil
+}
@@ -0,0 +1,324 @@
+package database
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"bosun.org/models"
+	"bosun.org/slog"
+)
+
+func (d *sqlDataAccess) State() StateDataAccess {
+	return d
+}
+
+func (d *sqlDataAccess) TouchAlertKey(ak models.AlertKey, t time.Time) error {
+	defer d.timer()()
+
+	return d.exec(`INSERT INTO alert_keys (alert_key, alert, last_touched) VALUES (?, ?, ?)
+		ON CONFLICT (alert_key) DO UPDATE SET last_touched = excluded.last_touched`,
+		string(ak), ak.Name(), t.UTC().Unix())
+}
+
+func (d *sqlDataAccess) GetUntouchedSince(alert string, time int64) ([]models.AlertKey, error) {
+	defer d.timer()()
+
+	results, err := d.queryStrings(`SELECT alert_key FROM alert_keys WHERE alert = ? AND last_touched <= ?`, alert, time)
+	if err != nil {
+		return nil, err
+	}
+	aks := make([]models.AlertKey, len(results))
+	for i := range results {
+		aks[i] = models.AlertKey(results[i])
+	}
+	return aks, nil
+}
+
+func (d *sqlDataAccess) getIncidents(query string, args ...interface{}) ([]*models.IncidentState, error) {
+	jsons, err := d.queryStrings(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	if len(jsons) == 0 {
+		return nil, nil
+	}
+	results := make([]*models.IncidentState, 0, len(jsons))
+	for _, j := range jsons {
+		state := &models.IncidentState{}
+		if err = json.Unmarshal([]byte(j), state); err != nil {
+			return nil, slog.Wrap(err)
+		}
+		results = append(results, state)
+	}
+	return results, nil
+}
+
+func (d *sqlDataAccess) GetOpenIncident(ak models.AlertKey) (*models.IncidentState, error) {
+	inc, err := d.GetLatestIncident(ak)
+	if err != nil || inc == nil || !inc.Open {
+		return nil, err
+	}
+	return inc, nil
+}
+
+func (d *sqlDataAccess) GetLatestIncident(ak models.AlertKey) (*models.IncidentState, error) {
+	defer d.timer()()
+
+	incs, err := d.getIncidents(`SELECT data FROM incidents WHERE alert_key = ? ORDER BY id DESC LIMIT 1`, string(ak))
+	if err != nil || len(incs) == 0 {
+		return nil, err
+	}
+	return incs[0], nil
+}
+
+func (d *sqlDataAccess) GetAllOpenIncidents() ([]*models.IncidentState, error) {
+	defer d.timer()()
+
+	return d.getIncidents(`SELECT data FROM incidents WHERE open = 1`)
+}
+
+func (d *sqlDataAccess) GetIncidentState(incidentId int64) (*models.IncidentState, error) {
+	defer d.timer()()
+
+	var data string
+	if err := d.db.QueryRow(d.q(`SELECT data FROM incidents WHERE id = ?`), incidentId).Scan(&data); err != nil {
+		return nil, slog.Wrap(err)
+	}
+	state := &models.IncidentState{}
+	if err := json.Unmarshal([]byte(data), state); err != nil {
+		return nil, slog.Wrap(err)
+	}
+	return state, nil
+}
+
+func (d *sqlDataAccess) GetAllIncidentsByAlertKey(ak models.AlertKey) ([]*models.IncidentState, error) {
+	defer d.timer()()
+
+	return d.getIncidents(`SELECT data FROM incidents WHERE alert_key = ? ORDER BY id DESC`, string(ak))
+}
+
+func (d *sqlDataAccess) GetAllAlertKeys() ([]models.AlertKey, error) {
+	defer d.timer()()
+
+	results, err := d.queryStrings(`SELECT DISTINCT alert_key FROM incidents`)
+	if err != nil {
+		return nil, err
+	}
+	aks := make([]models.AlertKey, len(results))
+	for i := range results {
+		aks[i] = models.AlertKey(results[i])
+	}
+	return aks, nil
+}
+
+func (d *sqlDataAccess) UpdateIncidentState(s *models.IncidentState) (int64, error) {
+	return d.save(s, false)
+}
+
+func (d *sqlDataAccess) ImportIncidentState(s *models.IncidentState) error {
+	_, err := d.save(s, true)
+	return err
+}
+
+func (d *sqlDataAccess) save(s *models.IncidentState, isImport bool) (int64, error) {
+	defer d.timer()()
+
+	return s.Id, d.transact(func(tx *sql.Tx) error {
+		//if id is still zero, assign new id.
+		if s.Id == 0 {
+			id, err := d.nextId(tx, "incident")
+			if err != nil {
+				return err
+			}
+			s.Id = id
+		} else if isImport {
+			if _, err := tx.Exec(d.q(`INSERT INTO sequences (name, value) VALUES ('incident', ?)
+				ON CONFLICT (name) DO UPDATE SET value = excluded.value WHERE sequences.value < excluded.value`), s.Id); err != nil {
+				return slog.Wrap(err)
+			}
+		}
+		data, err := json.Marshal(s)
+		if err != nil {
+			return slog.Wrap(err)
+		}
+		if _, err = tx.Exec(d.q(`INSERT INTO incidents (id, alert_key, open, data) VALUES (?, ?, ?, ?)
+			ON CONFLICT (id) DO UPDATE SET open = excluded.open, data = excluded.data`),
+			s.Id, string(s.AlertKey), boolInt(s.Open), string(data)); err != nil {
+			return slog.Wrap(err)
+		}
+		alert := s.Alert
+		if alert == "" {
+			alert = s.AlertKey.Name()
+		}
+		_, err = tx.Exec(d.q(`INSERT INTO alert_keys (alert_key, alert, unknown, unevaluated) VALUES (?, ?, ?, ?)
+			ON CONFLICT (alert_key) DO UPDATE SET unknown = excluded.unknown, unevaluated = excluded.unevaluated`),
+			string(s.AlertKey), alert, boolInt(s.CurrentStatus == models.StUnknown), boolInt(s.Unevaluated))
+		return slog.Wrap(err)
+	})
+}
+
+func (d *sqlDataAccess) SetRenderedTemplates(incidentId int64, rt *models.RenderedTemplates) error {
+	defer d.timer()()
+
+	data, err := json.Marshal(rt)
+	if err != nil {
+		return slog.Wrap(err)
+	}
+	return d.exec(`INSERT INTO rendered_templates (incident_id, data) VALUES (?, ?)
+		ON CONFLICT (incident_id) DO UPDATE SET data = excluded.data`, incidentId, string(data))
+}
+
+func (d *sqlDataAccess) GetRenderedTemplates(incidentId int64) (*models.RenderedTemplates, error) {
+	defer d.timer()()
+
+	var data string
+	if err := d.db.QueryRow(d.q(`SELECT data FROM rendered_templates WHERE incident_id = ?`), incidentId).Scan(&data); err != nil {
+		return nil, slog.Wrap(err)
+	}
+	renderedT := &models.RenderedTemplates{}
+	if err := json.Unmarshal([]byte(data), renderedT); err != nil {
+		return nil, slog.Wrap(err)
+	}
+	return renderedT, nil
+}
+
+func (d *sqlDataAccess) AddNotificationRecord(incidentId int64, r *models.NotificationRecord) error {
+	defer d.timer()()
+
+	data, err := json.Marshal(r)
+	if err != nil {
+		return slog.Wrap(err)
+	}
+	return d.transact(func(tx *sql.Tx) error {
+		id, err := d.nextId(tx, "notificationRecord")
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(d.q(`INSERT INTO notification_records (id, incident_id, data) VALUES (?, ?, ?)`), id, incidentId, string(data))
+		return slog.Wrap(err)
+	})
+}
+
+func (d *sqlDataAccess) GetNotificationRecords(incidentId int64) ([]*models.NotificationRecord, error) {
+	defer d.timer()()
+
+	rows, err := d.queryStrings(`SELECT data FROM notification_records WHERE incident_id = ? ORDER BY id`, incidentId)
+	if err != nil {
+		return nil, err
+	}
+	records := make([]*models.NotificationRecord, len(rows))
+	for i, row := range rows {
+		r := &models.NotificationRecord{}
+		if err = json.Unmarshal([]byte(row), r); err != nil {
+			return nil, slog.Wrap(err)
+		}
+		records[i] = r
+	}
+	return records, nil
+}
+
+func (d *sqlDataAccess) AddSnapshot(s *models.Snapshot, max int) (int64, error) {
+	defer d.timer()()
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		return 0, slog.Wrap(err)
+	}
+	var snapshotId int64
+	err = d.transact(func(tx *sql.Tx) error {
+		var err error
+		if snapshotId, err = d.nextId(tx, "snapshot"); err != nil {
+			return err
+		}
+		_, err = tx.Exec(d.q(`INSERT INTO run_snapshots (id, stored, data) VALUES (?, ?, ?)`),
+			snapshotId, time.Now().UTC().Unix(), string(data))
+		if err != nil {
+			return slog.Wrap(err)
+		}
+		_, err = tx.Exec(d.q(`DELETE FROM run_snapshots WHERE id NOT IN
+			(SELECT id FROM run_snapshots ORDER BY id DESC LIMIT ?)`), max)
+		return slog.Wrap(err)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return snapshotId, nil
+}
+
+func (d *sqlDataAccess) SetIncidentSnapshot(incidentId, snapshotId int64) error {
+	defer d.timer()()
+
+	return d.exec(`INSERT INTO incident_snapshots (incident_id, snapshot_id) VALUES (?, ?)
+		ON CONFLICT (incident_id) DO UPDATE SET snapshot_id = excluded.snapshot_id`, incidentId, snapshotId)
+}
+
+func (d *sqlDataAccess) GetSnapshot(incidentId int64) (*models.Snapshot, error) {
+	defer d.timer()()
+
+	var data string
+	err := d.db.QueryRow(d.q(`SELECT r.data FROM incident_snapshots i JOIN run_snapshots r ON r.id = i.snapshot_id
+		WHERE i.incident_id = ?`), incidentId).Scan(&data)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	snap := &models.Snapshot{}
+	if err := json.Unmarshal([]byte(data), snap); err != nil {
+		return nil, slog.Wrap(err)
+	}
+	return snap, nil
+}
+
+// The nucular option. Delete all we know about this alert key
+func (d *sqlDataAccess) Forget(ak models.AlertKey) error {
+	defer d.timer()()
+
+	return d.transact(func(tx *sql.Tx) error {
+		for _, table := range []string{"rendered_templates", "notification_records", "incident_snapshots"} {
+			if _, err := tx.Exec(d.q(`DELETE FROM `+table+` WHERE incident_id IN (SELECT id FROM incidents WHERE alert_key = ?)`), string(ak)); err != nil {
+				return slog.Wrap(err)
+			}
+		}
+		if _, err := tx.Exec(d.q(`DELETE FROM incidents WHERE alert_key = ?`), string(ak)); err != nil {
+			return slog.Wrap(err)
+		}
+		_, err := tx.Exec(d.q(`DELETE FROM alert_keys WHERE alert_key = ?`), string(ak))
+		return slog.Wrap(err)
+	})
+}
+
+func (d *sqlDataAccess) SetUnevaluated(ak models.AlertKey, uneval bool) error {
+	defer d.timer()()
+
+	return d.exec(`INSERT INTO alert_keys (alert_key, alert, unevaluated) VALUES (?, ?, ?)
+		ON CONFLICT (alert_key) DO UPDATE SET unevaluated = excluded.unevaluated`,
+		string(ak), ak.Name(), boolInt(uneval))
+}
+
+func (d *sqlDataAccess) GetUnknownAndUnevalAlertKeys(alert string) ([]models.AlertKey, []models.AlertKey, error) {
+	defer d.timer()()
+
+	rows, err := d.db.Query(d.q(`SELECT alert_key, unknown, unevaluated FROM alert_keys WHERE alert = ? AND (unknown = 1 OR unevaluated = 1)`), alert)
+	if err != nil {
+		return nil, nil, slog.Wrap(err)
+	}
+	defer rows.Close()
+	unknown := []models.AlertKey{}
+	unevals := []models.AlertKey{}
+	for rows.Next() {
+		var ak string
+		var unk, uneval int
+		if err := rows.Scan(&ak, &unk, &uneval); err != nil {
+			return nil, nil, slog.Wrap(err)
+		}
+		if unk == 1 {
+			unknown = append(unknown, models.AlertKey(ak))
+		}
+		if uneval == 1 {
+			unevals = append(unevals, models.AlertKey(ak))
+		}
+	}
+	return unknown, unevals, slog.Wrap(rows.Err())
+}
@@ -0,0 +1,77 @@
+package database
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"bosun.org/slog"
+	"github.com/captncraig/easyauth/providers/token"
+)
+
+// The sql implementation of token.TokenDataAccess, which the redis implementation gets
+// from the easyauth redisStore package.
+
+func (d *sqlDataAccess) LookupToken(hash string) (*token.Token, error) {
+	defer d.timer()()
+
+	var data string
+	err := d.db.QueryRow(d.q(`SELECT data FROM tokens WHERE hash = ?`), hash).Scan(&data)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("Token not found")
+	}
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	tok := &token.Token{}
+	if err = json.Unmarshal([]byte(data), tok); err != nil {
+		return nil, slog.Wrap(err)
+	}
+	d.exec(`UPDATE tokens SET last_used = ? WHERE hash = ?`, time.Now().UTC().Unix(), hash) // eat failure here
+	return tok, nil
+}
+
+func (d *sqlDataAccess) StoreToken(t *token.Token) error {
+	defer d.timer()()
+
+	data, err := json.Marshal(t)
+	if err != nil {
+		return slog.Wrap(err)
+	}
+	return d.exec(`INSERT INTO tokens (hash, data) VALUES (?, ?)
+		ON CONFLICT (hash) DO UPDATE SET data = excluded.data`, t.Hash, string(data))
+}
+
+func (d *sqlDataAccess) RevokeToken(hash string) error {
+	defer d.timer()()
+
+	return d.exec(`DELETE FROM tokens WHERE hash = ?`, hash)
+}
+
+func (d *sqlDataAccess) ListTokens() ([]*token.Token, error) {
+	defer d.timer()()
+
+	rows, err := d.db.Query(`SELECT data, last_used FROM tokens`)
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	defer rows.Close()
+	toks := []*token.Token{}
+	for rows.Next() {
+		var data string
+		var lastUsed sql.NullInt64
+		if err := rows.Scan(&data, &lastUsed); err != nil {
+			return nil, slog.Wrap(err)
+		}
+		t := &token.Token{}
+		if err = json.Unmarshal([]byte(data), t); err != nil {
+			return nil, slog.Wrap(err)
+		}
+		if lastUsed.Valid {
+			t.LastUsed = time.Unix(lastUsed.Int64, 0).UTC()
+		}
+		toks = append(toks, t)
+	}
+	return toks, slog.Wrap(rows.Err())
+}
@@ -0,0 +1,7 @@
+// +build !cgo
+
+package database
+
+// sqliteSupported is whether the sqlite3 driver works, which needs cgo. Without it the
+// driver is a stub that fails to open any database.
+const sqliteSupported = false
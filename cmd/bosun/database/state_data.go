@@ -18,6 +18,13 @@ incidentById:{id} - json encoded state. Authoritative source.
 
 renderedTemplatesById:{id} - json encoded RenderedTemplates by Incident Id
 
+notificationRecordsById:{id} - List of json encoded NotificationRecords by Incident Id. Oldest first.
+
+snapshotById:{id} - json encoded Snapshot of the backend responses of a check run by snapshot id
+snapshots - ZSET of snapshot ids by the time they were stored
+maxSnapshotId - INCR counter of snapshot ids
+incidentSnapshot:{id} - id of the snapshot of the run that last changed the status of an incident by Incident Id
+
 lastTouched:{alert} - ZSET of alert key to last touched time stamp
 unknown:{alert} - Set of unknown alert keys for alert
 unevel:{alert} - Set of unevaluated alert keys for alert
@@ -30,6 +37,9 @@ allIncidents - List of all incidents ever. Value is "incidentId:timestamp:ak"
 
 const (
 	statesOpenIncidentsKey = "openIncidents"
+
+	snapshotsKey     = "snapshots"
+	maxSnapshotIdKey = "maxSnapshotId"
 )
 
 func statesLastTouchedKey(alert string) string {
@@ -47,6 +57,15 @@ func incidentStateKey(id int64) string {
 func renderedTemplatesKey(id int64) string {
 	return fmt.Sprintf("renderedTemplatesById:%d", id)
 }
+func notificationRecordsKey(id int64) string {
+	return fmt.Sprintf("notificationRecordsById:%d", id)
+}
+func snapshotKey(id int64) string {
+	return fmt.Sprintf("snapshotById:%d", id)
+}
+func incidentSnapshotKey(id int64) string {
+	return fmt.Sprintf("incidentSnapshot:%d", id)
+}
 func incidentsForAlertKeyKey(ak models.AlertKey) string {
 	return fmt.Sprintf("incidents:%s", ak)
 }
@@ -61,6 +80,7 @@ type StateDataAccess interface {
 	GetIncidentState(incidentId int64) (*models.IncidentState, error)
 
 	GetAllIncidentsByAlertKey(ak models.AlertKey) ([]*models.IncidentState, error)
+	GetAllAlertKeys() ([]models.AlertKey, error)
 
 	UpdateIncidentState(s *models.IncidentState) (int64, error)
 	ImportIncidentState(s *models.IncidentState) error
@@ -68,6 +88,19 @@ type StateDataAccess interface {
 	SetRenderedTemplates(incidentId int64, rt *models.RenderedTemplates) error
 	GetRenderedTemplates(incidentId int64) (*models.RenderedTemplates, error)
 
+	AddNotificationRecord(incidentId int64, r *models.NotificationRecord) error
+	GetNotificationRecords(incidentId int64) ([]*models.NotificationRecord, error)
+
+	// AddSnapshot stores the snapshot of a check run and returns its id. It deletes the
+	// oldest snapshots so that at most max are kept.
+	AddSnapshot(s *models.Snapshot, max int) (int64, error)
+	// SetIncidentSnapshot makes the snapshot with snapshotId that of the incident,
+	// replacing any previous one. Several incidents may share a snapshot.
+	SetIncidentSnapshot(incidentId, snapshotId int64) error
+	// GetSnapshot returns the snapshot of an incident, or nil if there is none or it
+	// has been deleted.
+	GetSnapshot(incidentId int64) (*models.Snapshot, error)
+
 	Forget(ak models.AlertKey) error
 	SetUnevaluated(ak models.AlertKey, uneval bool) error
 	GetUnknownAndUnevalAlertKeys(alert string) ([]models.AlertKey, []models.AlertKey, error)
@@ -103,6 +136,102 @@ func (d *dataAccess) GetRenderedTemplates(incidentId int64) (*models.RenderedTem
 	return renderedT, nil
 }
 
+func (d *dataAccess) AddNotificationRecord(incidentId int64, r *models.NotificationRecord) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	data, err := json.Marshal(r)
+	if err != nil {
+		return slog.Wrap(err)
+	}
+	_, err = conn.Do("RPUSH", notificationRecordsKey(incidentId), data)
+	return slog.Wrap(err)
+}
+
+func (d *dataAccess) GetNotificationRecords(incidentId int64) ([]*models.NotificationRecord, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	rows, err := redis.Strings(conn.Do("LRANGE", notificationRecordsKey(incidentId), 0, -1))
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	records := make([]*models.NotificationRecord, len(rows))
+	for i, row := range rows {
+		r := &models.NotificationRecord{}
+		if err = json.Unmarshal([]byte(row), r); err != nil {
+			return nil, slog.Wrap(err)
+		}
+		records[i] = r
+	}
+	return records, nil
+}
+
+func (d *dataAccess) AddSnapshot(s *models.Snapshot, max int) (int64, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		return 0, slog.Wrap(err)
+	}
+	snapshotId, err := redis.Int64(conn.Do("INCR", maxSnapshotIdKey))
+	if err != nil {
+		return 0, slog.Wrap(err)
+	}
+	if _, err = conn.Do("SET", snapshotKey(snapshotId), data); err != nil {
+		return 0, slog.Wrap(err)
+	}
+	if _, err = conn.Do("ZADD", snapshotsKey, time.Now().UTC().Unix(), snapshotId); err != nil {
+		return 0, slog.Wrap(err)
+	}
+	// all but the newest max
+	old, err := int64s(conn.Do("ZRANGE", snapshotsKey, 0, -max-1))
+	if err != nil {
+		return 0, slog.Wrap(err)
+	}
+	for _, id := range old {
+		if _, err = conn.Do("DEL", snapshotKey(id)); err != nil {
+			return 0, slog.Wrap(err)
+		}
+		if _, err = conn.Do("ZREM", snapshotsKey, id); err != nil {
+			return 0, slog.Wrap(err)
+		}
+	}
+	return snapshotId, nil
+}
+
+func (d *dataAccess) SetIncidentSnapshot(incidentId, snapshotId int64) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	_, err := conn.Do("SET", incidentSnapshotKey(incidentId), snapshotId)
+	return slog.Wrap(err)
+}
+
+func (d *dataAccess) GetSnapshot(incidentId int64) (*models.Snapshot, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	snapshotId, err := redis.Int64(conn.Do("GET", incidentSnapshotKey(incidentId)))
+	if err == redis.ErrNil {
+		return nil, nil
+	} else if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	b, err := redis.Bytes(conn.Do("GET", snapshotKey(snapshotId)))
+	if err == redis.ErrNil {
+		return nil, nil
+	} else if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	snap := &models.Snapshot{}
+	if err = json.Unmarshal(b, snap); err != nil {
+		return nil, slog.Wrap(err)
+	}
+	return snap, nil
+}
+
 func (d *dataAccess) State() StateDataAccess {
 	return d
 }
@@ -215,6 +344,19 @@ func (d *dataAccess) getAllIncidentIdsByKeys() ([]int64, error) {
 	return ids, nil
 }
 
+// GetAllAlertKeys returns every alert key that has incidents.
+func (d *dataAccess) GetAllAlertKeys() ([]models.AlertKey, error) {
+	keys, err := d.scanKeys("LIST", "incidents:")
+	if err != nil {
+		return nil, err
+	}
+	aks := make([]models.AlertKey, len(keys))
+	for i, key := range keys {
+		aks[i] = models.AlertKey(key[len("incidents:"):])
+	}
+	return aks, nil
+}
+
 func (d *dataAccess) incidentMultiGet(conn redis.Conn, ids []int64) ([]*models.IncidentState, error) {
 	if len(ids) == 0 {
 		return nil, nil
@@ -384,6 +526,12 @@ func (d *dataAccess) Forget(ak models.AlertKey) error {
 			if _, err = conn.Do("DEL", incidentStateKey(id)); err != nil {
 				return slog.Wrap(err)
 			}
+			if _, err = conn.Do(d.LCLEAR(), notificationRecordsKey(id)); err != nil {
+				return slog.Wrap(err)
+			}
+			if _, err = conn.Do("DEL", incidentSnapshotKey(id)); err != nil {
+				return slog.Wrap(err)
+			}
 		}
 		if _, err := conn.Do(d.LCLEAR(), incidentsForAlertKeyKey(ak)); err != nil {
 			return slog.Wrap(err)
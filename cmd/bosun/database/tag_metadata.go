@@ -80,10 +80,38 @@ func (d *dataAccess) GetTagMetadata(tags opentsdb.TagSet, name string) ([]*TagMe
 		}
 	}
 	results, err := redis.Strings(conn.Do("MGET", args...))
+	return parseTagMetadata(args, results)
+}
+
+// GetAllTagMetadata returns the metadata of every tag set.
+func (d *dataAccess) GetAllTagMetadata() ([]*TagMetadata, error) {
+	keys, err := d.scanKeys("KV", "tmeta:")
+	if err != nil {
+		return nil, err
+	}
+	args := []interface{}{}
+	for _, key := range keys {
+		if !strings.HasPrefix(key, "tmeta:idx:") {
+			args = append(args, key)
+		}
+	}
+	if len(args) == 0 {
+		return []*TagMetadata{}, nil
+	}
+	conn := d.Get()
+	defer conn.Close()
+	results, err := redis.Strings(conn.Do("MGET", args...))
+	if err != nil {
+		return nil, err
+	}
+	return parseTagMetadata(args, results)
+}
+
+func parseTagMetadata(keys []interface{}, results []string) ([]*TagMetadata, error) {
 	data := []*TagMetadata{}
-	for i := range args {
+	for i := range keys {
 		// break up key to get tags and name
-		key := args[i].(string)[len("tmeta:"):]
+		key := keys[i].(string)[len("tmeta:"):]
 		sepIdx := strings.LastIndex(key, ":")
 		tags := key[:sepIdx]
 		name := key[sepIdx+1:]
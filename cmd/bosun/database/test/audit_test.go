@@ -0,0 +1,62 @@
+package dbtest
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"bosun.org/models"
+)
+
+func TestAuditEvents(t *testing.T) {
+	ad := testData.Audit()
+
+	start := time.Now().UTC().Truncate(time.Millisecond).Add(-time.Hour)
+	for i, e := range []*models.AuditEvent{
+		{User: "a", Route: "silence_set", Status: 200},
+		{User: "b", Route: "action", Status: 403, Error: "not authorized"},
+		{User: "a", Route: "action", Status: 200},
+	} {
+		e.Time = start.Add(time.Duration(i) * time.Minute)
+		check(t, ad.AddAuditEvent(e))
+		if e.Id == 0 {
+			t.Fatal("expected an id")
+		}
+	}
+	get := func(f *models.AuditFilter) []string {
+		events, err := ad.GetAuditEvents(f)
+		check(t, err)
+		var s []string
+		for _, e := range events {
+			s = append(s, e.User+" "+e.Route)
+		}
+		return s
+	}
+	for i, test := range []struct {
+		filter models.AuditFilter
+		expect []string
+	}{
+		{models.AuditFilter{}, []string{"a action", "b action", "a silence_set"}},
+		{models.AuditFilter{User: "a"}, []string{"a action", "a silence_set"}},
+		{models.AuditFilter{Route: "action", Limit: 1}, []string{"a action"}},
+		{models.AuditFilter{Start: start.Add(time.Minute), End: start.Add(time.Minute)}, []string{"b action"}},
+		{models.AuditFilter{User: "c"}, nil},
+		{models.AuditFilter{User: "a", Route: "action"}, []string{"a action"}},
+	} {
+		if got := get(&test.filter); !reflect.DeepEqual(got, test.expect) {
+			t.Errorf("%d: expected %v, got %v", i, test.expect, got)
+		}
+	}
+
+	check(t, ad.DeleteAuditEventsBefore(start.Add(time.Minute)))
+	if got := get(&models.AuditFilter{}); !reflect.DeepEqual(got, []string{"a action", "b action"}) {
+		t.Errorf("expected the first event to be deleted, got %v", got)
+	}
+	if got := get(&models.AuditFilter{User: "a"}); !reflect.DeepEqual(got, []string{"a action"}) {
+		t.Errorf("expected the first event to be deleted for its user, got %v", got)
+	}
+	if got := get(&models.AuditFilter{Route: "silence_set"}); got != nil {
+		t.Errorf("expected the first event to be deleted for its route, got %v", got)
+	}
+	check(t, ad.DeleteAuditEventsBefore(time.Now()))
+}
@@ -14,3 +14,16 @@ func TestConfigSave(t *testing.T) {
 		t.Fatalf("Loaded config doesn't match: %s", recoverd)
 	}
 }
+
+func TestScopeBits(t *testing.T) {
+	cd := testData.Configs()
+
+	check(t, cd.SetScopeBit(0, "storage"))
+	check(t, cd.SetScopeBit(1, "dba"))
+	check(t, cd.SetScopeBit(0, "web"))
+	bits, err := cd.GetScopeBits()
+	check(t, err)
+	if len(bits) != 2 || bits[0] != "web" || bits[1] != "dba" {
+		t.Fatalf("unexpected scope bits %v", bits)
+	}
+}
@@ -12,15 +12,24 @@ import (
 	"bosun.org/cmd/bosun/database"
 )
 
-// data access object to use for all unit tests. Pointed at ephemeral ledis, or redis server passed in with --redis=addr
+// data access object to use for all unit tests. Pointed at ephemeral ledis, or redis server passed in with --redis=addr.
+// The tests are then run a second time against a sql database, sqlite or postgres passed in with --postgres=connstr.
 var testData database.DataAccess
 
 func TestMain(m *testing.M) {
 	rand.Seed(time.Now().UnixNano())
-	var closeF func()
-	testData, closeF = StartTestRedis(9993)
-	status := m.Run()
-	closeF()
+	status := 0
+	for _, start := range []func() (database.DataAccess, func()){
+		func() (database.DataAccess, func()) { return StartTestRedis(9993) },
+		StartTestSQL,
+	} {
+		var closeF func()
+		testData, closeF = start()
+		if s := m.Run(); s != 0 {
+			status = s
+		}
+		closeF()
+	}
 	os.Exit(status)
 }
 
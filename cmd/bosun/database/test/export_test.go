@@ -0,0 +1,116 @@
+package dbtest
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"bosun.org/cmd/bosun/database"
+	"bosun.org/models"
+	"bosun.org/opentsdb"
+)
+
+// newSQLiteData returns an empty data store to import into, and a function to remove it.
+func newSQLiteData(t *testing.T) (database.DataAccess, func()) {
+	path := filepath.Join(os.TempDir(), "bosun_export_test", randString(10))
+	check(t, os.MkdirAll(path, 0755))
+	d, err := database.NewSQLDataAccess("sqlite3", filepath.Join(path, "bosun.db"))
+	check(t, err)
+	check(t, d.Migrate())
+	return d, func() { os.RemoveAll(path) }
+}
+
+func TestExportImport(t *testing.T) {
+	alert := "export" + randString(5)
+	ak := models.NewAlertKey(alert, opentsdb.TagSet{"host": "a"})
+	metric := "export." + randString(5)
+	tags := opentsdb.TagSet{"host": randString(5)}
+
+	inc := &models.IncidentState{AlertKey: ak, Alert: alert, Open: true, CurrentStatus: models.StWarning, Start: time.Now().UTC()}
+	_, err := testData.State().UpdateIncidentState(inc)
+	check(t, err)
+	check(t, testData.State().SetRenderedTemplates(inc.Id, &models.RenderedTemplates{Body: "body"}))
+	check(t, testData.State().AddNotificationRecord(inc.Id, &models.NotificationRecord{Notification: "n", Success: true}))
+	// an expired silence, so the active silences of other tests are not affected
+	now := time.Now().UTC().Truncate(time.Second)
+	silence := &models.Silence{Start: now.Add(-2 * time.Hour), End: now.Add(-time.Hour), Alert: alert}
+	check(t, testData.Silence().AddSilence(silence))
+	check(t, testData.Metadata().PutMetricMetadata(metric, "unit", "bytes"))
+	check(t, testData.Metadata().PutTagMetadata(tags, "desc", "a host", time.Now()))
+
+	buf := &bytes.Buffer{}
+	counts, err := database.Export(testData, buf)
+	check(t, err)
+	if counts["incident"] == 0 || counts["silence"] == 0 {
+		t.Fatalf("expected incidents and silences to be exported, got %v", counts)
+	}
+
+	target, closeF := newSQLiteData(t)
+	defer closeF()
+	_, err = database.Import(target, buf)
+	check(t, err)
+
+	imported, err := target.State().GetOpenIncident(ak)
+	check(t, err)
+	if imported == nil || imported.Id != inc.Id {
+		t.Fatalf("expected incident %d to be imported, got %v", inc.Id, imported)
+	}
+	rt, err := target.State().GetRenderedTemplates(inc.Id)
+	check(t, err)
+	if rt.Body != "body" {
+		t.Fatalf("wrong rendered templates: %v", rt)
+	}
+	records, err := target.State().GetNotificationRecords(inc.Id)
+	check(t, err)
+	if len(records) != 1 {
+		t.Fatalf("expected 1 notification record, got %d", len(records))
+	}
+	silences, err := target.Silence().ListSilences(0)
+	check(t, err)
+	if _, ok := silences[silence.ID()]; !ok {
+		t.Fatalf("expected silence %s to be imported", silence.ID())
+	}
+	mm, err := target.Metadata().GetMetricMetadata(metric)
+	check(t, err)
+	if mm == nil || mm.Unit != "bytes" {
+		t.Fatalf("wrong metric metadata: %v", mm)
+	}
+	tm, err := target.Metadata().GetTagMetadata(tags, "desc")
+	check(t, err)
+	if len(tm) != 1 || tm[0].Value != "a host" {
+		t.Fatalf("wrong tag metadata: %v", tm)
+	}
+
+	// new incidents must not reuse imported ids
+	next := &models.IncidentState{AlertKey: ak, Alert: alert, Start: time.Now().UTC()}
+	_, err = target.State().UpdateIncidentState(next)
+	check(t, err)
+	if next.Id <= inc.Id {
+		t.Fatalf("expected id after %d, got %d", inc.Id, next.Id)
+	}
+}
+
+func TestImport_SchemaVersions(t *testing.T) {
+	// schema version 0 stored rendered templates inside the incident
+	old := `{"Type":"header","Data":{"ArchiveVersion":1,"SchemaVersion":0}}
+{"Type":"incident","Data":{"Id":7,"AlertKey":"old{}","Alert":"old","Open":true,"Body":"old body"}}
+`
+	target, closeF := newSQLiteData(t)
+	defer closeF()
+	_, err := database.Import(target, strings.NewReader(old))
+	check(t, err)
+	rt, err := target.State().GetRenderedTemplates(7)
+	check(t, err)
+	if rt.Body != "old body" {
+		t.Fatalf("expected rendered templates to be migrated, got %v", rt)
+	}
+
+	newer := `{"Type":"header","Data":{"ArchiveVersion":1,"SchemaVersion":1000}}
+`
+	if _, err := database.Import(target, strings.NewReader(newer)); err == nil {
+		t.Fatal("expected an archive from a newer schema to be rejected")
+	}
+}
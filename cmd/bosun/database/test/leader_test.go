@@ -0,0 +1,57 @@
+package dbtest
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLeader(t *testing.T) {
+	ld := testData.Leader()
+
+	a, err := ld.AcquireLeader("a", 0, time.Minute)
+	check(t, err)
+	if a == 0 {
+		t.Fatal("a: expected to lead")
+	}
+	b, err := ld.AcquireLeader("b", 0, time.Minute)
+	check(t, err)
+	if b != 0 {
+		t.Fatalf("b: expected a to lead, got token %d", b)
+	}
+	renewed, err := ld.AcquireLeader("a", a, time.Minute)
+	check(t, err)
+	if renewed != a {
+		t.Fatalf("a: expected renewal with token %d, got %d", a, renewed)
+	}
+	if stale, err := ld.AcquireLeader("a", a-1, time.Minute); err != nil || stale != 0 {
+		t.Fatalf("a: expected renewal with a stale token to fail, got %d, %v", stale, err)
+	}
+	id, token, err := ld.GetLeader()
+	check(t, err)
+	if id != "a" || token != a {
+		t.Fatalf("expected leader a with token %d, got %s with %d", a, id, token)
+	}
+
+	check(t, ld.ReleaseLeader("b", a))
+	check(t, ld.ReleaseLeader("a", a))
+	if id, _, err = ld.GetLeader(); err != nil || id != "" {
+		t.Fatalf("expected no leader, got %q, %v", id, err)
+	}
+	b, err = ld.AcquireLeader("b", 0, time.Second)
+	check(t, err)
+	if b <= a {
+		t.Fatalf("b: expected a token above %d, got %d", a, b)
+	}
+
+	// b stops renewing its lock
+	time.Sleep(2100 * time.Millisecond)
+	a, err = ld.AcquireLeader("a", 0, time.Minute)
+	check(t, err)
+	if a <= b {
+		t.Fatalf("a: expected to take over with a token above %d, got %d", b, a)
+	}
+	if renewed, err := ld.AcquireLeader("b", b, time.Minute); err != nil || renewed != 0 {
+		t.Fatalf("b: expected renewal after take over to fail, got %d, %v", renewed, err)
+	}
+	check(t, ld.ReleaseLeader("a", a))
+}
@@ -65,4 +65,109 @@ func TestNotifications_RoundTrip(t *testing.T) {
 	if next != future {
 		t.Fatalf("wrong next time. %s != %s", next, future)
 	}
+
+	check(t, nd.ClearNotification(models.AlertKey("notak{foo=c}"), "email"))
+	next, err = nd.GetNextNotificationTime()
+	check(t, err)
+	if next != future {
+		t.Fatalf("wrong next time. %s != %s", next, future)
+	}
+	check(t, nd.ClearNotification(models.AlertKey("notak{foo=c}"), "chat"))
+	// nothing pending
+	next, err = nd.GetNextNotificationTime()
+	check(t, err)
+	if next.Before(future) {
+		t.Fatalf("wrong next time. %s < %s", next, future)
+	}
+}
+
+func TestFailedNotifications(t *testing.T) {
+	nd := testData.Notifications()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	due := &models.FailedNotification{
+		AlertKey:     "failed{foo=a}",
+		Notification: "page",
+		Transport:    "post",
+		Attempts:     1,
+		NextAttempt:  now.Add(-time.Minute),
+		Body:         "down",
+	}
+	later := &models.FailedNotification{
+		AlertKey:     "failed{foo=b}",
+		Notification: "page",
+		Transport:    "email",
+		Attempts:     1,
+		NextAttempt:  now.Add(time.Hour),
+	}
+	check(t, nd.PutFailedNotification(due))
+	check(t, nd.PutFailedNotification(later))
+	if due.Id == 0 || due.Id == later.Id {
+		t.Fatalf("expected distinct ids to be assigned, got %d and %d", due.Id, later.Id)
+	}
+
+	fs, err := nd.GetDueFailedNotifications()
+	check(t, err)
+	if len(fs) != 1 || fs[0].Id != due.Id || fs[0].Body != "down" {
+		t.Fatalf("expected only %d to be due, got %v", due.Id, fs)
+	}
+
+	// giving up removes it from the due set but keeps it listed
+	due.Attempts++
+	due.NextAttempt = time.Time{}
+	check(t, nd.PutFailedNotification(due))
+	fs, err = nd.GetDueFailedNotifications()
+	check(t, err)
+	if len(fs) != 0 {
+		t.Fatalf("expected no due notifications, got %d", len(fs))
+	}
+	fs, err = nd.GetFailedNotifications()
+	check(t, err)
+	if len(fs) != 2 || fs[0].Id != due.Id || !fs[0].GaveUp() || fs[0].Attempts != 2 {
+		t.Fatalf("unexpected failed notifications %v", fs)
+	}
+
+	check(t, nd.DeleteFailedNotification(due.Id))
+	check(t, nd.DeleteFailedNotification(later.Id))
+	fs, err = nd.GetFailedNotifications()
+	check(t, err)
+	if len(fs) != 0 {
+		t.Fatalf("expected no failed notifications, got %d", len(fs))
+	}
+}
+
+func TestNotificationRecords(t *testing.T) {
+	sd := testData.State()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	check(t, sd.AddNotificationRecord(42, &models.NotificationRecord{
+		Notification: "page",
+		Transport:    "post",
+		Target:       "https://pager.example.com",
+		Time:         now,
+		Attempt:      1,
+		Error:        "bad response on notification post: 500 Internal Server Error",
+	}))
+	check(t, sd.AddNotificationRecord(42, &models.NotificationRecord{
+		Notification: "page",
+		Transport:    "post",
+		Target:       "https://pager.example.com",
+		Time:         now.Add(time.Minute),
+		Attempt:      2,
+		Success:      true,
+	}))
+	records, err := sd.GetNotificationRecords(42)
+	check(t, err)
+	if len(records) != 2 {
+		t.Fatalf("expected 2 records, got %d", len(records))
+	}
+	if records[0].Success || records[0].Attempt != 1 || !records[1].Success || !records[1].Time.Equal(now.Add(time.Minute)) {
+		t.Fatalf("records out of order or changed: %+v %+v", records[0], records[1])
+	}
+
+	records, err = sd.GetNotificationRecords(43)
+	check(t, err)
+	if len(records) != 0 {
+		t.Fatalf("expected no records, got %d", len(records))
+	}
 }
@@ -0,0 +1,55 @@
+package dbtest
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestShardWorkers(t *testing.T) {
+	sd := testData.Shards()
+
+	check(t, sd.PutShardWorker("b", time.Minute))
+	check(t, sd.PutShardWorker("a", time.Minute))
+	check(t, sd.PutShardWorker("c", time.Millisecond))
+	check(t, sd.PutShardWorker("a", time.Minute))
+	time.Sleep(10 * time.Millisecond)
+	ids, err := sd.GetShardWorkers()
+	check(t, err)
+	if !reflect.DeepEqual(ids, []string{"a", "b"}) {
+		t.Fatalf("expected workers a and b, got %v", ids)
+	}
+
+	check(t, sd.DeleteShardWorker("a"))
+	check(t, sd.DeleteShardWorker("b"))
+	if ids, err = sd.GetShardWorkers(); err != nil || len(ids) != 0 {
+		t.Fatalf("expected no workers, got %v, %v", ids, err)
+	}
+}
+
+func TestAlertLeases(t *testing.T) {
+	sd := testData.Shards()
+
+	acquire := func(alert, id string, ttl time.Duration, expected bool) {
+		ok, err := sd.AcquireAlertLease(alert, id, ttl)
+		check(t, err)
+		if ok != expected {
+			t.Fatalf("%s acquiring %s: expected %v, got %v", id, alert, expected, ok)
+		}
+	}
+	acquire("x", "a", time.Minute, true)
+	acquire("x", "b", time.Minute, false)
+	acquire("x", "a", time.Minute, true)
+	// only the holder releases the lease
+	check(t, sd.ReleaseAlertLease("x", "b"))
+	acquire("x", "b", time.Minute, false)
+	check(t, sd.ReleaseAlertLease("x", "a"))
+	acquire("x", "b", time.Minute, true)
+	check(t, sd.ReleaseAlertLease("x", "b"))
+
+	// ledis expires keys in whole seconds, and only checks every second
+	acquire("y", "a", time.Millisecond, true)
+	time.Sleep(2100 * time.Millisecond)
+	acquire("y", "b", time.Minute, true)
+	check(t, sd.ReleaseAlertLease("y", "b"))
+}
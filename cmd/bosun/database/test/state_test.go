@@ -0,0 +1,112 @@
+package dbtest
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"bosun.org/models"
+)
+
+func TestIncidentState_RoundTrip(t *testing.T) {
+	sd := testData.State()
+	alert := "state" + randString(5)
+	ak := models.NewAlertKey(alert, nil)
+
+	inc := &models.IncidentState{
+		AlertKey:      ak,
+		Alert:         alert,
+		Open:          true,
+		CurrentStatus: models.StUnknown,
+		Start:         time.Now().UTC(),
+	}
+	id, err := sd.UpdateIncidentState(inc)
+	check(t, err)
+	if id == 0 || inc.Id != id {
+		t.Fatalf("expected a new id to be assigned, got %d", id)
+	}
+
+	open, err := sd.GetOpenIncident(ak)
+	check(t, err)
+	if open == nil || open.Id != id {
+		t.Fatalf("expected open incident %d, got %v", id, open)
+	}
+	unknown, _, err := sd.GetUnknownAndUnevalAlertKeys(alert)
+	check(t, err)
+	if len(unknown) != 1 || unknown[0] != ak {
+		t.Fatalf("expected %s to be unknown, got %v", ak, unknown)
+	}
+
+	// closing the incident keeps it as the latest, but not open
+	inc.Open = false
+	inc.CurrentStatus = models.StNormal
+	_, err = sd.UpdateIncidentState(inc)
+	check(t, err)
+	open, err = sd.GetOpenIncident(ak)
+	check(t, err)
+	if open != nil {
+		t.Fatalf("expected no open incident, got %v", open)
+	}
+	latest, err := sd.GetLatestIncident(ak)
+	check(t, err)
+	if latest == nil || latest.Id != id {
+		t.Fatalf("expected latest incident %d, got %v", id, latest)
+	}
+	unknown, _, err = sd.GetUnknownAndUnevalAlertKeys(alert)
+	check(t, err)
+	if len(unknown) != 0 {
+		t.Fatalf("expected no unknown alert keys, got %v", unknown)
+	}
+
+	check(t, sd.Forget(ak))
+	all, err := sd.GetAllIncidentsByAlertKey(ak)
+	check(t, err)
+	if len(all) != 0 {
+		t.Fatalf("expected incidents to be forgotten, got %d", len(all))
+	}
+}
+
+func TestSnapshots(t *testing.T) {
+	sd := testData.State()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	snap, err := sd.GetSnapshot(1)
+	check(t, err)
+	if snap != nil {
+		t.Fatalf("expected no snapshot, got %+v", snap)
+	}
+	// incidents 1 and 2 share the snapshot of the first run, 3 has that of the last
+	for run := 1; run <= 3; run++ {
+		snapshotId, err := sd.AddSnapshot(&models.Snapshot{
+			Alert:     "snap",
+			Time:      now.Add(time.Duration(run) * time.Minute),
+			Responses: map[string]map[string]json.RawMessage{"opentsdb": {"q": json.RawMessage(`[]`)}},
+		}, 2)
+		check(t, err)
+		switch run {
+		case 1:
+			check(t, sd.SetIncidentSnapshot(1, snapshotId))
+			check(t, sd.SetIncidentSnapshot(2, snapshotId))
+			snap, err = sd.GetSnapshot(2)
+			check(t, err)
+			if snap == nil || !snap.Time.Equal(now.Add(time.Minute)) {
+				t.Fatalf("unexpected snapshot of incident 2 %+v", snap)
+			}
+		case 3:
+			check(t, sd.SetIncidentSnapshot(3, snapshotId))
+		}
+	}
+	// the oldest run is deleted to keep at most 2
+	for _, id := range []int64{1, 2} {
+		snap, err = sd.GetSnapshot(id)
+		check(t, err)
+		if snap != nil {
+			t.Fatalf("expected the snapshot of incident %d to be deleted, got %+v", id, snap)
+		}
+	}
+	snap, err = sd.GetSnapshot(3)
+	check(t, err)
+	if snap == nil || snap.Alert != "snap" || !snap.Time.Equal(now.Add(3*time.Minute)) || string(snap.Responses["opentsdb"]["q"]) != "[]" {
+		t.Fatalf("unexpected snapshot %+v", snap)
+	}
+}
@@ -13,6 +13,7 @@ import (
 
 var flagReddisHost = flag.String("redis", "", "redis server to test against")
 var flagFlushRedis = flag.Bool("flush", false, "flush database before tests. DANGER!")
+var flagPostgres = flag.String("postgres", "", "postgres connection string to run the sql tests against instead of sqlite")
 
 func StartTestRedis(port int) (database.DataAccess, func()) {
 	flag.Parse()
@@ -44,3 +45,33 @@ func StartTestRedis(port int) (database.DataAccess, func()) {
 		os.RemoveAll(testPath)
 	}
 }
+
+func StartTestSQL() (database.DataAccess, func()) {
+	flag.Parse()
+	if *flagPostgres != "" {
+		testData, err := database.NewSQLDataAccess("postgres", *flagPostgres)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err = testData.Migrate(); err != nil {
+			log.Fatal(err)
+		}
+		return testData, func() {}
+	}
+	// sqlite gets a new database file in a tmp dir, like ledis.
+	testPath := filepath.Join(os.TempDir(), "bosun_sql_test", fmt.Sprint(time.Now().UnixNano()))
+	if err := os.MkdirAll(testPath, 0755); err != nil {
+		log.Fatal(err)
+	}
+	log.Println("Test sqlite at", testPath)
+	testData, err := database.NewSQLDataAccess("sqlite3", filepath.Join(testPath, "bosun.db"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = testData.Migrate(); err != nil {
+		log.Fatal(err)
+	}
+	return testData, func() {
+		os.RemoveAll(testPath)
+	}
+}
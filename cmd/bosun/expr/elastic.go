@@ -3,9 +3,12 @@ package expr
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
+	"bosun.org/cmd/bosun/cache"
 	"bosun.org/cmd/bosun/expr/parse"
 	"bosun.org/models"
 	"bosun.org/opentsdb"
@@ -354,34 +357,6 @@ func (r *ElasticRequest) CacheKey() (string, error) {
 	return fmt.Sprintf("%s:%v\n%s", r.HostKey, r.Indices, b), nil
 }
 
-// timeESRequest execute the elasticsearch query (which may set or hit cache) and returns
-// the search results.
-func timeESRequest(e *State, T miniprofiler.Timer, req *ElasticRequest) (resp *elastic.SearchResult, err error) {
-	e.elasticQueries = append(e.elasticQueries, *req.Source)
-	var source interface{}
-	source, err = req.Source.Source()
-	if err != nil {
-		return resp, fmt.Errorf("failed to get source of request while timing elastic request: %s", err)
-	}
-	b, err := json.MarshalIndent(source, "", "  ")
-	if err != nil {
-		return resp, err
-	}
-	key, err := req.CacheKey()
-	if err != nil {
-		return nil, err
-	}
-	T.StepCustomTiming("elastic", "query", fmt.Sprintf("%s:%v\n%s", req.HostKey, req.Indices, b), func() {
-		getFn := func() (interface{}, error) {
-			return e.ElasticHosts.Query(req)
-		}
-		var val interface{}
-		val, err = e.Cache.Get(key, getFn)
-		resp = val.(*elastic.SearchResult)
-	})
-	return
-}
-
 func ESIndicies(e *State, T miniprofiler.Timer, timeField string, literalIndices ...string) *Results {
 	var r Results
 	indexer := ESIndexer{}
@@ -391,6 +366,7 @@ func ESIndicies(e *State, T miniprofiler.Timer, timeField string, literalIndices
 		return literalIndices
 	}
 	indexer.TimeField = timeField
+	indexer.Key = fmt.Sprintf("indices:%s:%v", timeField, literalIndices)
 	r.Results = append(r.Results, &Result{Value: indexer})
 	return &r
 }
@@ -412,6 +388,7 @@ func ESDaily(e *State, T miniprofiler.Timer, timeField, indexRoot, layout string
 		}
 		return indices
 	}
+	indexer.Key = fmt.Sprintf("daily:%s:%s:%s", timeField, indexRoot, layout)
 	r.Results = append(r.Results, &Result{Value: indexer})
 	return &r, nil
 }
@@ -429,6 +406,7 @@ func ESMonthly(e *State, T miniprofiler.Timer, timeField, indexRoot, layout stri
 		}
 		return indices
 	}
+	indexer.Key = fmt.Sprintf("monthly:%s:%s:%s", timeField, indexRoot, layout)
 	r.Results = append(r.Results, &Result{Value: indexer})
 	return &r, nil
 }
@@ -451,101 +429,271 @@ func ESDateHistogram(prefix string, e *State, T miniprofiler.Timer, indexer ESIn
 	if err != nil {
 		return nil, err
 	}
-	// Extended bounds and min doc count are required to get values back when the bucket value is 0
-	ts := elastic.NewDateHistogramAggregation().Field(indexer.TimeField).Interval(strings.Replace(interval, "M", "n", -1)).MinDocCount(0).ExtendedBoundsMin(req.Start).ExtendedBoundsMax(req.End).Format(elasticRFC3339)
 	if stat_field != "" {
-		ts = ts.SubAggregation("stats", elastic.NewExtendedStatsAggregation().Field(stat_field))
 		switch rstat {
 		case "avg", "min", "max", "sum", "sum_of_squares", "variance", "std_deviation":
 		default:
 			return r, fmt.Errorf("stat function %v not a valid option", rstat)
 		}
 	}
-	if keystring == "" {
-		req.Source = req.Source.Aggregation("ts", ts)
-		result, err := timeESRequest(e, T, req)
-		if err != nil {
-			return nil, err
-		}
-		ts, found := result.Aggregations.DateHistogram("ts")
-		if !found {
-			return nil, fmt.Errorf("expected time series not found in elastic reply")
-		}
-		series := make(Series)
-		for _, v := range ts.Buckets {
-			val := processESBucketItem(v, rstat)
-			if val != nil {
-				series[time.Unix(v.Key/1000, 0).UTC()] = *val
-			}
-		}
-		if len(series) == 0 {
-			return r, nil
+	var keys []string
+	if keystring != "" {
+		keys = strings.Split(keystring, ",")
+	}
+	h := &esHistogram{
+		indexer:   indexer,
+		filter:    filter,
+		keys:      keys,
+		interval:  interval,
+		statField: stat_field,
+		rstat:     rstat,
+		size:      size,
+		prefix:    prefix,
+	}
+	req.Source = h.aggregate(req.Source, *req.Start, *req.End)
+	series, err := timeESHistogram(e, T, req, h)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range series {
+		if keystring != "" && e.Squelched(s.Group) {
+			continue
 		}
 		r.Results = append(r.Results, &Result{
-			Value: series,
-			Group: make(opentsdb.TagSet),
+			Value: Series(s.Points),
+			Group: s.Group.Copy(),
 		})
-		return r, nil
 	}
-	keys := strings.Split(keystring, ",")
+	return r, nil
+}
+
+// esHistogram is a date histogram query of ESDateHistogram.
+type esHistogram struct {
+	indexer   ESIndexer
+	filter    elastic.Query
+	keys      []string
+	interval  string
+	statField string
+	rstat     string
+	size      int
+	prefix    string
+}
+
+// aggregate adds the aggregations of the histogram from start to end to source.
+func (h *esHistogram) aggregate(source *elastic.SearchSource, start, end time.Time) *elastic.SearchSource {
+	// Extended bounds and min doc count are required to get values back when the bucket value is 0
+	ts := elastic.NewDateHistogramAggregation().Field(h.indexer.TimeField).Interval(strings.Replace(h.interval, "M", "n", -1)).MinDocCount(0).ExtendedBoundsMin(start).ExtendedBoundsMax(end).Format(elasticRFC3339)
+	if h.statField != "" {
+		ts = ts.SubAggregation("stats", elastic.NewExtendedStatsAggregation().Field(h.statField))
+	}
+	if len(h.keys) == 0 {
+		return source.Aggregation("ts", ts)
+	}
+	keys := h.keys
 	aggregation := elastic.NewTermsAggregation().Field(keys[len(keys)-1]).Size(0)
 	aggregation = aggregation.SubAggregation("ts", ts)
 	for i := len(keys) - 2; i > -1; i-- {
 		aggregation = elastic.NewTermsAggregation().Field(keys[i]).Size(0).SubAggregation("g_"+keys[i+1], aggregation)
 	}
-	req.Source = req.Source.Aggregation("g_"+keys[0], aggregation)
-	result, err := timeESRequest(e, T, req)
+	return source.Aggregation("g_"+keys[0], aggregation)
+}
+
+// cacheKey identifies the histogram without its time range.
+func (h *esHistogram) cacheKey() (string, error) {
+	f, err := h.filter.Source()
+	if err != nil {
+		return "", err
+	}
+	b, err := json.Marshal(f)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s:%s\n%s\n%v\n%s\n%s\n%s\n%d", h.prefix, h.indexer.Key, b, h.keys, h.interval, h.statField, h.rstat, h.size), nil
+}
+
+// step returns the length of the buckets of fixed intervals for the query cache, or
+// -1 for calendar intervals like weeks and months, and if the indexer can not be
+// identified.
+func (h *esHistogram) step() time.Duration {
+	m := esFixedInterval.FindStringSubmatch(h.interval)
+	if m == nil || h.indexer.Key == "" {
+		return -1
+	}
+	n, err := strconv.Atoi(m[1])
+	if err != nil || n <= 0 {
+		return -1
+	}
+	unit := map[string]time.Duration{
+		"ms": time.Millisecond,
+		"s":  time.Second,
+		"m":  time.Minute,
+		"h":  time.Hour,
+		"d":  24 * time.Hour,
+	}[m[2]]
+	return time.Duration(n) * unit
+}
+
+var esFixedInterval = regexp.MustCompile(`^(\d+)(ms|s|m|h|d)$`)
+
+// query fetches the series of the histogram from start to end.
+func (h *esHistogram) query(e *State, start, end time.Time) (esRanged, error) {
+	req, err := esRangeQuery(h.indexer, h.filter, start, end, h.size, h.prefix)
 	if err != nil {
 		return nil, err
 	}
-	top, ok := result.Aggregations.Terms("g_" + keys[0])
+	req.Source = h.aggregate(req.Source, start, end)
+	result, err := e.ElasticHosts.Query(req)
+	if err != nil {
+		return nil, err
+	}
+	var series esRanged
+	add := func(tags opentsdb.TagSet, ts *elastic.AggregationBucketHistogramItems) {
+		s := make(map[time.Time]float64)
+		for _, v := range ts.Buckets {
+			val := processESBucketItem(v, h.rstat)
+			if val != nil {
+				s[time.Unix(v.Key/1000, 0).UTC()] = *val
+			}
+		}
+		if len(s) > 0 {
+			series = append(series, esSeries{Group: tags, Points: s})
+		}
+	}
+	if len(h.keys) == 0 {
+		ts, found := result.Aggregations.DateHistogram("ts")
+		if !found {
+			return nil, fmt.Errorf("expected time series not found in elastic reply")
+		}
+		add(make(opentsdb.TagSet), ts)
+		return series, nil
+	}
+	top, ok := result.Aggregations.Terms("g_" + h.keys[0])
 	if !ok {
-		return nil, fmt.Errorf("top key g_%v not found in result", keys[0])
+		return nil, fmt.Errorf("top key g_%v not found in result", h.keys[0])
 	}
-	var desc func(*elastic.AggregationBucketKeyItem, opentsdb.TagSet, []string) error
-	desc = func(b *elastic.AggregationBucketKeyItem, tags opentsdb.TagSet, keys []string) error {
+	var desc func(*elastic.AggregationBucketKeyItem, opentsdb.TagSet, []string)
+	desc = func(b *elastic.AggregationBucketKeyItem, tags opentsdb.TagSet, keys []string) {
 		if ts, found := b.DateHistogram("ts"); found {
-			if e.Squelched(tags) {
-				return nil
-			}
-			series := make(Series)
-			for _, v := range ts.Buckets {
-				val := processESBucketItem(v, rstat)
-				if val != nil {
-					series[time.Unix(v.Key/1000, 0).UTC()] = *val
-				}
-			}
-			if len(series) == 0 {
-				return nil
-			}
-			r.Results = append(r.Results, &Result{
-				Value: series,
-				Group: tags.Copy(),
-			})
-			return nil
+			add(tags, ts)
+			return
 		}
 		if len(keys) < 1 {
-			return nil
+			return
 		}
 		n, _ := b.Aggregations.Terms("g_" + keys[0])
 		for _, item := range n.Buckets {
 			key := fmt.Sprint(item.Key)
 			tags[keys[0]] = key
-			if err := desc(item, tags.Copy(), keys[1:]); err != nil {
-				return err
-			}
+			desc(item, tags.Copy(), keys[1:])
 		}
-		return nil
 	}
 	for _, b := range top.Buckets {
 		tags := make(opentsdb.TagSet)
 		key := fmt.Sprint(b.Key)
-		tags[keys[0]] = key
-		if err := desc(b, tags, keys[1:]); err != nil {
-			return nil, err
+		tags[h.keys[0]] = key
+		desc(b, tags, h.keys[1:])
+	}
+	return series, nil
+}
+
+// timeESHistogram returns the series of the histogram of req, through the per run
+// cache and the query cache.
+func timeESHistogram(e *State, T miniprofiler.Timer, req *ElasticRequest, h *esHistogram) (series esRanged, err error) {
+	e.elasticQueries = append(e.elasticQueries, *req.Source)
+	var source interface{}
+	source, err = req.Source.Source()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get source of request while timing elastic request: %s", err)
+	}
+	b, err := json.MarshalIndent(source, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	key, err := req.CacheKey()
+	if err != nil {
+		return nil, err
+	}
+	T.StepCustomTiming("elastic", "query", fmt.Sprintf("%s:%v\n%s", req.HostKey, req.Indices, b), func() {
+		getFn := func() (interface{}, error) {
+			ck, err := h.cacheKey()
+			if err != nil {
+				return nil, err
+			}
+			v, err := e.QueryCache.Get("elastic", ck, *req.Start, *req.End, h.step(), func(start, end time.Time) (cache.Ranged, error) {
+				return h.query(e, start, end)
+			})
+			if err != nil {
+				return nil, err
+			}
+			return v.(esRanged), nil
+		}
+		var val interface{}
+		val, err = e.query("elastic", key, new(esRanged), getFn)
+		series, _ = val.(esRanged)
+	})
+	return
+}
+
+// esRanged are the series of an Elastic date histogram in the query cache.
+type esRanged []esSeries
+
+// esSeries is a series of a date histogram. The points are not a Series, which does
+// not decode from json, so that snapshots can be replayed.
+type esSeries struct {
+	Group  opentsdb.TagSet
+	Points map[time.Time]float64
+}
+
+func (r esRanged) Trim(start, end time.Time) cache.Ranged {
+	var trimmed esRanged
+	for _, s := range r {
+		c := esSeries{Group: s.Group, Points: make(map[time.Time]float64)}
+		for t, v := range s.Points {
+			if !t.Before(start) && !t.After(end) {
+				c.Points[t] = v
+			}
+		}
+		if len(c.Points) > 0 {
+			trimmed = append(trimmed, c)
 		}
 	}
-	return r, nil
+	return trimmed
+}
+
+func (r esRanged) Merge(tail cache.Ranged) cache.Ranged {
+	tails := make(map[string]esSeries)
+	for _, s := range tail.(esRanged) {
+		tails[s.Group.String()] = s
+	}
+	var merged esRanged
+	for _, s := range r {
+		key := s.Group.String()
+		c := esSeries{Group: s.Group, Points: make(map[time.Time]float64, len(s.Points))}
+		for t, v := range s.Points {
+			c.Points[t] = v
+		}
+		if n, ok := tails[key]; ok {
+			for t, v := range n.Points {
+				c.Points[t] = v
+			}
+			delete(tails, key)
+		}
+		merged = append(merged, c)
+	}
+	for _, s := range tail.(esRanged) {
+		if _, ok := tails[s.Group.String()]; ok {
+			merged = append(merged, s)
+		}
+	}
+	return merged
+}
+
+func (r esRanged) Size() int64 {
+	var n int64
+	for _, s := range r {
+		n += 64 + int64(len(s.Group.String())) + 48*int64(len(s.Points))
+	}
+	return n
 }
 
 // ESBaseQuery builds the base query that both ESCount and ESStat share
@@ -563,6 +711,11 @@ func ESBaseQuery(now time.Time, indexer ESIndexer, filter elastic.Query, sdurati
 	}
 	st := now.Add(time.Duration(-start))
 	en := now.Add(time.Duration(-end))
+	return esRangeQuery(indexer, filter, st, en, size, prefix)
+}
+
+// esRangeQuery is ESBaseQuery from st to en.
+func esRangeQuery(indexer ESIndexer, filter elastic.Query, st, en time.Time, size int, prefix string) (*ElasticRequest, error) {
 	indices := indexer.Generate(&st, &en)
 	r := ElasticRequest{
 		Indices: indices,
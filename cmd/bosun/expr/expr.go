@@ -18,6 +18,7 @@ import (
 	"bosun.org/graphite"
 	"bosun.org/models"
 	"bosun.org/opentsdb"
+	"bosun.org/prom"
 	"bosun.org/slog"
 	"github.com/MiniProfiler/go/miniprofiler"
 	"github.com/bosun-monitor/annotate/backend"
@@ -34,6 +35,9 @@ type State struct {
 	autods             int
 	vValue             float64
 
+	// the results of the let bindings that have been evaluated
+	lets map[*parse.LetNode]*Results
+
 	*Backends
 
 	// Bosun Internal
@@ -56,6 +60,7 @@ type Backends struct {
 	ElasticHosts    ElasticHosts
 	InfluxConfig    client.HTTPConfig
 	ElasticConfig   ElasticConfig
+	PromContext     prom.Context
 }
 
 type BosunProviders struct {
@@ -64,6 +69,13 @@ type BosunProviders struct {
 	History   AlertStatusProvider
 	Cache     *cache.Cache
 	Annotate  backend.Backend
+
+	// QueryCache caches query results across check runs, unlike Cache which is per
+	// run. It may be nil.
+	QueryCache *cache.QueryCache
+	// Snapshot, if not nil, records the responses of backend queries, or replays
+	// recorded responses instead of querying the backends.
+	Snapshot *Snapshot
 }
 
 // Alert Status Provider is used to provide information about alert results.
@@ -128,6 +140,41 @@ func (e *Expr) ExecuteState(s *State, T miniprofiler.Timer) (r *Results, queries
 	return
 }
 
+// Constant returns the value of e and true if e does not depend on any data,
+// like "0" or "1 > 2".
+func (e *Expr) Constant() (float64, bool) {
+	return constant(e.Tree.Root)
+}
+
+func constant(node parse.Node) (float64, bool) {
+	switch node := node.(type) {
+	case *parse.NumberNode:
+		return node.Float64, true
+	case *parse.UnaryNode:
+		a, ok := constant(node.Arg)
+		if !ok {
+			return 0, false
+		}
+		return uoperate(node.OpStr, a), true
+	case *parse.BinaryNode:
+		switch node.OpStr {
+		case "+", "-", "*", "/", "**", "%", "==", "!=", ">", "<", ">=", "<=", "||", "&&":
+		default:
+			return 0, false
+		}
+		a, aok := constant(node.Args[0])
+		b, bok := constant(node.Args[1])
+		if aok && bok {
+			return operate(node.OpStr, a, b), true
+		}
+		// operate short circuits a false left side of && before looking at the right
+		if aok && a == 0 && node.OpStr == "&&" {
+			return 0, true
+		}
+	}
+	return 0, false
+}
+
 // errRecover is the handler that turns panics into returns from the top
 // level of Parse.
 func errRecover(errp *error) {
@@ -221,6 +268,8 @@ func (e ESQuery) MarshalJSON() ([]byte, error) {
 type ESIndexer struct {
 	TimeField string
 	Generate  func(startDuration, endDuration *time.Time) []string
+	// Key identifies the indices Generate returns, for the query cache.
+	Key string
 }
 
 func (e ESIndexer) Type() models.FuncType { return models.TypeESIndexer }
@@ -328,6 +377,32 @@ type ResultSliceByGroup ResultSlice
 
 type ResultSliceByValue ResultSlice
 
+// copy returns a copy of r with its own results and series, which can be modified
+// without changing r.
+func (r *Results) copy() *Results {
+	c := *r
+	c.Results = make(ResultSlice, len(r.Results))
+	for i, res := range r.Results {
+		v := res.Value
+		if series, ok := v.(Series); ok {
+			s := make(Series, len(series))
+			for t, f := range series {
+				s[t] = f
+			}
+			v = s
+		}
+		c.Results[i] = &Result{
+			Computations: append(models.Computations(nil), res.Computations...),
+			Value:        v,
+			Group:        res.Group,
+		}
+		if res.Group != nil {
+			c.Results[i].Group = res.Group.Copy()
+		}
+	}
+	return &c
+}
+
 func (r *Results) NaN() Number {
 	if r.NaNValue != nil {
 		return Number(*r.NaNValue)
@@ -396,7 +471,6 @@ func (u *Union) ExtendComputations(o *Result) {
 
 // union returns the combination of a and b where one is a subset of the other.
 func (e *State) union(a, b *Results, expression string) []*Union {
-	const unjoinedGroup = "unjoined group (%v)"
 	var us []*Union
 	if len(a.Results) == 0 || len(b.Results) == 0 {
 		return us
@@ -439,6 +513,14 @@ func (e *State) union(a, b *Results, expression string) []*Union {
 			us = append(us, u)
 		}
 	}
+	return append(us, e.unjoined(a, b, am, bm, expression)...)
+}
+
+// unjoined returns the unions of the results am of a and bm of b that were not
+// joined with a NaN, unless unjoined results are ignored.
+func (e *State) unjoined(a, b *Results, am, bm map[*Result]bool, expression string) []*Union {
+	const unjoinedGroup = "unjoined group (%v)"
+	var us []*Union
 	if !e.unjoinedOk {
 		if !a.IgnoreUnjoined && !b.IgnoreOtherUnjoined {
 			for r := range am {
@@ -468,6 +550,108 @@ func (e *State) union(a, b *Results, expression string) []*Union {
 	return us
 }
 
+// match joins the results of a and b by the tag keys of the vector matching
+// modifiers m. Results of a one-to-one join have the joined tags as group.
+// Results on the many side of a one-to-many join keep their group, to which the
+// included tags of the result they are joined with are added.
+func (e *State) match(a, b *Results, m *parse.VectorMatch, expression string) []*Union {
+	var us []*Union
+	if len(a.Results) == 0 || len(b.Results) == 0 {
+		return us
+	}
+	index := func(rs ResultSlice, side string) map[string]*Result {
+		idx := make(map[string]*Result, len(rs))
+		for _, r := range rs {
+			sig := signature(m, r.Group).String()
+			if _, ok := idx[sig]; ok {
+				if m.Card == parse.CardOneToOne {
+					panic(fmt.Errorf("expr: multiple results for %s on the %s side of %s, use group_left or group_right for a one-to-many join", sig, side, expression))
+				}
+				panic(fmt.Errorf("expr: multiple results for %s on the %s side of %s, many-to-many joins are not supported", sig, side, expression))
+			}
+			idx[sig] = r
+		}
+		return idx
+	}
+	am := make(map[*Result]bool, len(a.Results))
+	bm := make(map[*Result]bool, len(b.Results))
+	for _, ra := range a.Results {
+		am[ra] = true
+	}
+	for _, rb := range b.Results {
+		bm[rb] = true
+	}
+	join := func(ra, rb *Result, group opentsdb.TagSet) {
+		delete(am, ra)
+		delete(bm, rb)
+		u := &Union{
+			A:     ra.Value,
+			B:     rb.Value,
+			Group: group,
+		}
+		u.ExtendComputations(ra)
+		u.ExtendComputations(rb)
+		us = append(us, u)
+	}
+	include := func(many, one *Result) opentsdb.TagSet {
+		g := many.Group.Copy()
+		for _, k := range m.Include {
+			if v, ok := one.Group[k]; ok {
+				g[k] = v
+			} else {
+				delete(g, k)
+			}
+		}
+		return g
+	}
+	switch m.Card {
+	case parse.CardOneToOne:
+		index(a.Results, "left")
+		bi := index(b.Results, "right")
+		for _, ra := range a.Results {
+			sig := signature(m, ra.Group)
+			if rb, ok := bi[sig.String()]; ok {
+				join(ra, rb, sig)
+			}
+		}
+	case parse.CardManyToOne:
+		bi := index(b.Results, "right")
+		for _, ra := range a.Results {
+			if rb, ok := bi[signature(m, ra.Group).String()]; ok {
+				join(ra, rb, include(ra, rb))
+			}
+		}
+	case parse.CardOneToMany:
+		ai := index(a.Results, "left")
+		for _, rb := range b.Results {
+			if ra, ok := ai[signature(m, rb.Group).String()]; ok {
+				join(ra, rb, include(rb, ra))
+			}
+		}
+	}
+	return append(us, e.unjoined(a, b, am, bm, expression)...)
+}
+
+// signature returns the tags of group by which it is joined by m.
+func signature(m *parse.VectorMatch, group opentsdb.TagSet) opentsdb.TagSet {
+	s := make(opentsdb.TagSet)
+	if m.On {
+		for _, k := range m.Keys {
+			if v, ok := group[k]; ok {
+				s[k] = v
+			}
+		}
+		return s
+	}
+	for k, v := range group {
+		s[k] = v
+	}
+	for _, k := range m.Keys {
+		delete(s, k)
+	}
+	return s
+}
+
 func (e *State) walk(node parse.Node, T miniprofiler.Timer) *Results {
 	var res *Results
 	switch node := node.(type) {
@@ -483,12 +667,46 @@ func (e *State) walk(node parse.Node, T miniprofiler.Timer) *Results {
 		res = e.walkExpr(node, T)
 	case *parse.PrefixNode:
 		res = e.walkPrefix(node, T)
+	case *parse.StringNode:
+		res = &Results{Results: ResultSlice{&Result{Value: String(node.Text)}}}
+	case *parse.LetNode:
+		res = e.walk(node.Body, T)
+	case *parse.FuncDefNode:
+		res = e.walk(node.Body, T)
+	case *parse.VarNode:
+		res = e.walkVar(node, T)
+	case *parse.CallNode:
+		res = e.walk(node.Expanded, T)
 	default:
 		panic(fmt.Errorf("expr: unknown node type"))
 	}
 	return res
 }
 
+// walkVar returns the value of a let binding, which is evaluated the first time it
+// is used. Each use gets a copy, since functions may modify the results passed to them.
+func (e *State) walkVar(node *parse.VarNode, T miniprofiler.Timer) *Results {
+	res, ok := e.lets[node.Let]
+	if !ok {
+		T.Step("let: "+node.Name, func(T miniprofiler.Timer) {
+			res = e.walk(node.Let.Value, T)
+		})
+		for _, r := range res.Results {
+			switch v := r.Value.(type) {
+			case Number:
+				e.AddComputation(r, node.Name, v)
+			case Scalar:
+				e.AddComputation(r, node.Name, v)
+			}
+		}
+		if e.lets == nil {
+			e.lets = make(map[*parse.LetNode]*Results)
+		}
+		e.lets[node.Let] = res
+	}
+	return res.copy()
+}
+
 func (e *State) walkExpr(node *parse.ExprNode, T miniprofiler.Timer) *Results {
 	return &Results{
 		Results: ResultSlice{
@@ -507,7 +725,12 @@ func (e *State) walkBinary(node *parse.BinaryNode, T miniprofiler.Timer) *Result
 		IgnoreOtherUnjoined: ar.IgnoreOtherUnjoined || br.IgnoreOtherUnjoined,
 	}
 	T.Step("walkBinary: "+node.OpStr, func(T miniprofiler.Timer) {
-		u := e.union(ar, br, node.String())
+		var u []*Union
+		if node.Match != nil {
+			u = e.match(ar, br, node.Match, node.String())
+		} else {
+			u = e.union(ar, br, node.String())
+		}
 		for _, v := range u {
 			var value Value
 			r := &Result{
@@ -563,6 +786,11 @@ func (e *State) walkBinary(node *parse.BinaryNode, T miniprofiler.Timer) *Result
 					}
 					value = s
 				case Series:
+					// series of different resolutions are combined at the
+					// timestamps of the left one
+					if misaligned(at, bt) {
+						bt = sampleAt(bt, at)
+					}
 					s := make(Series)
 					for k, av := range at {
 						if bv, ok := bt[k]; ok {
@@ -745,6 +973,8 @@ func (e *State) walkFunc(node *parse.FuncNode, T miniprofiler.Timer) *Results {
 				v = e.walkExpr(t, T)
 			case *parse.PrefixNode:
 				v = e.walkPrefix(t, T)
+			case *parse.VarNode, *parse.CallNode:
+				v = extract(e.walk(t, T))
 			default:
 				panic(fmt.Errorf("expr: unknown func arg type"))
 			}
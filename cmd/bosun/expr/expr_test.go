@@ -118,6 +118,33 @@ func TestExprParse(t *testing.T) {
 	}
 }
 
+func TestConstant(t *testing.T) {
+	var constantTests = []struct {
+		input    string
+		constant bool
+		value    float64
+	}{
+		{`0`, true, 0},
+		{`1 > 2`, true, 0},
+		{`-(2 * 3) + 7`, true, 1},
+		{`!1 || 0`, true, 0},
+		{`0 && avg(q("avg:m", "1m", ""))`, true, 0},
+		{`avg(q("avg:m", "1m", "")) && 0`, false, 0},
+		{`avg(q("avg:m", "1m", "")) > 2`, false, 0},
+	}
+	for _, ct := range constantTests {
+		e, err := New(ct.input, TSDB)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		v, ok := e.Constant()
+		if ok != ct.constant || v != ct.value {
+			t.Errorf("%v: got %v, %v, expected %v, %v", ct.input, v, ok, ct.value, ct.constant)
+		}
+	}
+}
+
 var queryTime = time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC)
 
 func TestQueryExpr(t *testing.T) {
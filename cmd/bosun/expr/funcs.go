@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -77,6 +78,22 @@ func tagTranspose(args []parse.Node) (parse.Tags, error) {
 	return tags, nil
 }
 
+func tagAggregate(args []parse.Node) (parse.Tags, error) {
+	tags := make(parse.Tags)
+	if text := args[1].(*parse.StringNode).Text; text != "" {
+		for _, k := range strings.Split(text, ",") {
+			tags[k] = struct{}{}
+		}
+	}
+	// the tags of the set are unknown in the body of a user defined function
+	if atags, err := args[0].Tags(); err != nil {
+		return nil, err
+	} else if atags != nil && !tags.Subset(atags) {
+		return nil, fmt.Errorf("aggregate tags (%v) must be a subset of first argument's tags (%v)", tags, atags)
+	}
+	return tags, nil
+}
+
 func tagRename(args []parse.Node) (parse.Tags, error) {
 	tags, err := tagFirst(args)
 	if err != nil {
@@ -103,6 +120,12 @@ func tagRename(args []parse.Node) (parse.Tags, error) {
 var builtins = map[string]parse.Func{
 	// Reduction functions
 
+	"anomalies": {
+		Args:   []models.FuncType{models.TypeSeriesSet, models.TypeString},
+		Return: models.TypeNumberSet,
+		Tags:   tagFirst,
+		F:      Anomalies,
+	},
 	"avg": {
 		Args:   []models.FuncType{models.TypeSeriesSet},
 		Return: models.TypeNumberSet,
@@ -230,6 +253,13 @@ var builtins = map[string]parse.Func{
 		Return: models.TypeScalar,
 		F:      Ungroup,
 	},
+	"aggregate": {
+		Args:          []models.FuncType{models.TypeVariantSet, models.TypeString, models.TypeString},
+		VariantReturn: true,
+		Tags:          tagAggregate,
+		F:             Aggregate,
+		Check:         aggregateCheck,
+	},
 
 	// Other functions
 
@@ -261,6 +291,18 @@ var builtins = map[string]parse.Func{
 		Tags:   tagFirst,
 		F:      Des,
 	},
+	"holtwinters": {
+		Args:   []models.FuncType{models.TypeSeriesSet, models.TypeString, models.TypeScalar, models.TypeScalar, models.TypeScalar},
+		Return: models.TypeSeriesSet,
+		Tags:   tagFirst,
+		F:      HoltWinters,
+	},
+	"seasonal": {
+		Args:   []models.FuncType{models.TypeSeriesSet, models.TypeString, models.TypeString, models.TypeScalar},
+		Return: models.TypeSeriesSet,
+		Tags:   tagFirst,
+		F:      Seasonal,
+	},
 	"dropge": {
 		Args:   []models.FuncType{models.TypeSeriesSet, models.TypeNumberSet},
 		Return: models.TypeSeriesSet,
@@ -314,6 +356,18 @@ var builtins = map[string]parse.Func{
 		Tags:          tagFirst,
 		F:             Limit,
 	},
+	"topk": {
+		Args:   []models.FuncType{models.TypeNumberSet, models.TypeScalar},
+		Return: models.TypeNumberSet,
+		Tags:   tagFirst,
+		F:      TopK,
+	},
+	"bottomk": {
+		Args:   []models.FuncType{models.TypeNumberSet, models.TypeScalar},
+		Return: models.TypeNumberSet,
+		Tags:   tagFirst,
+		F:      BottomK,
+	},
 	"nv": {
 		Args:   []models.FuncType{models.TypeNumberSet, models.TypeScalar},
 		Return: models.TypeNumberSet,
@@ -341,6 +395,25 @@ var builtins = map[string]parse.Func{
 		Tags:   tagFirst,
 		F:      Shift,
 	},
+	"resample": {
+		Args:   []models.FuncType{models.TypeSeriesSet, models.TypeString, models.TypeString},
+		Return: models.TypeSeriesSet,
+		Tags:   tagFirst,
+		F:      Resample,
+		Check:  resampleCheck,
+	},
+	"fill": {
+		Args:   []models.FuncType{models.TypeSeriesSet, models.TypeString},
+		Return: models.TypeSeriesSet,
+		Tags:   tagFirst,
+		F:      Fill,
+	},
+	"align": {
+		Args:   []models.FuncType{models.TypeSeriesSet, models.TypeSeriesSet},
+		Return: models.TypeSeriesSet,
+		Tags:   tagFirst,
+		F:      Align,
+	},
 	"leftjoin": {
 		Args:     []models.FuncType{models.TypeString, models.TypeString, models.TypeNumberSet},
 		VArgs:    true,
@@ -392,10 +465,21 @@ func V(e *State, T miniprofiler.Timer) (*Results, error) {
 
 func Map(e *State, T miniprofiler.Timer, series *Results, expr *Results) (*Results, error) {
 	newExpr := Expr{expr.Results[0].Value.Value().(NumberExpr).Tree}
+	// let bindings inside the map expression depend on v(), so they are evaluated for
+	// each point, unlike those of the enclosing expression
+	var lets []*parse.LetNode
+	parse.Walk(newExpr.Tree.Root, func(n parse.Node) {
+		if l, ok := n.(*parse.LetNode); ok {
+			lets = append(lets, l)
+		}
+	})
 	for _, result := range series.Results {
 		newSeries := make(Series)
 		for t, v := range result.Value.Value().(Series) {
 			e.vValue = v
+			for _, l := range lets {
+				delete(e.lets, l)
+			}
 			subResults, _, err := newExpr.ExecuteState(e, T)
 			if err != nil {
 				return series, err
@@ -561,6 +645,24 @@ func Limit(e *State, T miniprofiler.Timer, set *Results, v float64) (*Results, e
 	return set, nil
 }
 
+// TopK returns the k results of set with the highest values, highest first.
+func TopK(e *State, T miniprofiler.Timer, set *Results, k float64) (*Results, error) {
+	set, err := Sort(e, T, set, "desc")
+	if err != nil {
+		return nil, err
+	}
+	return Limit(e, T, set, k)
+}
+
+// BottomK returns the k results of set with the lowest values, lowest first.
+func BottomK(e *State, T miniprofiler.Timer, set *Results, k float64) (*Results, error) {
+	set, err := Sort(e, T, set, "asc")
+	if err != nil {
+		return nil, err
+	}
+	return Limit(e, T, set, k)
+}
+
 func Filter(e *State, T miniprofiler.Timer, set *Results, numberSet *Results) (*Results, error) {
 	var ns ResultSlice
 	for _, sr := range set.Results {
@@ -716,6 +818,217 @@ func Shift(e *State, T miniprofiler.Timer, series *Results, d string) (*Results,
 	return series, nil
 }
 
+// resampler returns the function by which resample combines the values of a
+// bucket, in time order: first, last, or an aggregator.
+func resampler(name string) (func([]float64) float64, error) {
+	switch name {
+	case "first":
+		return func(x []float64) float64 { return x[0] }, nil
+	case "last":
+		return func(x []float64) float64 { return x[len(x)-1] }, nil
+	}
+	f, err := aggregator(name)
+	if err != nil {
+		return nil, fmt.Errorf("resample: unknown function %q, must be first, last, sum, avg, min, max, median, count, or pN for the Nth percentile", name)
+	}
+	return f, nil
+}
+
+func resampleCheck(t *parse.Tree, f *parse.FuncNode) error {
+	if n, ok := f.Args[2].(*parse.StringNode); ok {
+		if _, err := resampler(n.Text); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Resample combines the points of each series in buckets of the interval, aligned
+// to the unix epoch, into one point at the start of the bucket. NaN values are
+// skipped.
+func Resample(e *State, T miniprofiler.Timer, series *Results, interval, fn string) (*Results, error) {
+	d, err := opentsdb.ParseDuration(interval)
+	if err != nil {
+		return nil, err
+	}
+	if d <= 0 {
+		return nil, fmt.Errorf("resample: interval must be positive")
+	}
+	f, err := resampler(fn)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range series.Results {
+		buckets := make(map[time.Time][]float64)
+		for _, p := range NewSortedSeries(r.Value.Value().(Series)) {
+			if math.IsNaN(p.V) {
+				continue
+			}
+			b := p.T.Add(-time.Duration(p.T.UnixNano() % int64(d)))
+			buckets[b] = append(buckets[b], p.V)
+		}
+		s := make(Series, len(buckets))
+		for t, x := range buckets {
+			s[t] = f(x)
+		}
+		r.Value = s
+	}
+	return series, nil
+}
+
+// maxFillPoints limits the points fill adds to a series, which would be many for
+// an irregular series with two points close together.
+const maxFillPoints = 1000000
+
+// Fill fills the missing points of each series, on the interval between its two
+// closest points from its first point to its last, and its NaN values. The
+// method is previous, zero or linear. Points before the first value are not
+// filled by previous, and points not between two values are not filled by linear.
+func Fill(e *State, T miniprofiler.Timer, series *Results, method string) (*Results, error) {
+	switch method {
+	case "previous", "zero", "linear":
+	default:
+		return nil, fmt.Errorf("fill: unknown method %q, must be previous, zero, or linear", method)
+	}
+	for _, r := range series.Results {
+		orig := r.Value.Value().(Series)
+		sorted := NewSortedSeries(orig)
+		st := step(sorted)
+		if st == 0 {
+			continue
+		}
+		first, last := sorted[0].T, sorted[len(sorted)-1].T
+		if n := last.Sub(first) / st; n > maxFillPoints {
+			return nil, fmt.Errorf("fill: %v would have %d points, resample it first", r.Group, n)
+		}
+		var points SortableSeries
+		var targets []time.Time
+		s := make(Series, len(sorted))
+		for _, p := range sorted {
+			if math.IsNaN(p.V) {
+				targets = append(targets, p.T)
+				continue
+			}
+			points = append(points, p)
+			s[p.T] = p.V
+		}
+		for t := first; !t.After(last); t = t.Add(st) {
+			if _, ok := orig[t]; !ok {
+				targets = append(targets, t)
+			}
+		}
+		sort.Slice(targets, func(i, j int) bool { return targets[i].Before(targets[j]) })
+		// i is the index of the first point after the target
+		i := 0
+		for _, t := range targets {
+			for i < len(points) && !points[i].T.After(t) {
+				i++
+			}
+			switch {
+			case method == "zero":
+				s[t] = 0
+			case method == "previous" && i > 0:
+				s[t] = points[i-1].V
+			case method == "linear" && i > 0 && i < len(points):
+				p, n := points[i-1], points[i]
+				s[t] = p.V + (n.V-p.V)*float64(t.Sub(p.T))/float64(n.T.Sub(p.T))
+			default:
+				if _, ok := orig[t]; ok {
+					// keep a NaN that could not be filled
+					s[t] = math.NaN()
+				}
+			}
+		}
+		r.Value = s
+	}
+	return series, nil
+}
+
+// Align returns the series of a sampled at the timestamps of the series of b they
+// are joined with. The value at a timestamp is that of the last point at or before
+// it, unless that is further back than the interval between the two closest
+// points of the series of a.
+func Align(e *State, T miniprofiler.Timer, a, b *Results) (*Results, error) {
+	res := &Results{}
+	for _, u := range e.union(a, b, "align") {
+		as, aok := u.A.(Series)
+		bs, bok := u.B.(Series)
+		if !aok || !bok {
+			// unjoined
+			continue
+		}
+		res.Results = append(res.Results, &Result{
+			Group:        u.Group,
+			Value:        sampleAt(as, bs),
+			Computations: u.Computations,
+		})
+	}
+	return res, nil
+}
+
+// step returns the interval between the two closest points of the sorted series
+// s, or 0 if it has fewer than two points.
+func step(s SortableSeries) time.Duration {
+	var d time.Duration
+	for i := 1; i < len(s); i++ {
+		if g := s[i].T.Sub(s[i-1].T); g > 0 && (d == 0 || g < d) {
+			d = g
+		}
+	}
+	return d
+}
+
+// sampleAt returns s sampled at the timestamps of at, as described by Align.
+func sampleAt(s, at Series) Series {
+	sorted := NewSortedSeries(s)
+	maxAge := step(sorted)
+	r := make(Series)
+	for t := range at {
+		i := sort.Search(len(sorted), func(i int) bool { return sorted[i].T.After(t) })
+		if i > 0 && t.Sub(sorted[i-1].T) <= maxAge {
+			r[t] = sorted[i-1].V
+		}
+	}
+	return r
+}
+
+// alignTolerance is how much the resolutions of two series may differ, relative to
+// the coarser one, for them to be combined at their common timestamps, so that jitter
+// of a few points does not resample them.
+const alignTolerance = 0.1
+
+// misaligned reports whether the series a and b have resolutions that differ by more
+// than alignTolerance, or similar resolutions but no common timestamps in the range
+// they overlap, so that they must be aligned to be combined point by point. Series
+// with the same timestamps, as from the same backend, are not sorted.
+func misaligned(a, b Series) bool {
+	common := 0
+	for t := range a {
+		if _, ok := b[t]; ok {
+			common++
+		}
+	}
+	if common == len(a) && common == len(b) {
+		return false
+	}
+	sa, sb := NewSortedSeries(a), NewSortedSeries(b)
+	stepA, stepB := step(sa), step(sb)
+	if stepA == 0 || stepB == 0 {
+		return false
+	}
+	diff, max := stepA-stepB, stepA
+	if diff < 0 {
+		diff, max = -diff, stepB
+	}
+	if float64(diff) > alignTolerance*float64(max) {
+		return true
+	}
+	if common > 0 {
+		return false
+	}
+	return sa[0].T.Before(sb[len(sb)-1].T) && sb[0].T.Before(sa[len(sa)-1].T)
+}
+
 func Duration(e *State, T miniprofiler.Timer, d string) (*Results, error) {
 	duration, err := opentsdb.ParseDuration(d)
 	if err != nil {
@@ -951,6 +1264,216 @@ func Des(e *State, T miniprofiler.Timer, series *Results, alpha float64, beta fl
 	return series
 }
 
+// parseSeason parses the season argument of the seasonal functions.
+func parseSeason(season string) (time.Duration, error) {
+	d, err := opentsdb.ParseDuration(season)
+	if err != nil {
+		return 0, err
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("season must be a positive duration")
+	}
+	return time.Duration(d), nil
+}
+
+// seasonLength returns the number of points in a season of the series, based on the
+// median interval between its points.
+func seasonLength(sorted SortableSeries, season time.Duration) int {
+	if len(sorted) < 2 {
+		return 0
+	}
+	gaps := make([]float64, len(sorted)-1)
+	for i := 1; i < len(sorted); i++ {
+		gaps[i-1] = float64(sorted[i].T.Sub(sorted[i-1].T))
+	}
+	sort.Float64s(gaps)
+	step := gaps[len(gaps)/2]
+	if step <= 0 {
+		return 0
+	}
+	return int(math.Floor(float64(season)/step + .5))
+}
+
+func HoltWinters(e *State, T miniprofiler.Timer, series *Results, season string, alpha, beta, gamma float64) (*Results, error) {
+	d, err := parseSeason(season)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range []float64{alpha, beta, gamma} {
+		if f < 0 || f > 1 {
+			return nil, fmt.Errorf("holtwinters: alpha, beta and gamma must be between 0 and 1")
+		}
+	}
+	for _, res := range series.Results {
+		sorted := NewSortedSeries(res.Value.Value().(Series))
+		res.Value = holtWinters(sorted, seasonLength(sorted, d), alpha, beta, gamma)
+	}
+	return series, nil
+}
+
+// holtWinters returns the one step ahead forecasts of additive triple exponential
+// smoothing with a season of m points. The first season initializes the model and
+// has no forecasts. The result is empty if there are fewer than two seasons of points.
+func holtWinters(sorted SortableSeries, m int, alpha, beta, gamma float64) Series {
+	hw := make(Series)
+	if m < 2 || len(sorted) < 2*m {
+		return hw
+	}
+	var first, second float64
+	for i := 0; i < m; i++ {
+		first += sorted[i].V
+		second += sorted[i+m].V
+	}
+	level := first / float64(m)
+	trend := (second - first) / float64(m*m)
+	seasonal := make([]float64, len(sorted))
+	for i := 0; i < m; i++ {
+		seasonal[i] = sorted[i].V - level
+	}
+	for i := m; i < len(sorted); i++ {
+		hw[sorted[i].T] = level + trend + seasonal[i-m]
+		prev := level
+		level = alpha*(sorted[i].V-seasonal[i-m]) + (1-alpha)*(level+trend)
+		trend = beta*(level-prev) + (1-beta)*trend
+		seasonal[i] = gamma*(sorted[i].V-level) + (1-gamma)*seasonal[i-m]
+	}
+	return hw
+}
+
+// seasonalModel is a linear trend plus a seasonal index for each of the m positions
+// in a season.
+type seasonalModel struct {
+	start            time.Time
+	step             float64 // seconds between positions in the season
+	intercept, slope float64 // of the trend, by seconds since start
+	index            []float64
+	dev              float64 // standard deviation of the residuals
+}
+
+// fitSeasonal decomposes the series into a trend and a seasonal index of m points. It
+// returns nil if there are fewer than two seasons of points.
+func fitSeasonal(sorted SortableSeries, season time.Duration, m int) *seasonalModel {
+	if m < 2 || len(sorted) < 2*m {
+		return nil
+	}
+	s := &seasonalModel{
+		start: sorted[0].T,
+		step:  season.Seconds() / float64(m),
+		index: make([]float64, m),
+	}
+	n := float64(len(sorted))
+	var sx, sy, sxx, sxy float64
+	for _, p := range sorted {
+		x := p.T.Sub(s.start).Seconds()
+		sx += x
+		sy += p.V
+		sxx += x * x
+		sxy += x * p.V
+	}
+	if d := n*sxx - sx*sx; d != 0 {
+		s.slope = (n*sxy - sx*sy) / d
+	}
+	s.intercept = (sy - s.slope*sx) / n
+	counts := make([]float64, m)
+	for _, p := range sorted {
+		i := s.position(p.T)
+		s.index[i] += p.V - s.trend(p.T)
+		counts[i]++
+	}
+	for i := range s.index {
+		if counts[i] > 0 {
+			s.index[i] /= counts[i]
+		}
+	}
+	var ss float64
+	for _, p := range sorted {
+		r := p.V - s.expected(p.T)
+		ss += r * r
+	}
+	s.dev = math.Sqrt(ss / n)
+	return s
+}
+
+func (s *seasonalModel) trend(t time.Time) float64 {
+	return s.intercept + s.slope*t.Sub(s.start).Seconds()
+}
+
+// position returns the position of t in the season.
+func (s *seasonalModel) position(t time.Time) int {
+	m := len(s.index)
+	i := int(math.Floor(t.Sub(s.start).Seconds()/s.step+.5)) % m
+	if i < 0 {
+		i += m
+	}
+	return i
+}
+
+func (s *seasonalModel) expected(t time.Time) float64 {
+	return s.trend(t) + s.index[s.position(t)]
+}
+
+func Seasonal(e *State, T miniprofiler.Timer, series *Results, season, bound string, z float64) (*Results, error) {
+	d, err := parseSeason(season)
+	if err != nil {
+		return nil, err
+	}
+	var sign float64
+	switch bound {
+	case "expected":
+	case "upper":
+		sign = 1
+	case "lower":
+		sign = -1
+	default:
+		return nil, fmt.Errorf("seasonal: bound must be expected, upper or lower, got %s", bound)
+	}
+	for _, res := range series.Results {
+		sorted := NewSortedSeries(res.Value.Value().(Series))
+		out := make(Series)
+		if s := fitSeasonal(sorted, d, seasonLength(sorted, d)); s != nil {
+			for _, p := range sorted {
+				out[p.T] = s.expected(p.T) + sign*z*s.dev
+			}
+		}
+		res.Value = out
+	}
+	return series, nil
+}
+
+func Anomalies(e *State, T miniprofiler.Timer, series *Results, season string) (*Results, error) {
+	d, err := parseSeason(season)
+	if err != nil {
+		return nil, err
+	}
+	return reduce(e, T, series, anomalies, fromScalar(d.Seconds()))
+}
+
+// anomalies returns how many standard deviations the last point is away from the
+// value expected by a seasonal model of the other points. It is NaN if there are not
+// enough points for the model.
+func anomalies(dps Series, args ...float64) float64 {
+	season := time.Duration(args[0] * float64(time.Second))
+	sorted := NewSortedSeries(dps)
+	if len(sorted) < 2 {
+		return math.NaN()
+	}
+	last := sorted[len(sorted)-1]
+	sorted = sorted[:len(sorted)-1]
+	s := fitSeasonal(sorted, season, seasonLength(sorted, season))
+	if s == nil {
+		return math.NaN()
+	}
+	r := math.Abs(last.V - s.expected(last.T))
+	if s.dev == 0 {
+		// without any variation every change is as anomalous as it gets
+		if r == 0 {
+			return 0
+		}
+		return math.MaxFloat64
+	}
+	return r / s.dev
+}
+
 func Streak(e *State, T miniprofiler.Timer, series *Results) (*Results, error) {
 	return reduce(e, T, series, streak)
 }
@@ -1142,11 +1665,15 @@ func Max(e *State, T miniprofiler.Timer, series *Results) (r *Results, err error
 // percentile returns the value at the corresponding percentile between 0 and 1.
 // Min and Max can be simulated using p <= 0 and p >= 1, respectively.
 func percentile(dps Series, args ...float64) (a float64) {
-	p := args[0]
 	var x []float64
 	for _, v := range dps {
 		x = append(x, float64(v))
 	}
+	return quantile(x, args[0])
+}
+
+// quantile returns the value of x at the percentile p between 0 and 1, and sorts x.
+func quantile(x []float64, p float64) float64 {
 	sort.Float64s(x)
 	if p <= 0 {
 		return x[0]
@@ -1251,3 +1778,131 @@ func Transpose(e *State, T miniprofiler.Timer, d *Results, gp string) (*Results,
 	}
 	return &r, nil
 }
+
+// aggregators are the functions by which aggregate combines the values of each
+// group, other than the percentiles. They are not called with an empty slice.
+var aggregators = map[string]func([]float64) float64{
+	"sum": func(x []float64) float64 {
+		var s float64
+		for _, v := range x {
+			s += v
+		}
+		return s
+	},
+	"avg": func(x []float64) float64 {
+		var s float64
+		for _, v := range x {
+			s += v
+		}
+		return s / float64(len(x))
+	},
+	"min": func(x []float64) float64 {
+		return quantile(x, 0)
+	},
+	"max": func(x []float64) float64 {
+		return quantile(x, 1)
+	},
+	"median": func(x []float64) float64 {
+		return quantile(x, .5)
+	},
+	"count": func(x []float64) float64 {
+		return float64(len(x))
+	},
+}
+
+// aggregator returns the aggregator named name, which is one of aggregators or pN
+// for the Nth percentile, such as p95 or p99.9.
+func aggregator(name string) (func([]float64) float64, error) {
+	if f, ok := aggregators[name]; ok {
+		return f, nil
+	}
+	if strings.HasPrefix(name, "p") {
+		if p, err := strconv.ParseFloat(name[1:], 64); err == nil && p >= 0 && p <= 100 {
+			return func(x []float64) float64 {
+				return quantile(x, p/100)
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("aggregate: unknown aggregator %q, must be sum, avg, min, max, median, count, or pN for the Nth percentile", name)
+}
+
+func aggregateCheck(t *parse.Tree, f *parse.FuncNode) error {
+	if n, ok := f.Args[2].(*parse.StringNode); ok {
+		if _, err := aggregator(n.Text); err != nil {
+			return err
+		}
+	}
+	_, err := f.Tags()
+	return err
+}
+
+// Aggregate combines the results of set that have the same values for the tag
+// keys in groups, a comma separated list, into one result with those tags as
+// group. Numbers are combined by the aggregator, and series by the aggregator
+// of the values at each timestamp. NaN values are skipped.
+func Aggregate(e *State, T miniprofiler.Timer, set *Results, groups, agg string) (*Results, error) {
+	f, err := aggregator(agg)
+	if err != nil {
+		return nil, err
+	}
+	var keys []string
+	if groups != "" {
+		keys = strings.Split(groups, ",")
+	}
+	m := make(map[string]*Result)
+	values := make(map[*Result]map[time.Time][]float64)
+	for _, r := range set.Results {
+		g := make(opentsdb.TagSet)
+		for _, k := range keys {
+			v, ok := r.Group[k]
+			if !ok {
+				return nil, fmt.Errorf("aggregate: tag key %s not in group %s", k, r.Group)
+			}
+			g[k] = v
+		}
+		a, ok := m[g.String()]
+		if !ok {
+			a = &Result{Group: g}
+			m[g.String()] = a
+			values[a] = make(map[time.Time][]float64)
+		}
+		// numbers are aggregated as series with a single point at the zero time
+		switch v := r.Value.(type) {
+		case Number:
+			a.Value = Number(0)
+			if !math.IsNaN(float64(v)) {
+				values[a][time.Time{}] = append(values[a][time.Time{}], float64(v))
+			}
+		case Series:
+			a.Value = Series(nil)
+			for t, v := range v {
+				if !math.IsNaN(v) {
+					values[a][t] = append(values[a][t], v)
+				}
+			}
+		default:
+			return nil, fmt.Errorf("aggregate: unexpected value type %T", r.Value)
+		}
+	}
+	aggregate := func(x []float64) float64 {
+		if len(x) == 0 && agg != "count" {
+			return math.NaN()
+		}
+		return f(x)
+	}
+	res := &Results{}
+	for _, a := range m {
+		if _, ok := a.Value.(Number); ok {
+			a.Value = Number(aggregate(values[a][time.Time{}]))
+		} else {
+			s := make(Series)
+			for t, x := range values[a] {
+				s[t] = aggregate(x)
+			}
+			a.Value = s
+		}
+		res.Results = append(res.Results, a)
+	}
+	sort.Sort(ResultSliceByGroup(res.Results))
+	return res, nil
+}
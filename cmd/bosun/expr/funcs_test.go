@@ -2,6 +2,7 @@ package expr
 
 import (
 	"fmt"
+	"math"
 	"testing"
 	"time"
 
@@ -257,3 +258,248 @@ func TestTail(t *testing.T) {
 		}
 	}
 }
+
+// seasonalTestSeries returns six seasons of four hourly points with some noise.
+func seasonalTestSeries() Series {
+	pattern := []float64{10, 20, 30, 20}
+	s := make(Series)
+	start := time.Unix(0, 0)
+	for i := 0; i < 24; i++ {
+		noise := .5
+		if (i/4)%2 == 0 {
+			noise = -.5
+		}
+		s[start.Add(time.Duration(i)*time.Hour)] = pattern[i%4] + noise
+	}
+	return s
+}
+
+func TestHoltWinters(t *testing.T) {
+	sorted := NewSortedSeries(seasonalTestSeries())
+	if m := seasonLength(sorted, 4*time.Hour); m != 4 {
+		t.Fatalf("expected a season of 4 points, got %v", m)
+	}
+	hw := holtWinters(sorted, 4, .5, .1, .5)
+	if len(hw) != 20 {
+		t.Fatalf("expected forecasts for all but the first season, got %v", len(hw))
+	}
+	for _, p := range sorted[4:] {
+		if d := math.Abs(hw[p.T] - p.V); d > 3 {
+			t.Errorf("forecast at %v is %v, expected about %v", p.T, hw[p.T], p.V)
+		}
+	}
+	if hw := holtWinters(sorted[:7], 4, .5, .1, .5); len(hw) != 0 {
+		t.Errorf("expected no forecasts with fewer than two seasons, got %v", hw)
+	}
+}
+
+func TestSeasonal(t *testing.T) {
+	series := seasonalTestSeries()
+	sorted := NewSortedSeries(series)
+	s := fitSeasonal(sorted, 4*time.Hour, 4)
+	if s == nil {
+		t.Fatal("expected a model")
+	}
+	if s.dev == 0 || s.dev > 1 {
+		t.Errorf("expected a deviation below 1 from the noise, got %v", s.dev)
+	}
+	for _, p := range sorted {
+		if d := math.Abs(s.expected(p.T) - p.V); d > 1.5 {
+			t.Errorf("expected value at %v is %v, expected about %v", p.T, s.expected(p.T), p.V)
+		}
+	}
+	if _, err := Seasonal(nil, nil, &Results{}, "4h", "middle", 2); err == nil {
+		t.Error("expected an error for an unknown bound")
+	}
+
+	if a := anomalies(series, 4*3600); a > 2 {
+		t.Errorf("expected no anomaly, got a score of %v", a)
+	}
+	last := sorted[len(sorted)-1]
+	series[last.T] = last.V + 20
+	if a := anomalies(series, 4*3600); a < 10 {
+		t.Errorf("expected an anomaly, got a score of %v", a)
+	}
+	if a := anomalies(Series{last.T: 1}, 4*3600); !math.IsNaN(a) {
+		t.Errorf("expected NaN without enough points, got %v", a)
+	}
+}
+
+func TestAggregate(t *testing.T) {
+	hosts := `merge(series("cluster=a,host=x", 0, 1, 60, 4), series("cluster=a,host=y", 0, 3, 60, 2), series("cluster=b,host=z", 0, 5))`
+	tests := []struct {
+		expr string
+		out  ResultSlice
+	}{
+		{fmt.Sprintf(`aggregate(%v, "cluster", "sum")`, hosts), ResultSlice{
+			{Value: Series{time.Unix(0, 0): 4, time.Unix(60, 0): 6}, Group: opentsdb.TagSet{"cluster": "a"}},
+			{Value: Series{time.Unix(0, 0): 5}, Group: opentsdb.TagSet{"cluster": "b"}},
+		}},
+		{fmt.Sprintf(`aggregate(%v, "", "max")`, hosts), ResultSlice{
+			{Value: Series{time.Unix(0, 0): 5, time.Unix(60, 0): 4}, Group: opentsdb.TagSet{}},
+		}},
+		{fmt.Sprintf(`aggregate(avg(%v), "cluster", "avg")`, hosts), ResultSlice{
+			{Value: Number(2.5), Group: opentsdb.TagSet{"cluster": "a"}},
+			{Value: Number(5), Group: opentsdb.TagSet{"cluster": "b"}},
+		}},
+		{fmt.Sprintf(`aggregate(avg(%v), "cluster", "count")`, hosts), ResultSlice{
+			{Value: Number(2), Group: opentsdb.TagSet{"cluster": "a"}},
+			{Value: Number(1), Group: opentsdb.TagSet{"cluster": "b"}},
+		}},
+		{fmt.Sprintf(`aggregate(last(%v), "", "p50")`, hosts), ResultSlice{
+			{Value: Number(4), Group: opentsdb.TagSet{}},
+		}},
+		{fmt.Sprintf(`func clusters(x) = aggregate(x, "cluster", "min"); clusters(first(%v))`, hosts), ResultSlice{
+			{Value: Number(1), Group: opentsdb.TagSet{"cluster": "a"}},
+			{Value: Number(5), Group: opentsdb.TagSet{"cluster": "b"}},
+		}},
+	}
+	for _, test := range tests {
+		if err := testExpression(exprInOut{test.expr, Results{Results: test.out}, false}); err != nil {
+			t.Errorf("%s: %v", test.expr, err)
+		}
+	}
+	for _, expr := range []string{
+		fmt.Sprintf(`aggregate(%v, "dc", "sum")`, hosts),
+		fmt.Sprintf(`aggregate(%v, "cluster", "p101")`, hosts),
+		fmt.Sprintf(`aggregate(%v, "cluster", "stddev")`, hosts),
+	} {
+		if err := testExpression(exprInOut{expr, Results{}, true}); err != nil {
+			t.Errorf("%s: %v", expr, err)
+		}
+	}
+}
+
+func TestTopK(t *testing.T) {
+	hosts := `avg(merge(series("host=a", 0, 1), series("host=b", 0, 3), series("host=c", 0, 2)))`
+	err := testExpression(exprInOut{
+		fmt.Sprintf("topk(%v, 2)", hosts),
+		Results{
+			Results: ResultSlice{
+				{Value: Number(3), Group: opentsdb.TagSet{"host": "b"}},
+				{Value: Number(2), Group: opentsdb.TagSet{"host": "c"}},
+			},
+		},
+		false,
+	})
+	if err != nil {
+		t.Error(err)
+	}
+	err = testExpression(exprInOut{
+		fmt.Sprintf("bottomk(%v, 1)", hosts),
+		Results{
+			Results: ResultSlice{
+				{Value: Number(1), Group: opentsdb.TagSet{"host": "a"}},
+			},
+		},
+		false,
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestResample(t *testing.T) {
+	s := `series("host=a", 0, 1, 30, 3, 60, 5, 120, 2, 150, 0/0)`
+	tests := []struct {
+		fn  string
+		out Series
+	}{
+		{"avg", Series{time.Unix(0, 0): 2, time.Unix(60, 0): 5, time.Unix(120, 0): 2}},
+		{"sum", Series{time.Unix(0, 0): 4, time.Unix(60, 0): 5, time.Unix(120, 0): 2}},
+		{"last", Series{time.Unix(0, 0): 3, time.Unix(60, 0): 5, time.Unix(120, 0): 2}},
+	}
+	for _, test := range tests {
+		expr := fmt.Sprintf(`resample(%v, "1m", "%v")`, s, test.fn)
+		err := testExpression(exprInOut{
+			expr,
+			Results{Results: ResultSlice{{Value: test.out, Group: opentsdb.TagSet{"host": "a"}}}},
+			false,
+		})
+		if err != nil {
+			t.Errorf("%s: %v", expr, err)
+		}
+	}
+	if err := testExpression(exprInOut{fmt.Sprintf(`resample(%v, "1m", "mode")`, s), Results{}, true}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestFill(t *testing.T) {
+	s := `series("host=a", 0, 1, 60, 0/0, 180, 7, 240, 4)`
+	tests := []struct {
+		method string
+		out    Series
+	}{
+		{"zero", Series{time.Unix(0, 0): 1, time.Unix(60, 0): 0, time.Unix(120, 0): 0, time.Unix(180, 0): 7, time.Unix(240, 0): 4}},
+		{"previous", Series{time.Unix(0, 0): 1, time.Unix(60, 0): 1, time.Unix(120, 0): 1, time.Unix(180, 0): 7, time.Unix(240, 0): 4}},
+		{"linear", Series{time.Unix(0, 0): 1, time.Unix(60, 0): 3, time.Unix(120, 0): 5, time.Unix(180, 0): 7, time.Unix(240, 0): 4}},
+	}
+	for _, test := range tests {
+		expr := fmt.Sprintf(`fill(%v, "%v")`, s, test.method)
+		err := testExpression(exprInOut{
+			expr,
+			Results{Results: ResultSlice{{Value: test.out, Group: opentsdb.TagSet{"host": "a"}}}},
+			false,
+		})
+		if err != nil {
+			t.Errorf("%s: %v", expr, err)
+		}
+	}
+}
+
+func TestAlign(t *testing.T) {
+	// a has a point every 10 seconds, b every minute at an offset of 5 seconds
+	a := `series("host=a", 0, 0, 10, 1, 20, 2, 30, 3, 40, 4, 50, 5, 60, 6, 70, 7)`
+	b := `series("host=a", 5, 10, 65, 20, 125, 30)`
+	err := testExpression(exprInOut{
+		fmt.Sprintf("align(%v, %v)", a, b),
+		Results{Results: ResultSlice{{
+			Value: Series{time.Unix(5, 0): 0, time.Unix(65, 0): 6},
+			Group: opentsdb.TagSet{"host": "a"},
+		}}},
+		false,
+	})
+	if err != nil {
+		t.Error(err)
+	}
+	// binary operators align series of different resolutions at the timestamps
+	// of the left one
+	err = testExpression(exprInOut{
+		fmt.Sprintf("%v + %v", b, a),
+		Results{Results: ResultSlice{{
+			Value: Series{time.Unix(5, 0): 10, time.Unix(65, 0): 26},
+			Group: opentsdb.TagSet{"host": "a"},
+		}}},
+		false,
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMisaligned(t *testing.T) {
+	series := func(secs ...int64) Series {
+		s := make(Series)
+		for _, sec := range secs {
+			s[time.Unix(sec, 0)] = 1
+		}
+		return s
+	}
+	for i, test := range []struct {
+		a, b Series
+		misaligned bool
+	}{
+		{series(0, 60, 120), series(0, 60, 120), false},
+		// a point that arrived late
+		{series(0, 60, 120, 180), series(0, 62, 120, 180), false},
+		{series(0, 10, 20, 30, 40, 50, 60), series(0, 60), true},
+		{series(0, 60, 120), series(5, 65, 125), true},
+		// no overlap
+		{series(0, 60), series(300, 360), false},
+	} {
+		if m := misaligned(test.a, test.b); m != test.misaligned {
+			t.Errorf("%d: expected misaligned %v, got %v", i, test.misaligned, m)
+		}
+	}
+}
@@ -3,9 +3,11 @@ package expr
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
+	"bosun.org/cmd/bosun/cache"
 	"bosun.org/cmd/bosun/expr/parse"
 	"bosun.org/graphite"
 	"bosun.org/models"
@@ -215,11 +217,128 @@ func timeGraphiteRequest(e *State, T miniprofiler.Timer, req *graphite.Request)
 	T.StepCustomTiming("graphite", "query", string(b), func() {
 		key := req.CacheKey()
 		getFn := func() (interface{}, error) {
-			return e.GraphiteContext.Query(req)
+			return e.queryGraphite(req)
 		}
 		var val interface{}
-		val, err = e.Cache.Get(key, getFn)
-		resp = val.(graphite.Response)
+		val, err = e.query("graphite", key, new(graphite.Response), getFn)
+		resp, _ = val.(graphite.Response)
 	})
 	return
 }
+
+// queryGraphite queries Graphite through the query cache.
+func (e *State) queryGraphite(req *graphite.Request) (graphite.Response, error) {
+	key, err := json.Marshal(req.Targets)
+	if err != nil {
+		return nil, err
+	}
+	// functions like summarize and integral depend on the range, so only plain targets
+	// are merged
+	var step time.Duration
+	for _, t := range req.Targets {
+		if strings.Contains(t, "(") {
+			step = -1
+		}
+	}
+	v, err := e.QueryCache.Get("graphite", string(key), *req.Start, *req.End, step, func(start, end time.Time) (cache.Ranged, error) {
+		r := *req
+		r.Start, r.End = &start, &end
+		resp, err := e.GraphiteContext.Query(&r)
+		if err != nil {
+			return nil, err
+		}
+		return graphiteRanged(resp), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return graphite.Response(v.(graphiteRanged)), nil
+}
+
+// graphiteRanged is a Graphite response in the query cache.
+type graphiteRanged graphite.Response
+
+func graphitePointTime(dp graphite.DataPoint) (time.Time, bool) {
+	if len(dp) != 2 {
+		return time.Time{}, false
+	}
+	ts, err := dp[1].Int64()
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(ts, 0), true
+}
+
+func (g graphiteRanged) Trim(start, end time.Time) cache.Ranged {
+	r := make(graphiteRanged, len(g))
+	for i, s := range g {
+		r[i] = graphite.Series{Target: s.Target}
+		for _, dp := range s.Datapoints {
+			if t, ok := graphitePointTime(dp); !ok || !t.Before(start) && !t.After(end) {
+				r[i].Datapoints = append(r[i].Datapoints, dp)
+			}
+		}
+	}
+	return r
+}
+
+func (g graphiteRanged) Merge(tail cache.Ranged) cache.Ranged {
+	tails := make(map[string]graphite.Series)
+	for _, s := range tail.(graphiteRanged) {
+		tails[s.Target] = s
+	}
+	var r graphiteRanged
+	for _, s := range g {
+		n, ok := tails[s.Target]
+		if !ok {
+			r = append(r, s)
+			continue
+		}
+		delete(tails, s.Target)
+		points := make(map[int64]graphite.DataPoint)
+		for _, ps := range [][]graphite.DataPoint{s.Datapoints, n.Datapoints} {
+			for _, dp := range ps {
+				t, ok := graphitePointTime(dp)
+				if !ok {
+					continue
+				}
+				// graphite returns null for points without data, which must not
+				// replace a value that has been fetched before
+				if old, ok := points[t.Unix()]; ok && dp[0] == "" && old[0] != "" {
+					continue
+				}
+				points[t.Unix()] = dp
+			}
+		}
+		m := graphite.Series{Target: s.Target}
+		for _, dp := range points {
+			m.Datapoints = append(m.Datapoints, dp)
+		}
+		sort.Slice(m.Datapoints, func(i, j int) bool {
+			a, _ := m.Datapoints[i][1].Int64()
+			b, _ := m.Datapoints[j][1].Int64()
+			return a < b
+		})
+		r = append(r, m)
+	}
+	for _, s := range tail.(graphiteRanged) {
+		if _, ok := tails[s.Target]; ok {
+			r = append(r, s)
+		}
+	}
+	return r
+}
+
+func (g graphiteRanged) Size() int64 {
+	var n int64
+	for _, s := range g {
+		n += 64 + int64(len(s.Target))
+		for _, dp := range s.Datapoints {
+			n += 48
+			for _, v := range dp {
+				n += int64(len(v))
+			}
+		}
+	}
+	return n
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"bosun.org/cmd/bosun/cache"
 	"bosun.org/cmd/bosun/expr/parse"
 	"bosun.org/models"
 	"bosun.org/opentsdb"
@@ -93,16 +94,30 @@ func InfluxQuery(e *State, T miniprofiler.Timer, db, query, startDuration, endDu
 
 // influxQueryDuration adds time WHERE clauses to query for the given start and end durations.
 func influxQueryDuration(now time.Time, query, start, end, groupByInterval string) (string, error) {
-	sd, err := opentsdb.ParseDuration(start)
+	st, et, err := influxRange(now, start, end)
 	if err != nil {
 		return "", err
 	}
+	return influxQueryRange(query, st, et, groupByInterval)
+}
+
+// influxRange returns the times of the start and end durations before now.
+func influxRange(now time.Time, start, end string) (st, et time.Time, err error) {
+	sd, err := opentsdb.ParseDuration(start)
+	if err != nil {
+		return
+	}
 	ed, err := opentsdb.ParseDuration(end)
 	if end == "" {
 		ed = 0
 	} else if err != nil {
-		return "", err
+		return
 	}
+	return now.Add(time.Duration(-sd)), now.Add(time.Duration(-ed)), nil
+}
+
+// influxQueryRange adds time WHERE clauses to query for the range from start to end.
+func influxQueryRange(query string, start, end time.Time, groupByInterval string) (string, error) {
 	st, err := influxql.ParseStatement(query)
 	if err != nil {
 		return "", err
@@ -136,13 +151,13 @@ func influxQueryDuration(now time.Time, query, start, end, groupByInterval strin
 	startExpr := &influxql.BinaryExpr{
 		Op:  influxql.GTE,
 		LHS: &influxql.VarRef{Val: "time"},
-		RHS: &influxql.TimeLiteral{Val: now.Add(time.Duration(-sd))},
+		RHS: &influxql.TimeLiteral{Val: start},
 	}
 
 	stopExpr := &influxql.BinaryExpr{
 		Op:  influxql.LTE,
 		LHS: &influxql.VarRef{Val: "time"},
-		RHS: &influxql.TimeLiteral{Val: now.Add(time.Duration(-ed))},
+		RHS: &influxql.TimeLiteral{Val: end},
 	}
 
 	if s.Condition != nil {
@@ -188,7 +203,11 @@ func influxQueryDuration(now time.Time, query, start, end, groupByInterval strin
 }
 
 func timeInfluxRequest(e *State, T miniprofiler.Timer, db, query, startDuration, endDuration, groupByInterval string) (s []influxModels.Row, err error) {
-	q, err := influxQueryDuration(e.now, query, startDuration, endDuration, groupByInterval)
+	st, et, err := influxRange(e.now, startDuration, endDuration)
+	if err != nil {
+		return nil, err
+	}
+	q, err := influxQueryRange(query, st, et, groupByInterval)
 	if err != nil {
 		return nil, err
 	}
@@ -198,33 +217,162 @@ func timeInfluxRequest(e *State, T miniprofiler.Timer, db, query, startDuration,
 	}
 	T.StepCustomTiming("influx", "query", q, func() {
 		getFn := func() (interface{}, error) {
-			res, err := conn.Query(client.Query{
-				Command:  q,
-				Database: db,
-			})
+			key := db + "\n" + query + "\n" + groupByInterval
+			step, err := influxStep(q)
 			if err != nil {
 				return nil, err
 			}
-			if res.Error() != nil {
-				return nil, res.Error()
-			}
-			if len(res.Results) != 1 {
-				return nil, fmt.Errorf("influx: expected one result")
-			}
-
-			r := res.Results[0]
-			if r.Err == "" {
-				return r.Series, nil
+			v, err := e.QueryCache.Get("influx", key, st, et, step, func(start, end time.Time) (cache.Ranged, error) {
+				q, err := influxQueryRange(query, start, end, groupByInterval)
+				if err != nil {
+					return nil, err
+				}
+				rows, err := queryInflux(conn, db, q)
+				if err != nil {
+					return nil, err
+				}
+				return influxRanged(rows), nil
+			})
+			if err != nil {
+				return nil, err
 			}
-			err = fmt.Errorf(r.Err)
-			return r.Series, err
+			return []influxModels.Row(v.(influxRanged)), nil
 		}
 		var val interface{}
 		var ok bool
-		val, err = e.Cache.Get(q, getFn)
-		if s, ok = val.([]influxModels.Row); !ok {
+		val, err = e.query("influx", q, new([]influxModels.Row), getFn)
+		if s, ok = val.([]influxModels.Row); !ok && err == nil {
 			err = fmt.Errorf("influx: did not get a valid result from InfluxDB")
 		}
 	})
 	return
 }
+
+// influxStep returns the GROUP BY time interval of the query for the query cache, or -1
+// if the query aggregates its whole range.
+func influxStep(q string) (time.Duration, error) {
+	st, err := influxql.ParseStatement(q)
+	if err != nil {
+		return 0, err
+	}
+	s, ok := st.(*influxql.SelectStatement)
+	if !ok {
+		return 0, fmt.Errorf("influx: expected select statement")
+	}
+	step, err := s.GroupByInterval()
+	if err != nil {
+		return 0, err
+	}
+	if step == 0 && !s.IsRawQuery {
+		return -1, nil
+	}
+	return step, nil
+}
+
+func queryInflux(conn client.Client, db, q string) ([]influxModels.Row, error) {
+	res, err := conn.Query(client.Query{
+		Command:  q,
+		Database: db,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.Error() != nil {
+		return nil, res.Error()
+	}
+	if len(res.Results) != 1 {
+		return nil, fmt.Errorf("influx: expected one result")
+	}
+	r := res.Results[0]
+	if r.Err != "" {
+		return nil, fmt.Errorf(r.Err)
+	}
+	return r.Series, nil
+}
+
+// influxRanged is an InfluxDB result in the query cache.
+type influxRanged []influxModels.Row
+
+func influxRowKey(r influxModels.Row) string {
+	return r.Name + opentsdb.TagSet(r.Tags).String()
+}
+
+func influxPointTime(v []interface{}) (time.Time, bool) {
+	if len(v) == 0 {
+		return time.Time{}, false
+	}
+	s, ok := v[0].(string)
+	if !ok {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	return t, err == nil
+}
+
+func (r influxRanged) Trim(start, end time.Time) cache.Ranged {
+	var rows influxRanged
+	for _, row := range r {
+		c := row
+		c.Values = nil
+		for _, v := range row.Values {
+			if t, ok := influxPointTime(v); !ok || !t.Before(start) && !t.After(end) {
+				c.Values = append(c.Values, v)
+			}
+		}
+		if len(c.Values) == 0 && len(row.Values) > 0 {
+			continue
+		}
+		rows = append(rows, c)
+	}
+	return rows
+}
+
+func (r influxRanged) Merge(tail cache.Ranged) cache.Ranged {
+	tails := make(map[string]influxModels.Row)
+	for _, row := range tail.(influxRanged) {
+		tails[influxRowKey(row)] = row
+	}
+	var rows influxRanged
+	for _, row := range r {
+		key := influxRowKey(row)
+		n, ok := tails[key]
+		if !ok {
+			rows = append(rows, row)
+			continue
+		}
+		delete(tails, key)
+		c := n
+		c.Values = nil
+		var first time.Time
+		if len(n.Values) > 0 {
+			first, _ = influxPointTime(n.Values[0])
+		}
+		// the values are sorted by time, so the cached values before the first
+		// value of the tail precede it
+		for _, v := range row.Values {
+			if t, ok := influxPointTime(v); ok && (first.IsZero() || t.Before(first)) {
+				c.Values = append(c.Values, v)
+			}
+		}
+		c.Values = append(c.Values, n.Values...)
+		rows = append(rows, c)
+	}
+	for _, row := range tail.(influxRanged) {
+		if _, ok := tails[influxRowKey(row)]; ok {
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+func (r influxRanged) Size() int64 {
+	var n int64
+	for _, row := range r {
+		n += 64 + int64(len(row.Name))
+		for k, v := range row.Tags {
+			n += 16 + int64(len(k)+len(v))
+		}
+		n += 96 * int64(len(row.Values))
+	}
+	return n
+}
@@ -0,0 +1,68 @@
+package expr
+
+import (
+	"testing"
+
+	"bosun.org/opentsdb"
+	"github.com/MiniProfiler/go/miniprofiler"
+	"github.com/influxdata/influxdb/client/v2"
+)
+
+func TestLet(t *testing.T) {
+	tests := []struct {
+		expr string
+		out  Number
+	}{
+		{`let s = series("a=b", 0, 1, 1, 3); avg(s) + max(s)`, 5},
+		{`let s = series("a=b", 0, 1, 1, 3); func double(x) = x * 2; avg(s) + max(double(s))`, 8},
+		{`func avgplus(x, n) = avg(x) + n; avgplus(series("a=b", 0, 1, 1, 3), 1)`, 3},
+		// map modifies its argument, which must not change the value of s
+		{`let s = series("a=b", 0, 1, 1, 3); avg(map(s, expr(v() + 1))) + avg(s)`, 5},
+		// bindings inside a map expression are evaluated for each point
+		{`avg(map(series("a=b", 0, 1, 1, 3), expr(let x = v() * 2; x + 1)))`, 5},
+		{`let n = 10; avg(map(series("a=b", 0, 1, 1, 3), expr(v() + n)))`, 12},
+	}
+	for _, test := range tests {
+		err := testExpression(exprInOut{
+			test.expr,
+			Results{
+				Results: ResultSlice{
+					&Result{
+						Value: test.out,
+						Group: opentsdb.TagSet{"a": "b"},
+					},
+				},
+			},
+			false,
+		})
+		if err != nil {
+			t.Errorf("%s: %v", test.expr, err)
+		}
+	}
+}
+
+func TestLetComputations(t *testing.T) {
+	e, err := New(`let cpu = avg(series("a=b", 0, 1, 1, 3)); cpu > 1`, builtins)
+	if err != nil {
+		t.Fatal(err)
+	}
+	backends := &Backends{
+		InfluxConfig: client.HTTPConfig{},
+	}
+	r, _, err := e.Execute(backends, &BosunProviders{}, new(miniprofiler.Profile), queryTime, 0, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(r.Results))
+	}
+	found := false
+	for _, c := range r.Results[0].Computations {
+		if c.Text == "cpu" && c.Value == Number(2) {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("no computation for cpu in %v", r.Results[0].Computations)
+	}
+}
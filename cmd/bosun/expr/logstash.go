@@ -110,8 +110,8 @@ func timeLSRequest(e *State, T miniprofiler.Timer, req *LogstashRequest) (resp *
 			return e.LogstashHosts.Query(req)
 		}
 		var val interface{}
-		val, err = e.Cache.Get(string(b), getFn)
-		resp = val.(*elastic.SearchResult)
+		val, err = e.query("logstash", string(b), new(*elastic.SearchResult), getFn)
+		resp, _ = val.(*elastic.SearchResult)
 	})
 	return
 }
@@ -0,0 +1,70 @@
+package expr
+
+import (
+	"strings"
+	"testing"
+
+	"bosun.org/opentsdb"
+)
+
+func TestVectorMatching(t *testing.T) {
+	ifaces := `avg(merge(series("host=a,iface=x", 0, 4), series("host=a,iface=y", 0, 6), series("host=b,iface=x", 0, 10)))`
+	hosts := `avg(merge(series("host=a", 0, 2), series("host=b", 0, 5)))`
+	descs := `avg(merge(series("host=a,desc=web", 0, 2), series("host=b,desc=db", 0, 5)))`
+	perIface := ResultSlice{
+		{Value: Number(2), Group: opentsdb.TagSet{"host": "a", "iface": "x"}},
+		{Value: Number(3), Group: opentsdb.TagSet{"host": "a", "iface": "y"}},
+		{Value: Number(2), Group: opentsdb.TagSet{"host": "b", "iface": "x"}},
+	}
+	tests := []struct {
+		expr string
+		out  ResultSlice
+	}{
+		{ifaces + " / on(host) group_left " + hosts, perIface},
+		{ifaces + " / ignoring(iface) group_left " + hosts, perIface},
+		{hosts + " * on(host) group_right " + ifaces, ResultSlice{
+			{Value: Number(8), Group: opentsdb.TagSet{"host": "a", "iface": "x"}},
+			{Value: Number(12), Group: opentsdb.TagSet{"host": "a", "iface": "y"}},
+			{Value: Number(50), Group: opentsdb.TagSet{"host": "b", "iface": "x"}},
+		}},
+		{ifaces + " / on(host) group_left(desc) " + descs, ResultSlice{
+			{Value: Number(2), Group: opentsdb.TagSet{"host": "a", "iface": "x", "desc": "web"}},
+			{Value: Number(3), Group: opentsdb.TagSet{"host": "a", "iface": "y", "desc": "web"}},
+			{Value: Number(2), Group: opentsdb.TagSet{"host": "b", "iface": "x", "desc": "db"}},
+		}},
+		{hosts + " + on(host) " + descs, ResultSlice{
+			{Value: Number(4), Group: opentsdb.TagSet{"host": "a"}},
+			{Value: Number(10), Group: opentsdb.TagSet{"host": "b"}},
+		}},
+		{hosts + " - ignoring(desc) " + descs, ResultSlice{
+			{Value: Number(0), Group: opentsdb.TagSet{"host": "a"}},
+			{Value: Number(0), Group: opentsdb.TagSet{"host": "b"}},
+		}},
+	}
+	for _, test := range tests {
+		err := testExpression(exprInOut{test.expr, Results{Results: test.out}, false})
+		if err != nil {
+			t.Errorf("%s: %v", test.expr, err)
+		}
+	}
+}
+
+func TestVectorMatchingErrors(t *testing.T) {
+	ifaces := `avg(merge(series("host=a,iface=x", 0, 4), series("host=a,iface=y", 0, 6)))`
+	hosts := `avg(series("host=a", 0, 2))`
+	tests := []struct {
+		expr string
+		err  string
+	}{
+		{ifaces + " / on(host) " + hosts, "use group_left or group_right"},
+		{hosts + " / on(host) group_left " + ifaces, "many-to-many joins are not supported"},
+		{ifaces + " / on(dc) group_left " + hosts, "tag key dc of on(dc) group_left is not in tags"},
+		{ifaces + " / on(host) group_left(dc) " + hosts, "included tag key dc is not in tags"},
+	}
+	for _, test := range tests {
+		err := testExpression(exprInOut{test.expr, Results{}, false})
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%s: expected error containing %q, got %v", test.expr, test.err, err)
+		}
+	}
+}
@@ -59,7 +59,17 @@ const (
 	itemTripleQuotedString
 	itemPow // '**'
 	itemExpr
-	itemPrefix // [prefix]
+	itemPrefix    // [prefix]
+	itemLet       // let keyword
+	itemFuncDef   // func keyword
+	itemAssign    // '='
+	itemSemicolon // ';'
+
+	// vector matching modifiers of binary operators
+	itemOn
+	itemIgnoring
+	itemGroupLeft
+	itemGroupRight
 )
 
 const eof = -1
@@ -192,6 +202,8 @@ Loop:
 			return lexStringTripleBegin
 		case r == ',':
 			l.emit(itemComma)
+		case r == ';':
+			l.emit(itemSemicolon)
 		case isSpace(r):
 			l.ignore()
 		case r == eof:
@@ -236,6 +248,11 @@ func (l *lexer) scanNumber() bool {
 const symbols = "!<>=&|+-*/%"
 
 func lexSymbol(l *lexer) stateFn {
+	// a single = is an assignment, which may be followed by a unary operator
+	if l.input[l.start:l.pos] == "=" && l.peek() != '=' {
+		l.emit(itemAssign)
+		return lexItem
+	}
 	l.acceptRun(symbols)
 	s := l.input[l.start:l.pos]
 	switch s {
@@ -278,15 +295,28 @@ func lexSymbol(l *lexer) stateFn {
 func lexFunc(l *lexer) stateFn {
 	for {
 		switch r := l.next(); {
-		case unicode.IsLetter(r):
+		case isVarchar(r):
 			// absorb
 		default:
 			l.backup()
-			if l.input[l.start:l.pos] == "expr" {
+			switch l.input[l.start:l.pos] {
+			case "expr":
 				l.emit(itemExpr)
-				return lexItem
+			case "let":
+				l.emit(itemLet)
+			case "func":
+				l.emit(itemFuncDef)
+			case "on":
+				l.emit(itemOn)
+			case "ignoring":
+				l.emit(itemIgnoring)
+			case "group_left":
+				l.emit(itemGroupLeft)
+			case "group_right":
+				l.emit(itemGroupRight)
+			default:
+				l.emit(itemFunc)
 			}
-			l.emit(itemFunc)
 			return lexItem
 		}
 	}
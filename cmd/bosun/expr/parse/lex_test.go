@@ -143,6 +143,43 @@ var lexTests = []lexTest{
 		tRpar,
 		tEOF,
 	}},
+	{"let", "let cpu_2 = -1; func f(x) = x==cpu_2; f(cpu_2)", []item{
+		{itemLet, 0, "let"},
+		{itemFunc, 0, "cpu_2"},
+		{itemAssign, 0, "="},
+		tMinus,
+		{itemNumber, 0, "1"},
+		{itemSemicolon, 0, ";"},
+		{itemFuncDef, 0, "func"},
+		{itemFunc, 0, "f"},
+		tLpar,
+		{itemFunc, 0, "x"},
+		tRpar,
+		{itemAssign, 0, "="},
+		{itemFunc, 0, "x"},
+		tEq,
+		{itemFunc, 0, "cpu_2"},
+		{itemSemicolon, 0, ";"},
+		{itemFunc, 0, "f"},
+		tLpar,
+		{itemFunc, 0, "cpu_2"},
+		tRpar,
+		tEOF,
+	}},
+	{"vector matching", `a / on(host) group_left("if.desc") b`, []item{
+		{itemFunc, 0, "a"},
+		tDiv,
+		{itemOn, 0, "on"},
+		tLpar,
+		{itemFunc, 0, "host"},
+		tRpar,
+		{itemGroupLeft, 0, "group_left"},
+		tLpar,
+		{itemString, 0, `"if.desc"`},
+		tRpar,
+		{itemFunc, 0, "b"},
+		tEOF,
+	}},
 	// errors
 	{"unclosed quote", "\"", []item{
 		{itemError, 0, "unterminated string"},
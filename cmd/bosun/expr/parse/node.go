@@ -9,6 +9,7 @@ package parse
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"bosun.org/models"
 )
@@ -61,6 +62,10 @@ const (
 	NodeNumber                 // A numerical constant.
 	NodeExpr                   // A sub expression
 	NodePrefix                 // A host prefix [""]
+	NodeLet                    // A let binding.
+	NodeDef                    // A user defined function.
+	NodeVar                    // A reference to a let binding.
+	NodeCall                   // A call of a user defined function.
 )
 
 // Nodes.
@@ -346,6 +351,7 @@ type BinaryNode struct {
 	Args     [2]Node
 	Operator item
 	OpStr    string
+	Match    *VectorMatch // nil unless the operator has vector matching modifiers
 }
 
 func newBinary(operator item, arg1, arg2 Node) *BinaryNode {
@@ -353,10 +359,16 @@ func newBinary(operator item, arg1, arg2 Node) *BinaryNode {
 }
 
 func (b *BinaryNode) String() string {
+	if b.Match != nil {
+		return fmt.Sprintf("%s %s %s %s", b.Args[0], b.Operator.val, b.Match, b.Args[1])
+	}
 	return fmt.Sprintf("%s %s %s", b.Args[0], b.Operator.val, b.Args[1])
 }
 
 func (b *BinaryNode) StringAST() string {
+	if b.Match != nil {
+		return fmt.Sprintf("%s %s(%s, %s)", b.Operator.val, b.Match, b.Args[0], b.Args[1])
+	}
 	return fmt.Sprintf("%s(%s, %s)", b.Operator.val, b.Args[0], b.Args[1])
 }
 
@@ -383,6 +395,9 @@ func (b *BinaryNode) Check(t *Tree) error {
 	if err != nil {
 		return err
 	}
+	if b.Match != nil {
+		return b.Match.check(b, g1, g2)
+	}
 	if g1 != nil && g2 != nil && !g1.Subset(g2) && !g2.Subset(g1) {
 		return fmt.Errorf("parse: incompatible tags (%v and %v) in %s", g1, g2, b)
 	}
@@ -403,12 +418,143 @@ func (b *BinaryNode) Tags() (Tags, error) {
 	if err != nil {
 		return nil, err
 	}
+	if b.Match != nil {
+		t2, err := b.Args[1].Tags()
+		if err != nil {
+			return nil, err
+		}
+		return b.Match.tags(t, t2), nil
+	}
 	if t == nil {
 		return b.Args[1].Tags()
 	}
 	return t, nil
 }
 
+// Cardinality is the kind of join of a binary operator with vector matching
+// modifiers.
+type Cardinality int
+
+const (
+	CardOneToOne  Cardinality = iota
+	CardManyToOne             // group_left: many results on the left match one on the right
+	CardOneToMany             // group_right: one result on the left matches many on the right
+)
+
+// VectorMatch holds the vector matching modifiers of a binary operator, which
+// join the results of its arguments by the given tag keys instead of by
+// compatible groups, as in a / on(host) group_left(desc) b.
+type VectorMatch struct {
+	// On is true if results are joined by the tag keys in Keys (on), and false
+	// if they are joined by all of their tag keys except those (ignoring).
+	On   bool
+	Keys []string
+	Card Cardinality
+	// Include are the tag keys copied from the result on the one side of a
+	// one-to-many join to the results of the operator.
+	Include []string
+}
+
+func (m *VectorMatch) String() string {
+	s := "ignoring"
+	if m.On {
+		s = "on"
+	}
+	s += "(" + tagKeys(m.Keys) + ")"
+	switch m.Card {
+	case CardManyToOne:
+		s += " group_left"
+	case CardOneToMany:
+		s += " group_right"
+	default:
+		return s
+	}
+	if len(m.Include) > 0 {
+		s += "(" + tagKeys(m.Include) + ")"
+	}
+	return s
+}
+
+func tagKeys(keys []string) string {
+	s := make([]string, len(keys))
+	for i, k := range keys {
+		s[i] = k
+		if strings.IndexFunc(k, func(r rune) bool { return !isVarchar(r) }) != -1 || k == "" {
+			s[i] = strconv.Quote(k)
+		}
+	}
+	return strings.Join(s, ", ")
+}
+
+func (m *VectorMatch) check(b *BinaryNode, g1, g2 Tags) error {
+	for _, a := range b.Args {
+		if a.Return() == models.TypeScalar {
+			return fmt.Errorf("parse: vector matching requires a NumberSet or SeriesSet on both sides of %s", b)
+		}
+	}
+	if m.On {
+		for _, k := range m.Keys {
+			for _, g := range []Tags{g1, g2} {
+				if _, ok := g[k]; g != nil && !ok {
+					return fmt.Errorf("parse: tag key %s of %s is not in tags (%v) of %s", k, m, g, b)
+				}
+			}
+		}
+	}
+	one := g2
+	if m.Card == CardOneToMany {
+		one = g1
+	}
+	for _, k := range m.Include {
+		if _, ok := one[k]; one != nil && !ok {
+			return fmt.Errorf("parse: included tag key %s is not in tags (%v) of the one side of %s", k, one, b)
+		}
+	}
+	return nil
+}
+
+// tags returns the tags of the results of the operator given the tags of its
+// arguments.
+func (m *VectorMatch) tags(g1, g2 Tags) Tags {
+	var base Tags
+	switch m.Card {
+	case CardManyToOne:
+		base = g1
+	case CardOneToMany:
+		base = g2
+	default:
+		if m.On {
+			t := make(Tags)
+			for _, k := range m.Keys {
+				t[k] = struct{}{}
+			}
+			return t
+		}
+		if g1 == nil {
+			return nil
+		}
+		t := make(Tags)
+		for k := range g1 {
+			t[k] = struct{}{}
+		}
+		for _, k := range m.Keys {
+			delete(t, k)
+		}
+		return t
+	}
+	if base == nil {
+		return nil
+	}
+	t := make(Tags)
+	for k := range base {
+		t[k] = struct{}{}
+	}
+	for _, k := range m.Include {
+		t[k] = struct{}{}
+	}
+	return t
+}
+
 // UnaryNode holds one argument and an operator.
 type UnaryNode struct {
 	NodeType
@@ -447,6 +593,168 @@ func (u *UnaryNode) Tags() (Tags, error) {
 	return u.Arg.Tags()
 }
 
+// LetNode binds the result of Value to Name for the evaluation of Body. Value is
+// evaluated at most once, however often Body refers to it.
+type LetNode struct {
+	NodeType
+	Pos
+	Name  string
+	Value Node
+	Body  Node
+}
+
+func newLet(pos Pos, name string, value Node) *LetNode {
+	return &LetNode{NodeType: NodeLet, Pos: pos, Name: name, Value: value}
+}
+
+func (l *LetNode) String() string {
+	return fmt.Sprintf("let %s = %s; %s", l.Name, l.Value, l.Body)
+}
+
+func (l *LetNode) StringAST() string {
+	return fmt.Sprintf("let(%s, %s, %s)", l.Name, l.Value.StringAST(), l.Body.StringAST())
+}
+
+func (l *LetNode) Check(t *Tree) error {
+	if err := l.Value.Check(t); err != nil {
+		return err
+	}
+	return l.Body.Check(t)
+}
+
+func (l *LetNode) Return() models.FuncType {
+	return l.Body.Return()
+}
+
+func (l *LetNode) Tags() (Tags, error) {
+	return l.Body.Tags()
+}
+
+// VarNode is a reference to the value of a let binding, or to a parameter of a
+// user defined function.
+type VarNode struct {
+	NodeType
+	Pos
+	Name string
+	Let  *LetNode
+}
+
+func newVar(pos Pos, name string, let *LetNode) *VarNode {
+	return &VarNode{NodeType: NodeVar, Pos: pos, Name: name, Let: let}
+}
+
+func (v *VarNode) String() string {
+	return v.Name
+}
+
+func (v *VarNode) StringAST() string {
+	return v.String()
+}
+
+func (v *VarNode) Check(*Tree) error {
+	// The value is checked by its LetNode.
+	return nil
+}
+
+func (v *VarNode) Return() models.FuncType {
+	if v.Let.Value == nil {
+		// the parameter of a function definition, which is only typed when called
+		return models.TypeUnexpected
+	}
+	return v.Let.Value.Return()
+}
+
+func (v *VarNode) Tags() (Tags, error) {
+	if v.Let.Value == nil {
+		return nil, nil
+	}
+	return v.Let.Value.Tags()
+}
+
+// FuncDefNode defines a function for the evaluation of Body. The definition has
+// no value of its own: each call is parsed from Text again with the parameters
+// bound to the arguments of the call.
+type FuncDefNode struct {
+	NodeType
+	Pos
+	Name   string
+	Params []string
+	Text   string // the text of the function body
+	Body   Node
+
+	scope scope // the bindings visible to the function body
+}
+
+func newFuncDef(pos Pos, name string, params []string, s scope) *FuncDefNode {
+	return &FuncDefNode{NodeType: NodeDef, Pos: pos, Name: name, Params: params, scope: s}
+}
+
+func (f *FuncDefNode) String() string {
+	return fmt.Sprintf("func %s(%s) = %s; %s", f.Name, strings.Join(f.Params, ", "), f.Text, f.Body)
+}
+
+func (f *FuncDefNode) StringAST() string {
+	return fmt.Sprintf("func(%s(%s), %s, %s)", f.Name, strings.Join(f.Params, ", "), f.Text, f.Body.StringAST())
+}
+
+func (f *FuncDefNode) Check(t *Tree) error {
+	return f.Body.Check(t)
+}
+
+func (f *FuncDefNode) Return() models.FuncType {
+	return f.Body.Return()
+}
+
+func (f *FuncDefNode) Tags() (Tags, error) {
+	return f.Body.Tags()
+}
+
+// CallNode is a call of a user defined function. Expanded is the body of the
+// function with a LetNode binding each parameter to its argument.
+type CallNode struct {
+	NodeType
+	Pos
+	Name     string
+	Args     []Node
+	Def      *FuncDefNode
+	Expanded Node
+}
+
+func newCall(pos Pos, def *FuncDefNode, args []Node) *CallNode {
+	return &CallNode{NodeType: NodeCall, Pos: pos, Name: def.Name, Args: args, Def: def}
+}
+
+func (c *CallNode) String() string {
+	s := c.Name + "("
+	for i, arg := range c.Args {
+		if i > 0 {
+			s += ", "
+		}
+		s += arg.String()
+	}
+	s += ")"
+	return s
+}
+
+func (c *CallNode) StringAST() string {
+	return c.Expanded.StringAST()
+}
+
+func (c *CallNode) Check(t *Tree) error {
+	if err := c.Expanded.Check(t); err != nil {
+		return fmt.Errorf("%s: %v", c, err)
+	}
+	return nil
+}
+
+func (c *CallNode) Return() models.FuncType {
+	return c.Expanded.Return()
+}
+
+func (c *CallNode) Tags() (Tags, error) {
+	return c.Expanded.Tags()
+}
+
 // Walk invokes f on n and sub-nodes of n.
 func Walk(n Node, f func(Node)) {
 	f(n)
@@ -458,10 +766,19 @@ func Walk(n Node, f func(Node)) {
 		for _, a := range n.Args {
 			Walk(a, f)
 		}
-	case *NumberNode, *StringNode, *ExprNode:
+	case *NumberNode, *StringNode, *ExprNode, *VarNode:
 		// Ignore.
 	case *UnaryNode:
 		Walk(n.Arg, f)
+	case *PrefixNode:
+		Walk(n.Arg, f)
+	case *LetNode:
+		Walk(n.Value, f)
+		Walk(n.Body, f)
+	case *FuncDefNode:
+		Walk(n.Body, f)
+	case *CallNode:
+		Walk(n.Expanded, f)
 	default:
 		panic(fmt.Errorf("other type: %T", n))
 	}
@@ -24,6 +24,7 @@ type Tree struct {
 
 	funcs   []map[string]Func
 	mapExpr bool
+	scope   scope // let bindings and function definitions in scope while parsing
 
 	// Parsing only; cleared after parse.
 	lex       *lexer
@@ -90,6 +91,19 @@ func (t Tags) Intersection(o Tags) Tags {
 	return result
 }
 
+// scope maps the names of let bindings and user defined functions to their *LetNode
+// or *FuncDefNode. It is never modified: with returns an extended copy.
+type scope map[string]Node
+
+func (s scope) with(name string, n Node) scope {
+	c := make(scope, len(s)+1)
+	for k, v := range s {
+		c[k] = v
+	}
+	c[name] = n
+	return c
+}
+
 // Parse returns a Tree, created by parsing the expression described in the
 // argument string. If an error is encountered, parsing stops and an empty Tree
 // is returned with the error.
@@ -238,7 +252,7 @@ func (t *Tree) Parse(text string, funcs ...map[string]Func) (err error) {
 // parse is the top-level parser for an expression.
 // It runs to EOF.
 func (t *Tree) parse() {
-	t.Root = t.O()
+	t.Root = t.program()
 	t.expect(itemEOF, "root input")
 	if err := t.Root.Check(t); err != nil {
 		t.error(err)
@@ -246,6 +260,8 @@ func (t *Tree) parse() {
 }
 
 /* Grammar:
+program -> {def ";"} O
+def -> "let" name "=" param | "func" name "(" [name {"," name}] ")" "=" param
 O -> A {"||" A}
 A -> C {"&&" C}
 C -> P {( "==" | "!=" | ">" | ">=" | "<" | "<=") P}
@@ -253,19 +269,141 @@ P -> M {( "+" | "-" ) M}
 M -> E {( "*" | "/" ) F}
 E -> F {( "**" ) F}
 F -> v | "(" O ")" | "!" O | "-" O
-v -> number | func(..)
+v -> number | func(..) | name
 Func -> optPrefix name "(" param {"," param} ")"
 param -> number | "string" | subExpr | [query]
 optPrefix -> [ prefix ]
 */
 
+// program parses the let bindings and function definitions that precede an
+// expression. Each is in scope for the rest of the input.
+func (t *Tree) program() Node {
+	outer := t.scope
+	defer func() { t.scope = outer }()
+	switch token := t.peek(); token.typ {
+	case itemLet:
+		t.next()
+		name := t.define(t.expect(itemFunc, "let"))
+		t.expect(itemAssign, "let")
+		l := newLet(token.pos, name, t.param(t.next()))
+		t.expect(itemSemicolon, "let")
+		t.scope = t.scope.with(name, l)
+		l.Body = t.program()
+		return l
+	case itemFuncDef:
+		t.next()
+		name := t.define(t.expect(itemFunc, "func"))
+		if _, ok := t.GetFunction(name); ok {
+			t.errorf("func %s redefines a builtin function", name)
+		}
+		t.expect(itemLeftParen, "func")
+		var params []string
+		seen := make(map[string]bool)
+		for t.peek().typ != itemRightParen {
+			if len(params) > 0 {
+				t.expect(itemComma, "func")
+			}
+			p := t.define(t.expect(itemFunc, "func"))
+			if seen[p] {
+				t.errorf("duplicate parameter %s in func %s", p, name)
+			}
+			seen[p] = true
+			params = append(params, p)
+		}
+		t.next()
+		t.expect(itemAssign, "func")
+		d := newFuncDef(token.pos, name, params, t.scope)
+		// The body is parsed here for syntax errors and to find its end, with
+		// parameters that have no value yet. It is type checked at each call.
+		start := t.peek().pos
+		for _, p := range params {
+			t.scope = t.scope.with(p, newLet(token.pos, p, nil))
+		}
+		t.param(t.next())
+		d.Text = strings.TrimSpace(t.lex.input[start:t.expect(itemSemicolon, "func").pos])
+		t.scope = d.scope.with(name, d)
+		d.Body = t.program()
+		return d
+	}
+	return t.O()
+}
+
+// define returns the name of a new binding, which may not hide another.
+func (t *Tree) define(token item) string {
+	if _, ok := t.scope[token.val]; ok {
+		t.errorf("%s is already defined", token.val)
+	}
+	return token.val
+}
+
+// variable returns a reference to the let binding name. Bindings of a string or
+// number constant are replaced by the constant, as functions may require a
+// constant argument, i.e. the query of q().
+func (t *Tree) variable(token item) Node {
+	l, ok := t.scope[token.val].(*LetNode)
+	if !ok {
+		t.errorf("undefined variable %s", token.val)
+	}
+	switch l.Value.(type) {
+	case *StringNode, *NumberNode:
+		return l.Value
+	}
+	return newVar(token.pos, token.val, l)
+}
+
+// call parses the arguments of a call of a user defined function and expands
+// its body.
+func (t *Tree) call(token item, d *FuncDefNode) Node {
+	t.expect(itemLeftParen, "func")
+	var args []Node
+	for t.peek().typ != itemRightParen {
+		if len(args) > 0 {
+			t.expect(itemComma, "func")
+		}
+		args = append(args, t.param(t.next()))
+	}
+	t.next()
+	if len(args) != len(d.Params) {
+		t.errorf("func %s takes %d arguments, got %d", d.Name, len(d.Params), len(args))
+	}
+	c := newCall(token.pos, d, args)
+	s := d.scope
+	var lets []*LetNode
+	for i, p := range d.Params {
+		l := newLet(args[i].Position(), p, args[i])
+		s = s.with(p, l)
+		lets = append(lets, l)
+	}
+	sub := &Tree{Text: d.Text, funcs: t.funcs, mapExpr: t.mapExpr, scope: s}
+	body, err := sub.parseBody()
+	if err != nil {
+		t.errorf("in func %s: %s", d.Name, strings.TrimPrefix(err.Error(), "expr: "))
+	}
+	c.Expanded = body
+	for i := len(lets) - 1; i >= 0; i-- {
+		lets[i].Body = c.Expanded
+		c.Expanded = lets[i]
+	}
+	return c
+}
+
+// parseBody parses the body of a user defined function.
+func (t *Tree) parseBody() (n Node, err error) {
+	defer t.recover(&err)
+	t.lex = lex(t.Text)
+	n = t.param(t.next())
+	t.expect(itemEOF, "func")
+	t.stopParse()
+	return n, nil
+}
+
 // expr:
 func (t *Tree) O() Node {
 	n := t.A()
 	for {
 		switch t.peek().typ {
 		case itemOr:
-			n = newBinary(t.next(), n, t.A())
+			n = t.binary(t.next(), n, t.A)
 		default:
 			return n
 		}
@@ -277,7 +415,7 @@ func (t *Tree) A() Node {
 	for {
 		switch t.peek().typ {
 		case itemAnd:
-			n = newBinary(t.next(), n, t.C())
+			n = t.binary(t.next(), n, t.C)
 		default:
 			return n
 		}
@@ -289,7 +427,7 @@ func (t *Tree) C() Node {
 	for {
 		switch t.peek().typ {
 		case itemEq, itemNotEq, itemGreater, itemGreaterEq, itemLess, itemLessEq:
-			n = newBinary(t.next(), n, t.P())
+			n = t.binary(t.next(), n, t.P)
 		default:
 			return n
 		}
@@ -301,7 +439,7 @@ func (t *Tree) P() Node {
 	for {
 		switch t.peek().typ {
 		case itemPlus, itemMinus:
-			n = newBinary(t.next(), n, t.M())
+			n = t.binary(t.next(), n, t.M)
 		default:
 			return n
 		}
@@ -313,7 +451,7 @@ func (t *Tree) M() Node {
 	for {
 		switch t.peek().typ {
 		case itemMult, itemDiv, itemMod:
-			n = newBinary(t.next(), n, t.E())
+			n = t.binary(t.next(), n, t.E)
 		default:
 			return n
 		}
@@ -325,13 +463,74 @@ func (t *Tree) E() Node {
 	for {
 		switch t.peek().typ {
 		case itemPow:
-			n = newBinary(t.next(), n, t.F())
+			n = t.binary(t.next(), n, t.F)
 		default:
 			return n
 		}
 	}
 }
 
+// binary parses the vector matching modifiers and right argument of the binary
+// operator op with the left argument n.
+func (t *Tree) binary(op item, n Node, right func() Node) Node {
+	m := t.vectorMatch()
+	b := newBinary(op, n, right())
+	b.Match = m
+	return b
+}
+
+func (t *Tree) vectorMatch() *VectorMatch {
+	var m *VectorMatch
+	switch token := t.peek(); token.typ {
+	case itemOn, itemIgnoring:
+		m = &VectorMatch{On: t.next().typ == itemOn}
+		m.Keys = t.tagKeys()
+	case itemGroupLeft, itemGroupRight:
+		t.errorf("%s must follow on or ignoring", token.val)
+	default:
+		return nil
+	}
+	switch t.peek().typ {
+	case itemGroupLeft:
+		m.Card = CardManyToOne
+	case itemGroupRight:
+		m.Card = CardOneToMany
+	default:
+		return m
+	}
+	t.next()
+	if t.peek().typ == itemLeftParen {
+		m.Include = t.tagKeys()
+	}
+	return m
+}
+
+// tagKeys parses a parenthesized list of tag keys, which are quoted unless they
+// are valid identifiers.
+func (t *Tree) tagKeys() []string {
+	t.expect(itemLeftParen, "tag keys")
+	var keys []string
+	for t.peek().typ != itemRightParen {
+		if len(keys) > 0 {
+			t.expect(itemComma, "tag keys")
+		}
+		switch token := t.next(); token.typ {
+		case itemFunc:
+			keys = append(keys, token.val)
+		case itemString:
+			k, err := strconv.Unquote(token.val)
+			if err != nil {
+				t.error(err)
+			}
+			keys = append(keys, k)
+		default:
+			t.unexpected(token, "tag keys")
+		}
+	}
+	t.next()
+	return keys
+}
+
 func (t *Tree) F() Node {
 	switch token := t.peek(); token.typ {
 	case itemNumber, itemFunc:
@@ -361,8 +560,13 @@ func (t *Tree) v() Node {
 		}
 		return n
 	case itemFunc:
-		t.backup()
-		return t.Func()
+		if t.peek().typ != itemLeftParen {
+			return t.variable(token)
+		}
+		if d, ok := t.scope[token.val].(*FuncDefNode); ok {
+			return t.call(token, d)
+		}
+		return t.function(token)
 	default:
 		t.unexpected(token, "input: v()")
 	}
@@ -370,7 +574,11 @@ func (t *Tree) v() Node {
 }
 
 func (t *Tree) Func() (f *FuncNode) {
-	token := t.next()
+	return t.function(t.next())
+}
+
+// function parses the arguments of a call of the builtin function named by token.
+func (t *Tree) function(token item) (f *FuncNode) {
 	funcv, ok := t.GetFunction(token.val)
 	if !ok {
 		t.errorf("non existent function %s", token.val)
@@ -378,56 +586,14 @@ func (t *Tree) Func() (f *FuncNode) {
 	f = newFunc(token.pos, token.val, funcv)
 	t.expect(itemLeftParen, "func")
 	for {
-		switch token = t.next(); token.typ {
-		default:
-			t.backup()
-			node := t.O()
-			f.append(node)
-			if len(f.Args) == 1 && f.F.VariantReturn {
-				f.F.Return = node.Return()
-			}
-		case itemTripleQuotedString:
-			f.append(newString(token.pos, token.val, token.val[3:len(token.val)-3]))
-		case itemString:
-			s, err := strconv.Unquote(token.val)
-			if err != nil {
-				t.errorf("Unquoting error: %s", err)
-			}
-			f.append(newString(token.pos, token.val, s))
-		case itemRightParen:
+		token = t.next()
+		if token.typ == itemRightParen {
 			return
-		case itemExpr:
-			t.expect(itemLeftParen, "v() expect left paran in itemExpr")
-			start := t.lex.lastPos
-			leftCount := 1
-		TOKENS:
-			for {
-				switch token = t.next(); token.typ {
-				case itemLeftParen:
-					leftCount++
-				case itemFunc:
-				case itemRightParen:
-					leftCount--
-					if leftCount == 0 {
-						t.expect(itemRightParen, "v() expect right paren in itemExpr")
-						t.backup()
-						break TOKENS
-					}
-				case itemEOF:
-					t.unexpected(token, "input: v()")
-				default:
-					// continue
-				}
-			}
-			n, err := newExprNode(t.lex.input[start:t.lex.lastPos], t.lex.lastPos)
-			if err != nil {
-				t.error(err)
-			}
-			n.Tree, err = ParseSub(n.Text, t.funcs...)
-			if err != nil {
-				t.error(err)
-			}
-			f.append(n)
+		}
+		node := t.param(token)
+		f.append(node)
+		if len(f.Args) == 1 && f.F.VariantReturn {
+			f.F.Return = node.Return()
 		}
 		switch token = t.next(); token.typ {
 		case itemComma:
@@ -440,6 +606,58 @@ func (t *Tree) Func() (f *FuncNode) {
 	}
 }
 
+// param parses a function argument, or the value of a let binding, that starts
+// with token.
+func (t *Tree) param(token item) Node {
+	switch token.typ {
+	case itemTripleQuotedString:
+		return newString(token.pos, token.val, token.val[3:len(token.val)-3])
+	case itemString:
+		s, err := strconv.Unquote(token.val)
+		if err != nil {
+			t.errorf("Unquoting error: %s", err)
+		}
+		return newString(token.pos, token.val, s)
+	case itemExpr:
+		t.expect(itemLeftParen, "v() expect left paran in itemExpr")
+		start := t.lex.lastPos
+		leftCount := 1
+	TOKENS:
+		for {
+			switch token = t.next(); token.typ {
+			case itemLeftParen:
+				leftCount++
+			case itemRightParen:
+				leftCount--
+				if leftCount == 0 {
+					break TOKENS
+				}
+			case itemEOF:
+				t.unexpected(token, "input: v()")
+			default:
+				// continue
+			}
+		}
+		n, err := newExprNode(t.lex.input[start:token.pos+1], token.pos)
+		if err != nil {
+			t.error(err)
+		}
+		// Map expressions may refer to the let bindings and functions in scope, and
+		// may start with bindings of their own inside the parentheses.
+		sub := New()
+		sub.mapExpr = true
+		sub.scope = t.scope
+		n.Tree = sub
+		if err = sub.Parse(n.Text[1:len(n.Text)-1], t.funcs...); err != nil {
+			t.error(err)
+		}
+		return n
+	default:
+		t.backup()
+		return t.O()
+	}
+}
+
 func (t *Tree) GetFunction(name string) (v Func, ok bool) {
 	for _, funcMap := range t.funcs {
 		if funcMap == nil {
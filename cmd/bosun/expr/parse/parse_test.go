@@ -109,12 +109,37 @@ var parseTests = []parseTest{
 	{"unary series", `!q("q", "1m")`, noError, `!q("q", "1m")`},
 	{"expr in func", `forecastlr(q("q", "1m"), -1)`, noError, `forecastlr(q("q", "1m"), -1)`},
 	{"nested func expr", `avg(q("q","1m")>0)`, noError, `avg(q("q", "1m") > 0)`},
+	{"let", `let a = avg(q("q", "1m")); a > 1 || a < 0`, noError, `let a = avg(q("q", "1m")); a > 1 || a < 0`},
+	{"let constant", `let m = "q"; let d = "1m"; avg(q(m, d))`, noError, `let m = "q"; let d = "1m"; avg(q("q", "1m"))`},
+	{"let expr", `let e = expr(1); let a = 2; a`, noError, `let e = (1); let a = 2; 2`},
+	{"func", `func avgq(m, d) = avg(q(m, d)); avgq("q", "1m") > avgq("r", "1m")`, noError,
+		`func avgq(m, d) = avg(q(m, d)); avgq("q", "1m") > avgq("r", "1m")`},
+	{"func calls func", `let d = "1m"; func s(m) = q(m, d); func a(m) = avg(s(m)); a("q")`, noError,
+		`let d = "1m"; func s(m) = q(m, d); func a(m) = avg(s(m)); a("q")`},
 	// Errors.
 	{"empty", "", hasError, ""},
+	{"undefined variable", "let a = 1; b", hasError, ""},
+	{"variable redefined", "let a = 1; let a = 2; a", hasError, ""},
+	{"let without semicolon", "let a = 1 a", hasError, ""},
+	{"let type error", `let a = band("q", "1h", "1m", "8"); 1`, hasError, ""},
+	{"func redefines builtin", `func avg(m) = m; 1`, hasError, ""},
+	{"func wrong number args", `func f(m) = q(m, "1m"); avg(f())`, hasError, ""},
+	{"func type error", `func f(m) = q(m, "1m"); avg(f(1))`, hasError, ""},
+	{"func recursion", `func f(m) = f(m); f(1)`, hasError, ""},
+	{"func param out of scope", `func f(m) = m; m`, hasError, ""},
+	{"on", `avg(q("q", "1m")) / on(host) avg(q("r", "1m"))`, noError, `avg(q("q", "1m")) / on(host) avg(q("r", "1m"))`},
+	{"ignoring group_left", `avg(q("q", "1m"))*ignoring(iface,"if.name")group_left(desc)avg(q("r", "1m"))`, noError,
+		`avg(q("q", "1m")) * ignoring(iface, "if.name") group_left(desc) avg(q("r", "1m"))`},
+	{"on group_right", `avg(q("q", "1m")) > on() group_right avg(q("r", "1m"))`, noError, `avg(q("q", "1m")) > on() group_right avg(q("r", "1m"))`},
+	{"func reusing on", `func f(x) = x - on(host) avg(q("r", "1m")); f(avg(q("q", "1m")))`, noError,
+		`func f(x) = x - on(host) avg(q("r", "1m")); f(avg(q("q", "1m")))`},
 	{"unclosed function", "avg(", hasError, ""},
 	{"bad function", "bad(1)", hasError, ""},
 	{"bad type", `band("q", "1h", "1m", "8")`, hasError, ""},
 	{"wrong number args", `avg(q("q", "1m"), "1m", 1)`, hasError, ""},
+	{"on scalar", `avg(q("q", "1m")) / on(host) 2`, hasError, ""},
+	{"group_left without on", `avg(q("q", "1m")) / group_left avg(q("r", "1m"))`, hasError, ""},
+	{"unclosed tag keys", `avg(q("q", "1m")) / on(host avg(q("r", "1m"))`, hasError, ""},
 	{"2 series math", `band(q("q", "1m"))+band(q("q", "1m"))`, hasError, ""},
 }
 
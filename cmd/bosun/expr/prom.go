@@ -0,0 +1,238 @@
+package expr
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"bosun.org/cmd/bosun/expr/parse"
+	"bosun.org/models"
+	"bosun.org/opentsdb"
+	"bosun.org/prom"
+	"github.com/MiniProfiler/go/miniprofiler"
+)
+
+// Prom defines functions for use with a Prometheus compatible backend.
+var Prom = map[string]parse.Func{
+	"prom": {
+		Args:   []models.FuncType{models.TypeString, models.TypeString, models.TypeString, models.TypeString},
+		Return: models.TypeSeriesSet,
+		Tags:   promTags,
+		F:      PromQuery,
+	},
+	"promBand": {
+		Args:   []models.FuncType{models.TypeString, models.TypeString, models.TypeString, models.TypeString, models.TypeScalar},
+		Return: models.TypeSeriesSet,
+		Tags:   promTags,
+		F:      PromBand,
+	},
+}
+
+var promByRE = regexp.MustCompile(`\bby\s*\(([^)]*)\)`)
+
+// promTags returns the labels named in the by clauses of the query. When
+// aggregations are nested only the labels common to every by clause survive
+// to the result, so the intersection is returned. Queries without a by clause
+// have no statically known tags.
+func promTags(args []parse.Node) (parse.Tags, error) {
+	q := args[0].(*parse.StringNode).Text
+	var t parse.Tags
+	for _, m := range promByRE.FindAllStringSubmatch(q, -1) {
+		by := make(parse.Tags)
+		for _, l := range strings.Split(m[1], ",") {
+			if l = strings.TrimSpace(l); l != "" {
+				by[l] = struct{}{}
+			}
+		}
+		if t == nil {
+			t = by
+			continue
+		}
+		for k := range t {
+			if _, ok := by[k]; !ok {
+				delete(t, k)
+			}
+		}
+	}
+	if t == nil {
+		t = make(parse.Tags)
+	}
+	return t, nil
+}
+
+func parsePromResponse(e *State, req *prom.Request, resp *prom.Response) ([]*Result, error) {
+	const parseErrFmt = "prom ParseError (%s): %s"
+	results := make([]*Result, 0, len(resp.Data.Result))
+	seen := make(map[string]bool)
+	for _, s := range resp.Data.Result {
+		tags := make(opentsdb.TagSet)
+		for k, v := range s.Metric {
+			if k == "__name__" {
+				continue
+			}
+			v, err := opentsdb.Replace(v, "_")
+			if err != nil {
+				return nil, fmt.Errorf(parseErrFmt, req.URL, fmt.Sprintf("label %s: %v", k, err))
+			}
+			tags[k] = v
+		}
+		if !tags.Valid() {
+			return nil, fmt.Errorf(parseErrFmt, req.URL, fmt.Sprintf("labels would make an invalid tag '%s'", tags))
+		}
+		if ts := tags.String(); !seen[ts] {
+			seen[ts] = true
+		} else {
+			return nil, fmt.Errorf(parseErrFmt, req.URL, fmt.Sprintf("More than 1 series identified by tagset '%v'", ts))
+		}
+		if e.Squelched(tags) {
+			continue
+		}
+		dps := make(Series, len(s.Values))
+		for _, dp := range s.Values {
+			t, err := dp.Time()
+			if err != nil {
+				return nil, fmt.Errorf(parseErrFmt, req.URL, err.Error())
+			}
+			f, err := dp.Float()
+			if err != nil {
+				return nil, fmt.Errorf(parseErrFmt, req.URL, err.Error())
+			}
+			dps[t] = f
+		}
+		results = append(results, &Result{
+			Value: dps,
+			Group: tags,
+		})
+	}
+	return results, nil
+}
+
+func promStep(step string) (time.Duration, error) {
+	d, err := opentsdb.ParseDuration(step)
+	if err != nil {
+		return 0, err
+	}
+	if time.Duration(d) < time.Second {
+		return 0, fmt.Errorf("prom: step must be at least 1s, got %v", step)
+	}
+	return time.Duration(d), nil
+}
+
+// PromQuery runs a range query against a Prometheus compatible server. Series
+// are grouped by their labels, with the metric name label dropped.
+func PromQuery(e *State, T miniprofiler.Timer, query, sduration, eduration, step string) (r *Results, err error) {
+	sd, err := opentsdb.ParseDuration(sduration)
+	if err != nil {
+		return
+	}
+	ed := opentsdb.Duration(0)
+	if eduration != "" {
+		ed, err = opentsdb.ParseDuration(eduration)
+		if err != nil {
+			return
+		}
+	}
+	st, err := promStep(step)
+	if err != nil {
+		return
+	}
+	req := &prom.Request{
+		Expr:  query,
+		Start: e.now.Add(-time.Duration(sd)),
+		End:   e.now.Add(-time.Duration(ed)),
+		Step:  st,
+	}
+	s, err := timePromRequest(e, T, req)
+	if err != nil {
+		return nil, err
+	}
+	r = new(Results)
+	r.Results, err = parsePromResponse(e, req, &s)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// PromBand is like Band but for Prometheus compatible servers.
+func PromBand(e *State, T miniprofiler.Timer, query, duration, period, step string, num float64) (r *Results, err error) {
+	r = new(Results)
+	r.IgnoreOtherUnjoined = true
+	r.IgnoreUnjoined = true
+	T.Step("promBand", func(T miniprofiler.Timer) {
+		var d, p opentsdb.Duration
+		d, err = opentsdb.ParseDuration(duration)
+		if err != nil {
+			return
+		}
+		p, err = opentsdb.ParseDuration(period)
+		if err != nil {
+			return
+		}
+		if num < 1 || num > 100 {
+			err = fmt.Errorf("expr: Band: num out of bounds")
+			return
+		}
+		var st time.Duration
+		st, err = promStep(step)
+		if err != nil {
+			return
+		}
+		now := e.now
+		for i := 0; i < int(num); i++ {
+			now = now.Add(time.Duration(-p))
+			req := &prom.Request{
+				Expr:  query,
+				Start: now.Add(time.Duration(-d)),
+				End:   now,
+				Step:  st,
+			}
+			var s prom.Response
+			s, err = timePromRequest(e, T, req)
+			if err != nil {
+				return
+			}
+			var results []*Result
+			results, err = parsePromResponse(e, req, &s)
+			if err != nil {
+				return
+			}
+			for _, result := range results {
+				var existing *Result
+				for _, res := range r.Results {
+					if result.Group.Equal(res.Group) {
+						existing = res
+						break
+					}
+				}
+				if existing == nil {
+					r.Results = append(r.Results, result)
+					continue
+				}
+				for k, v := range result.Value.(Series) {
+					existing.Value.(Series)[k] = v
+				}
+			}
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("promBand: %v", err)
+	}
+	return
+}
+
+func timePromRequest(e *State, T miniprofiler.Timer, req *prom.Request) (resp prom.Response, err error) {
+	b, _ := json.MarshalIndent(req, "", "  ")
+	T.StepCustomTiming("prom", "query", string(b), func() {
+		key := req.CacheKey()
+		getFn := func() (interface{}, error) {
+			return e.PromContext.Query(req)
+		}
+		var val interface{}
+		val, err = e.query("prom", key, new(prom.Response), getFn)
+		resp, _ = val.(prom.Response)
+	})
+	return
+}
@@ -0,0 +1,98 @@
+package expr
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"bosun.org/cmd/bosun/cache"
+	"bosun.org/opentsdb"
+	"bosun.org/prom"
+	"github.com/MiniProfiler/go/miniprofiler"
+)
+
+func TestPromTags(t *testing.T) {
+	tests := []struct {
+		query  string
+		expect string
+	}{
+		{`up`, ``},
+		{`sum by (host) (rate(x[5m]))`, `host`},
+		{`sum(rate(x[5m])) by (host, dc)`, `dc,host`},
+		{`max by (dc) (sum by (host,dc) (x))`, `dc`},
+	}
+	for _, test := range tests {
+		e, err := New(fmt.Sprintf(`prom("%s", "1h", "", "1m")`, test.query), Prom)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tags, err := e.Root.Tags()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := tags.String(); got != test.expect {
+			t.Errorf("%v: expected tags %q, got %q", test.query, test.expect, got)
+		}
+	}
+}
+
+func TestPromQuery(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/query_range" {
+			http.NotFound(w, r)
+			return
+		}
+		if q := r.FormValue("query"); q != "sum by (host) (x)" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, `{"status":"error","errorType":"bad_data","error":"unexpected query %s"}`, q)
+			return
+		}
+		fmt.Fprint(w, `{"status":"success","data":{"resultType":"matrix","result":[
+			{"metric":{"__name__":"x","host":"web01"},"values":[[1424822340,"1"],[1424822400,"2.5"]]},
+			{"metric":{"host":"web 02"},"values":[[1424822400,"NaN"]]}
+		]}}`)
+	}))
+	defer ts.Close()
+	e := State{
+		now: time.Date(2015, time.February, 25, 0, 0, 0, 0, time.UTC),
+		Backends: &Backends{
+			PromContext: prom.Host(ts.URL),
+		},
+		BosunProviders: &BosunProviders{
+			Squelched: func(tags opentsdb.TagSet) bool {
+				return false
+			},
+			Cache: cache.New(0),
+		},
+	}
+	r, err := PromQuery(&e, new(miniprofiler.Profile), "sum by (host) (x)", "1h", "", "1m")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Results) != 2 {
+		t.Fatalf("expected 2 results, got %v", len(r.Results))
+	}
+	for _, res := range r.Results {
+		s := res.Value.(Series)
+		switch res.Group.String() {
+		case "{host=web01}":
+			if len(s) != 2 || s[time.Unix(1424822400, 0)] != 2.5 {
+				t.Errorf("unexpected series for web01: %v", s)
+			}
+		case "{host=web_02}":
+			if len(s) != 1 {
+				t.Errorf("unexpected series for web_02: %v", s)
+			}
+		default:
+			t.Errorf("unexpected group %v", res.Group)
+		}
+	}
+	if _, err := PromQuery(&e, new(miniprofiler.Profile), "bad", "1h", "", "1m"); err == nil {
+		t.Fatal("expected an error from a bad query")
+	}
+	if _, err := PromQuery(&e, new(miniprofiler.Profile), "sum by (host) (x)", "1h", "", "0s"); err == nil {
+		t.Fatal("expected an error from a zero step")
+	}
+}
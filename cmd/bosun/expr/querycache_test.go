@@ -0,0 +1,198 @@
+package expr
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"bosun.org/cmd/bosun/cache"
+	"bosun.org/opentsdb"
+)
+
+// rangeTSDB answers every query with one point per minute of the requested range,
+// with the unix time in minutes as value, and records the requested ranges. Queries
+// downsampled to 5m get the number of points of the range in each bucket instead.
+type rangeTSDB struct {
+	ranges [][2]int64
+}
+
+func (r *rangeTSDB) Query(req *opentsdb.Request) (opentsdb.ResponseSet, error) {
+	start, end := req.Start.(int64), req.End.(int64)
+	r.ranges = append(r.ranges, [2]int64{start, end})
+	dps := make(map[string]opentsdb.Point)
+	for t := (start + 59) / 60 * 60; t <= end; t += 60 {
+		if req.Queries[0].Downsample == "5m-count" {
+			dps[strconv.FormatInt(t/300*300, 10)]++
+			continue
+		}
+		dps[strconv.FormatInt(t, 10)] = opentsdb.Point(t / 60)
+	}
+	return opentsdb.ResponseSet{{Metric: "m", Tags: opentsdb.TagSet{"a": "b"}, DPS: dps}}, nil
+}
+
+func (r *rangeTSDB) Version() opentsdb.Version {
+	return opentsdb.Version2_2
+}
+
+func TestQueryCacheTSDB(t *testing.T) {
+	tsdb := &rangeTSDB{}
+	e := &State{
+		Backends:       &Backends{TSDBContext: tsdb},
+		BosunProviders: &BosunProviders{QueryCache: cache.NewQueryCache(1<<20, 2*time.Minute)},
+	}
+	query := func(start, end int64) opentsdb.ResponseSet {
+		q, err := opentsdb.ParseQuery("avg:m{a=*}", opentsdb.Version2_2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rs, err := e.queryTSDB(&opentsdb.Request{Start: start, End: end, Queries: []*opentsdb.Query{q}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return rs
+	}
+	check := func(rs opentsdb.ResponseSet, start, end int64) {
+		if len(rs) != 1 {
+			t.Fatalf("expected 1 response, got %d", len(rs))
+		}
+		if n := (end-start)/60 + 1; int64(len(rs[0].DPS)) != n {
+			t.Errorf("%d-%d: expected %d points, got %d", start, end, n, len(rs[0].DPS))
+		}
+		for ts := start; ts <= end; ts += 60 {
+			if v := rs[0].DPS[strconv.FormatInt(ts, 10)]; v != opentsdb.Point(ts/60) {
+				t.Errorf("%d: expected %d, got %v", ts, ts/60, v)
+			}
+		}
+	}
+	check(query(0, 3600), 0, 3600)
+	// a later range only fetches the tail and refetches the last two minutes
+	check(query(600, 4200), 600, 4200)
+	// an earlier part of the cached range is not fetched at all
+	check(query(1200, 3000), 1200, 3000)
+	// a range that starts before the cached one is fetched entirely
+	check(query(60, 4200), 60, 4200)
+	expected := [][2]int64{{0, 3600}, {3480, 4200}, {60, 4200}}
+	if len(tsdb.ranges) != len(expected) {
+		t.Fatalf("expected queries %v, got %v", expected, tsdb.ranges)
+	}
+	for i, r := range expected {
+		if tsdb.ranges[i] != r {
+			t.Errorf("query %d: expected range %v, got %v", i, r, tsdb.ranges[i])
+		}
+	}
+	s := e.QueryCache.Status()
+	if st := s.Stats["opentsdb"]; st.Hits != 1 || st.Partial != 1 || st.Misses != 2 {
+		t.Errorf("unexpected stats: %+v", st)
+	}
+	if len(s.Entries) != 1 {
+		t.Errorf("expected 1 entry, got %d", len(s.Entries))
+	}
+	if n := e.QueryCache.Flush(); n != 1 {
+		t.Errorf("expected to flush 1 entry, flushed %d", n)
+	}
+}
+
+func TestQueryCacheTSDBBuckets(t *testing.T) {
+	tsdb := &rangeTSDB{}
+	e := &State{
+		Backends:       &Backends{TSDBContext: tsdb},
+		BosunProviders: &BosunProviders{QueryCache: cache.NewQueryCache(1<<20, 2*time.Minute)},
+	}
+	query := func(q string, start, end int64) opentsdb.ResponseSet {
+		tq, err := opentsdb.ParseQuery(q, opentsdb.Version2_2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rs, err := e.queryTSDB(&opentsdb.Request{Start: start, End: end, Queries: []*opentsdb.Query{tq}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return rs
+	}
+	query("sum:5m-count:m{a=*}", 0, 3600)
+	rs := query("sum:5m-count:m{a=*}", 600, 4200)
+	// the tail is fetched from a bucket boundary, and the buckets before its end are
+	// complete
+	for ts := int64(600); ts < 4200; ts += 300 {
+		if v := rs[0].DPS[strconv.FormatInt(ts, 10)]; v != 5 {
+			t.Errorf("%d: expected 5 points in the bucket, got %v", ts, v)
+		}
+	}
+	if r := tsdb.ranges[1]; r != [2]int64{3000, 4200} {
+		t.Errorf("expected the tail to be fetched from 3000, got %v", r)
+	}
+	// the first bucket has the time of its start, before that of the range
+	rs = query("sum:5m-count:m{a=*}", 610, 4200)
+	if _, ok := rs[0].DPS["600"]; !ok {
+		t.Error("expected the bucket at 600")
+	}
+
+	// aggregates of the whole range are only reused for the same range
+	query("sum:0all-count:m{a=*}", 0, 3600)
+	query("sum:0all-count:m{a=*}", 0, 3600)
+	query("sum:0all-count:m{a=*}", 600, 4200)
+	expected := [][2]int64{{0, 3600}, {3000, 4200}, {0, 3600}, {600, 4200}}
+	if len(tsdb.ranges) != len(expected) {
+		t.Fatalf("expected queries %v, got %v", expected, tsdb.ranges)
+	}
+	for i, r := range expected {
+		if tsdb.ranges[i] != r {
+			t.Errorf("query %d: expected range %v, got %v", i, r, tsdb.ranges[i])
+		}
+	}
+}
+
+func TestQueryCacheElastic(t *testing.T) {
+	for interval, step := range map[string]time.Duration{
+		"1m":  time.Minute,
+		"12h": 12 * time.Hour,
+		"1d":  24 * time.Hour,
+		"1w":  -1,
+		"1M":  -1,
+	} {
+		h := &esHistogram{indexer: ESIndexer{Key: "daily:@timestamp:logstash-:2006.01.02"}, interval: interval}
+		if s := h.step(); s != step {
+			t.Errorf("%s: expected step %v, got %v", interval, step, s)
+		}
+	}
+
+	at := func(m int) time.Time { return time.Unix(int64(m)*60, 0).UTC() }
+	cached := esRanged{
+		{Group: opentsdb.TagSet{"host": "a"}, Points: map[time.Time]float64{at(0): 1, at(1): 2}},
+		{Group: opentsdb.TagSet{"host": "b"}, Points: map[time.Time]float64{at(0): 1}},
+	}
+	tail := esRanged{
+		{Group: opentsdb.TagSet{"host": "a"}, Points: map[time.Time]float64{at(1): 3, at(2): 4}},
+		{Group: opentsdb.TagSet{"host": "c"}, Points: map[time.Time]float64{at(2): 5}},
+	}
+	merged := cached.Merge(tail).Trim(at(1), at(2)).(esRanged)
+	// snapshots record the series as json
+	b, err := json.Marshal(merged)
+	if err != nil {
+		t.Fatal(err)
+	}
+	merged = nil
+	if err := json.Unmarshal(b, &merged); err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]map[time.Time]float64{
+		"{host=a}": {at(1): 3, at(2): 4},
+		"{host=c}": {at(2): 5},
+	}
+	if len(merged) != len(expected) {
+		t.Fatalf("expected %d series, got %v", len(expected), merged)
+	}
+	for _, s := range merged {
+		e := expected[s.Group.String()]
+		if len(s.Points) != len(e) {
+			t.Errorf("%s: expected %v, got %v", s.Group, e, s.Points)
+			continue
+		}
+		for k, v := range e {
+			if s.Points[k] != v {
+				t.Errorf("%s: expected %v, got %v", s.Group, e, s.Points)
+			}
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package expr
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"bosun.org/models"
+	"bosun.org/slog"
+)
+
+// Snapshot records the responses of the backend queries of expressions, or
+// replays recorded responses instead of querying the backends.
+type Snapshot struct {
+	replay bool
+
+	sync.Mutex
+	s *models.Snapshot
+}
+
+// NewSnapshot returns a snapshot that records the responses of the queries of
+// alert evaluated at t.
+func NewSnapshot(alert string, t time.Time) *Snapshot {
+	return &Snapshot{
+		s: &models.Snapshot{
+			Alert:     alert,
+			Time:      t,
+			Responses: make(map[string]map[string]json.RawMessage),
+		},
+	}
+}
+
+// ReplaySnapshot returns a snapshot that answers queries with the responses
+// recorded in s. Queries that were not recorded fail.
+func ReplaySnapshot(s *models.Snapshot) *Snapshot {
+	return &Snapshot{replay: true, s: s}
+}
+
+// Recorded returns the recorded responses, or nil if there are none.
+func (s *Snapshot) Recorded() *models.Snapshot {
+	s.Lock()
+	defer s.Unlock()
+	if len(s.s.Responses) == 0 {
+		return nil
+	}
+	return s.s
+}
+
+func (s *Snapshot) record(backend, key string, v interface{}) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		slog.Errorf("snapshot: not recording %s query: %v", backend, err)
+		return
+	}
+	s.Lock()
+	defer s.Unlock()
+	if s.s.Responses[backend] == nil {
+		s.s.Responses[backend] = make(map[string]json.RawMessage)
+	}
+	s.s.Responses[backend][key] = b
+}
+
+// get decodes the recorded response of a query into v, a pointer to a value of
+// the type of the response, and returns that value.
+func (s *Snapshot) get(backend, key string, v interface{}) (interface{}, error) {
+	s.Lock()
+	b, ok := s.s.Responses[backend][key]
+	s.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("%s: query not in snapshot of %s at %s", backend, s.s.Alert, s.s.Time.Format(time.RFC3339))
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return nil, err
+	}
+	return reflect.ValueOf(v).Elem().Interface(), nil
+}
+
+// query gets the response of a backend query through the per run cache. If the
+// state has a snapshot, the response is recorded to it, or replayed from it into
+// v, a pointer to a value of the type of the response.
+func (e *State) query(backend, key string, v interface{}, getFn func() (interface{}, error)) (interface{}, error) {
+	s := e.Snapshot
+	if s != nil && s.replay {
+		return s.get(backend, key, v)
+	}
+	val, err := e.Cache.Get(key, getFn)
+	if err == nil && s != nil {
+		s.record(backend, key, val)
+	}
+	return val, err
+}
@@ -0,0 +1,63 @@
+package expr
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"bosun.org/opentsdb"
+)
+
+// downTSDB fails every query.
+type downTSDB struct{}
+
+func (downTSDB) Query(req *opentsdb.Request) (opentsdb.ResponseSet, error) {
+	return nil, fmt.Errorf("tsdb is down")
+}
+
+func (downTSDB) Version() opentsdb.Version {
+	return opentsdb.Version2_2
+}
+
+func TestSnapshotReplay(t *testing.T) {
+	now := time.Unix(3600, 0).UTC()
+	execute := func(text string, tsdb opentsdb.Context, snap *Snapshot) (*Results, error) {
+		e, err := New(text, TSDB)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r, _, err := e.Execute(&Backends{TSDBContext: tsdb}, &BosunProviders{Snapshot: snap}, nil, now, 0, false)
+		return r, err
+	}
+	const query = `avg(q("avg:m{a=*}", "10m", ""))`
+	rec := NewSnapshot("a", now)
+	if rec.Recorded() != nil {
+		t.Fatal("expected no recorded responses")
+	}
+	live, err := execute(query, &rangeTSDB{}, rec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	snap := rec.Recorded()
+	if snap == nil || len(snap.Responses["opentsdb"]) != 1 || !snap.Time.Equal(now) {
+		t.Fatalf("unexpected snapshot %+v", snap)
+	}
+
+	replayed, err := execute(query, downTSDB{}, ReplaySnapshot(snap))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := live.Equal(replayed); err != nil {
+		t.Error(err)
+	}
+	// the live result is the average of the minutes 50 to 60
+	if v := replayed.Results[0].Value; v != Number(55) {
+		t.Errorf("expected 55, got %v", v)
+	}
+
+	_, err = execute(`avg(q("avg:m{a=*}", "20m", ""))`, downTSDB{}, ReplaySnapshot(snap))
+	if err == nil || !strings.Contains(err.Error(), "query not in snapshot") {
+		t.Errorf("expected a query not in snapshot error, got %v", err)
+	}
+}
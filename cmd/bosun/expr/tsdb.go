@@ -6,8 +6,10 @@ import (
 	"math"
 	"reflect"
 	"strconv"
+	"strings"
 	"time"
 
+	"bosun.org/cmd/bosun/cache"
 	"bosun.org/cmd/bosun/expr/parse"
 	"bosun.org/models"
 	"bosun.org/opentsdb"
@@ -79,11 +81,13 @@ func timeTSDBRequest(e *State, T miniprofiler.Timer, req *opentsdb.Request) (s o
 	for {
 		T.StepCustomTiming("tsdb", "query", string(b), func() {
 			getFn := func() (interface{}, error) {
-				return e.TSDBContext.Query(req)
+				return e.queryTSDB(req)
 			}
 			var val interface{}
-			val, err = e.Cache.Get(string(b), getFn)
-			s = val.(opentsdb.ResponseSet).Copy()
+			val, err = e.query("opentsdb", string(b), new(opentsdb.ResponseSet), getFn)
+			if err == nil {
+				s = val.(opentsdb.ResponseSet).Copy()
+			}
 
 		})
 		if err == nil || tries == tsdbMaxTries {
@@ -95,6 +99,140 @@ func timeTSDBRequest(e *State, T miniprofiler.Timer, req *opentsdb.Request) (s o
 	return
 }
 
+// queryTSDB queries OpenTSDB through the query cache if the request has an absolute
+// time range.
+func (e *State) queryTSDB(req *opentsdb.Request) (opentsdb.ResponseSet, error) {
+	start, sok := req.Start.(int64)
+	end, eok := req.End.(int64)
+	if !sok || !eok {
+		return e.TSDBContext.Query(req)
+	}
+	r := *req
+	r.Start, r.End = nil, nil
+	key, err := json.Marshal(&r)
+	if err != nil {
+		return nil, err
+	}
+	v, err := e.QueryCache.Get("opentsdb", string(key), time.Unix(start, 0), time.Unix(end, 0), tsdbStep(req), func(start, end time.Time) (cache.Ranged, error) {
+		r := *req
+		r.Start, r.End = start.Unix(), end.Unix()
+		rs, err := e.TSDBContext.Query(&r)
+		if err != nil {
+			return nil, err
+		}
+		return tsdbRanged{rs, req.MsResolution}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(tsdbRanged).rs, nil
+}
+
+// tsdbStep returns the longest downsample interval of the queries of the request for
+// the query cache, or -1 if a query aggregates its whole range.
+func tsdbStep(req *opentsdb.Request) time.Duration {
+	var step time.Duration
+	for _, q := range req.Queries {
+		if q.Downsample == "" {
+			continue
+		}
+		interval := strings.SplitN(q.Downsample, "-", 2)[0]
+		if strings.HasSuffix(interval, "all") {
+			return -1
+		}
+		d, err := opentsdb.ParseDuration(interval)
+		if err != nil {
+			return -1
+		}
+		if time.Duration(d) > step {
+			step = time.Duration(d)
+		}
+	}
+	return step
+}
+
+// tsdbRanged is an OpenTSDB response set in the query cache.
+type tsdbRanged struct {
+	rs opentsdb.ResponseSet
+	ms bool // the timestamps are in milliseconds
+}
+
+func (t tsdbRanged) time(ts string) (time.Time, bool) {
+	i, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	if t.ms {
+		return time.Unix(0, i*int64(time.Millisecond)), true
+	}
+	return time.Unix(i, 0), true
+}
+
+func tsdbResponseKey(r *opentsdb.Response) string {
+	return r.Metric + r.Tags.String() + strings.Join(r.AggregateTags, ",")
+}
+
+func (t tsdbRanged) Trim(start, end time.Time) cache.Ranged {
+	var rs opentsdb.ResponseSet
+	for _, r := range t.rs {
+		dps := make(map[string]opentsdb.Point)
+		for k, v := range r.DPS {
+			if ts, ok := t.time(k); !ok || !ts.Before(start) && !ts.After(end) {
+				dps[k] = v
+			}
+		}
+		// OpenTSDB omits series without points in the range
+		if len(dps) == 0 && len(r.DPS) > 0 {
+			continue
+		}
+		c := *r
+		c.DPS = dps
+		rs = append(rs, &c)
+	}
+	return tsdbRanged{rs, t.ms}
+}
+
+func (t tsdbRanged) Merge(tail cache.Ranged) cache.Ranged {
+	tails := make(map[string]*opentsdb.Response)
+	for _, r := range tail.(tsdbRanged).rs {
+		tails[tsdbResponseKey(r)] = r
+	}
+	var rs opentsdb.ResponseSet
+	for _, r := range t.rs {
+		c := *r
+		c.DPS = make(map[string]opentsdb.Point, len(r.DPS))
+		for k, v := range r.DPS {
+			c.DPS[k] = v
+		}
+		key := tsdbResponseKey(r)
+		if n, ok := tails[key]; ok {
+			for k, v := range n.DPS {
+				c.DPS[k] = v
+			}
+			delete(tails, key)
+		}
+		rs = append(rs, &c)
+	}
+	for _, r := range tail.(tsdbRanged).rs {
+		if _, ok := tails[tsdbResponseKey(r)]; ok {
+			rs = append(rs, r)
+		}
+	}
+	return tsdbRanged{rs, t.ms}
+}
+
+func (t tsdbRanged) Size() int64 {
+	var n int64
+	for _, r := range t.rs {
+		n += 64 + int64(len(r.Metric))
+		for k, v := range r.Tags {
+			n += 16 + int64(len(k)+len(v))
+		}
+		n += 48 * int64(len(r.DPS))
+	}
+	return n
+}
+
 func bandTSDB(e *State, T miniprofiler.Timer, query, duration, period string, num float64, rfunc func(*Results, *opentsdb.Response, time.Duration) error) (r *Results, err error) {
 	r = new(Results)
 	r.IgnoreOtherUnjoined = true
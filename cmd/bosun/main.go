@@ -5,6 +5,7 @@ package main
 import (
 	"flag"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	_ "net/http/pprof"
@@ -12,6 +13,7 @@ import (
 	"os"
 	"os/signal"
 	"path/filepath"
+	"sort"
 	"strings"
 	"syscall"
 	"time"
@@ -23,6 +25,7 @@ import (
 	"bosun.org/cmd/bosun/database"
 	"bosun.org/cmd/bosun/ping"
 	"bosun.org/cmd/bosun/sched"
+	"bosun.org/cmd/bosun/search"
 	"bosun.org/cmd/bosun/web"
 	"bosun.org/collect"
 	"bosun.org/graphite"
@@ -93,8 +96,17 @@ var (
 	flagDev      = flag.Bool("dev", false, "enable dev mode: use local resources; no syslog")
 	flagSkipLast = flag.Bool("skiplast", false, "skip loading last datapoints from and to redis: useful for speeding up bosun startup time during development")
 	flagVersion  = flag.Bool("version", false, "Prints the version and exits")
+	flagExport   = flag.String("export", "", "export incidents, silences and metadata to the given file and exit")
+	flagImport   = flag.String("import", "", "import incidents, silences and metadata from a file written by -export and exit")
+	flagRunTests = flag.Bool("run-tests", false, "with -t, also run the test sections of the rule file against their fixtures; exits with 1 if any fail")
+	flagSimulate = flag.String("simulate", "", "simulate the checks of the named alert from -from to -to, print its status changes and notifications and exit")
+	flagFrom     = flag.String("from", "", "start of the -simulate range: an RFC 3339 time or a duration before now, like 2d")
+	flagTo       = flag.String("to", "", "end of the -simulate range like -from; defaults to now")
+	flagLint     = flag.Bool("lint", false, "check the rule file for problems that don't keep it from loading, print them and exit; exits with 1 if there are any")
 
 	mains []func() // Used to hook up syslog on *nix systems
+	// auditSyslog opens syslog for the audit log, nil on systems without syslog
+	auditSyslog func() (io.Writer, error)
 )
 
 func main() {
@@ -119,6 +131,25 @@ func main() {
 		slog.Fatalf("couldn't read rules: %v", err)
 	}
 	if *flagTest {
+		if *flagRunTests && !runRuleTests(ruleConf) {
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+	if *flagLint {
+		ok, err := lint(sysProvider, ruleConf)
+		if err != nil {
+			slog.Fatal(err)
+		}
+		if !ok {
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+	if *flagSimulate != "" {
+		if err := simulate(sysProvider, ruleConf, *flagSimulate, *flagFrom, *flagTo); err != nil {
+			slog.Fatal(err)
+		}
 		os.Exit(0)
 	}
 	var ruleProvider conf.RuleConfProvider = ruleConf
@@ -141,6 +172,12 @@ func main() {
 	if err != nil {
 		slog.Fatal(err)
 	}
+	if *flagExport != "" || *flagImport != "" {
+		if err := archive(da, *flagExport, *flagImport); err != nil {
+			slog.Fatal(err)
+		}
+		os.Exit(0)
+	}
 	var annotateBackend backend.Backend
 	if sysProvider.AnnotateEnabled() {
 		index := sysProvider.GetAnnotateIndex()
@@ -164,6 +201,14 @@ func main() {
 	if err := sched.Load(sysProvider, ruleProvider, da, annotateBackend, *flagSkipLast, *flagQuiet); err != nil {
 		slog.Fatal(err)
 	}
+	if timeout := sysProvider.GetLeaderTimeout(); timeout > 0 {
+		sched.DefaultSched.Election = sched.NewElection(da, timeout)
+		go sched.DefaultSched.Election.Run()
+	}
+	if timeout := sysProvider.GetShardTimeout(); timeout > 0 {
+		sched.DefaultSched.Shards = sched.NewShards(da, timeout)
+		go sched.DefaultSched.Shards.Run()
+	}
 	if err := metadata.InitF(false, func(k metadata.Metakey, v interface{}) error { return sched.DefaultSched.PutMetadata(k, v) }); err != nil {
 		slog.Fatal(err)
 	}
@@ -200,6 +245,15 @@ func main() {
 			slog.Fatalf("InternetProxy error: %s", err)
 		}
 	}
+	if sysProvider.GetAuditSyslog() {
+		if auditSyslog == nil {
+			slog.Fatal("AuditSyslog is not supported on this system")
+		}
+		web.AuditLog, err = auditSyslog()
+		if err != nil {
+			slog.Fatalf("AuditSyslog error: %s", err)
+		}
+	}
 	var cmdHook conf.SaveHook
 	if hookPath := sysProvider.GetCommandHookPath(); hookPath != "" {
 		cmdHook, err = conf.MakeSaveCommandHook(hookPath)
@@ -228,13 +282,19 @@ func main() {
 		newConf.SetReload(reload)
 		oldSched := sched.DefaultSched
 		oldSearch := oldSched.Search
+		oldQueryCache := oldSched.QueryCache
+		oldElection := oldSched.Election
+		oldShards := oldSched.Shards
 		sched.Close(true)
 		sched.Reset()
 		newSched := sched.DefaultSched
 		newSched.Search = oldSearch
+		newSched.QueryCache = oldQueryCache
+		newSched.Election = oldElection
+		newSched.Shards = oldShards
 		slog.Infoln("schedule shutdown, loading new schedule")
 
-		// Load does not set the DataAccess or Search if it is already set
+		// Load does not set the DataAccess, Search or QueryCache if it is already set
 		if err := sched.Load(sysProvider, newConf, da, annotateBackend, *flagSkipLast, *flagQuiet); err != nil {
 			slog.Fatal(err)
 		}
@@ -275,6 +335,12 @@ func main() {
 			go func() {
 				slog.Infoln("Interrupt: closing down...")
 				sched.Close(false)
+				if e := sched.DefaultSched.Election; e != nil {
+					e.Resign()
+				}
+				if s := sched.DefaultSched.Shards; s != nil {
+					s.Leave()
+				}
 				slog.Infoln("done")
 				os.Exit(0)
 			}()
@@ -297,7 +363,13 @@ func quit() {
 
 func initDataAccess(systemConf conf.SystemConfProvider) (database.DataAccess, error) {
 	var da database.DataAccess
-	if systemConf.GetRedisHost() != "" {
+	if systemConf.GetSQLDriver() != "" {
+		var err error
+		da, err = database.NewSQLDataAccess(systemConf.GetSQLDriver(), systemConf.GetSQLDataSource())
+		if err != nil {
+			return nil, err
+		}
+	} else if systemConf.GetRedisHost() != "" {
 		da = database.NewDataAccess(systemConf.GetRedisHost(), true, systemConf.GetRedisDb(), systemConf.GetRedisPassword())
 	} else {
 		_, err := database.StartLedis(systemConf.GetLedisDir(), systemConf.GetLedisBindAddr())
@@ -310,6 +382,144 @@ func initDataAccess(systemConf conf.SystemConfProvider) (database.DataAccess, er
 	return da, err
 }
 
+// archive exports the data store to exportFile or imports importFile into it.
+func archive(da database.DataAccess, exportFile, importFile string) error {
+	var counts database.ArchiveCounts
+	if exportFile != "" {
+		f, err := os.Create(exportFile)
+		if err != nil {
+			return err
+		}
+		if counts, err = database.Export(da, f); err != nil {
+			f.Close()
+			return err
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+		slog.Infof("exported %v to %s", counts, exportFile)
+	}
+	if importFile != "" {
+		f, err := os.Open(importFile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		if counts, err = database.Import(da, f); err != nil {
+			return err
+		}
+		slog.Infof("imported %v from %s", counts, importFile)
+	}
+	return nil
+}
+
+// runRuleTests runs the alert tests of the rule file, prints their outcome and
+// reports whether all passed.
+func runRuleTests(c *rule.Conf) bool {
+	ok := true
+	for _, r := range c.RunTests() {
+		switch {
+		case r.Error != "":
+			fmt.Printf("ERROR %s: %s\n", r.Name, r.Error)
+		case len(r.Failures) > 0:
+			fmt.Printf("FAIL  %s\n", r.Name)
+			for _, f := range r.Failures {
+				fmt.Printf("      %s\n", f)
+			}
+		default:
+			fmt.Printf("PASS  %s\n", r.Name)
+			continue
+		}
+		ok = false
+	}
+	return ok
+}
+
+// lint prints the lint warnings of the rule file and reports whether there are
+// none. The metrics and tag keys are checked against the search data if it is in
+// Redis or SQL; the embedded ledis is left to the running bosun.
+func lint(sc conf.SystemConfProvider, c *rule.Conf) (bool, error) {
+	var s *search.Search
+	if sc.GetSQLDriver() != "" || sc.GetRedisHost() != "" {
+		da, err := initDataAccess(sc)
+		if err != nil {
+			slog.Warningf("not checking metrics and tag keys: %v", err)
+		} else {
+			s = search.NewSearch(da, true)
+		}
+	}
+	warnings, err := c.Lint(s)
+	if err != nil {
+		return false, err
+	}
+	for _, w := range warnings {
+		fmt.Printf("%s:%s\n", c.Name, w)
+	}
+	return len(warnings) == 0, nil
+}
+
+// simulate prints the status changes and notifications of a simulation of alert
+// from from to to, in time order.
+func simulate(sc conf.SystemConfProvider, c *rule.Conf, alert, from, to string) error {
+	a := c.GetAlert(alert)
+	if a == nil {
+		return fmt.Errorf("alert %s not found", alert)
+	}
+	now := time.Now().UTC()
+	if from == "" {
+		return fmt.Errorf("-simulate requires -from")
+	}
+	start, err := parseSimulateTime(from, now)
+	if err != nil {
+		return err
+	}
+	end := now
+	if to != "" {
+		if end, err = parseSimulateTime(to, now); err != nil {
+			return err
+		}
+	}
+	s := &sched.Schedule{SystemConf: sc}
+	sim, err := s.Simulate(c, a, start, end)
+	if err != nil {
+		return err
+	}
+	type line struct {
+		t    time.Time
+		text string
+	}
+	var lines []line
+	for _, ch := range sim.Changes {
+		lines = append(lines, line{ch.Time, fmt.Sprintf("%s #%d %s -> %s", ch.AlertKey, ch.IncidentId, ch.From, ch.To)})
+	}
+	for _, n := range sim.Notifications {
+		lines = append(lines, line{n.Time, fmt.Sprintf("%s #%d notify %s: %s", n.AlertKey, n.IncidentId, n.Notification, n.Subject)})
+	}
+	for _, e := range sim.Errors {
+		lines = append(lines, line{e.Time, "error: " + e.Error})
+	}
+	sort.SliceStable(lines, func(i, j int) bool {
+		return lines[i].t.Before(lines[j].t)
+	})
+	for _, l := range lines {
+		fmt.Printf("%s %s\n", l.t.Format(time.RFC3339), l.text)
+	}
+	fmt.Printf("%d checks every %s, %d incidents, %d notifications\n", sim.Checks, sim.Interval, len(sim.Incidents), len(sim.Notifications))
+	return nil
+}
+
+// parseSimulateTime parses an RFC 3339 time or an OpenTSDB duration before now.
+func parseSimulateTime(v string, now time.Time) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t, nil
+	}
+	d, err := opentsdb.ParseDuration(v)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("bad time %s: must be an RFC 3339 time or a duration", v)
+	}
+	return now.Add(-time.Duration(d)), nil
+}
+
 func watch(root, pattern string, f func()) {
 	watcher, err := fsnotify.NewWatcher()
 	if err != nil {
@@ -6,6 +6,7 @@ import (
 
 	"bosun.org/cmd/bosun/cache"
 	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/expr"
 	"bosun.org/slog"
 )
 
@@ -16,7 +17,9 @@ func (s *Schedule) Run() error {
 	}
 	s.nc = make(chan interface{}, 1)
 	go s.dispatchNotifications()
+	go s.retryNotifications()
 	type alertCh struct {
+		name   string
 		ch     chan<- *checkContext
 		modulo int
 	}
@@ -28,7 +31,7 @@ func (s *Schedule) Run() error {
 			re = s.SystemConf.GetDefaultRunEvery()
 		}
 		go s.runAlert(a, ch)
-		chs = append(chs, alertCh{ch: ch, modulo: re})
+		chs = append(chs, alertCh{name: a.Name, ch: ch, modulo: re})
 	}
 	i := 0
 	for {
@@ -37,10 +40,14 @@ func (s *Schedule) Run() error {
 			return nil
 		default:
 		}
+		leader := s.IsLeader()
 		ctx := &checkContext{utcNow(), cache.New(0)}
-		s.LastCheck = utcNow()
+		if leader {
+			s.LastCheck = utcNow()
+		}
 		for _, a := range chs {
-			if i%a.modulo != 0 {
+			owned := s.Shards == nil || s.Shards.Claim(a.name)
+			if i%a.modulo != 0 || !leader || !owned {
 				continue
 			}
 			// Put on channel. If that fails, the alert is backed up pretty bad.
@@ -78,6 +85,9 @@ func (s *Schedule) runAlert(a *conf.Alert, ch <-chan *checkContext) {
 
 func (s *Schedule) checkAlert(a *conf.Alert, ctx *checkContext) {
 	rh := s.NewRunHistory(ctx.runTime, ctx.checkCache)
+	if s.SystemConf.GetSnapshotRetention() > 0 {
+		rh.Snapshot = expr.NewSnapshot(a.Name, rh.Start)
+	}
 	// s.CheckAlert will return early if the schedule has been closed
 	cancelled := s.CheckAlert(nil, rh, a)
 	if cancelled {
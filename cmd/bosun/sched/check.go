@@ -29,6 +29,9 @@ func init() {
 	metadata.AddMetricMeta(
 		"bosun.alerts.active_status", metadata.Gauge, metadata.Alert,
 		"The number of open alerts by active status.")
+	metadata.AddMetricMeta(
+		"bosun.alerts.flapping", metadata.Gauge, metadata.Alert,
+		"The number of open alerts that are flapping.")
 	metadata.AddMetricMeta("alerts.acknowledgement_status_by_notification", metadata.Gauge, metadata.Alert,
 		"The number of alerts by acknowledgement status and notification. Does not reflect escalation chains.")
 	metadata.AddMetricMeta("alerts.oldest_unacked_by_notification", metadata.Gauge, metadata.Second,
@@ -52,6 +55,12 @@ type RunHistory struct {
 	Backends *expr.Backends
 	Events   map[models.AlertKey]*models.Event
 	schedule *Schedule
+
+	// Snapshot, if not nil, records or replays the backend responses of the
+	// expressions of the run.
+	Snapshot *expr.Snapshot
+	// snapshotId is the id of the stored Snapshot, 0 until it is stored.
+	snapshotId int64
 }
 
 // AtTime creates a new RunHistory starting at t with the same context and
@@ -74,6 +83,7 @@ func (s *Schedule) NewRunHistory(start time.Time, cache *cache.Cache) *RunHistor
 			InfluxConfig:    s.SystemConf.GetInfluxContext(),
 			LogstashHosts:   s.SystemConf.GetLogstashContext(),
 			ElasticHosts:    s.SystemConf.GetElasticContext(),
+			PromContext:     s.SystemConf.GetPromContext(),
 		},
 	}
 	return r
@@ -81,9 +91,23 @@ func (s *Schedule) NewRunHistory(start time.Time, cache *cache.Cache) *RunHistor
 
 // RunHistory processes an event history and triggers notifications if needed.
 func (s *Schedule) RunHistory(r *RunHistory) {
+	if err := s.verifyLeader(); err != nil {
+		slog.Errorf("not saving the check at %s: %v", r.Start, err)
+		return
+	}
 	checkNotify := false
 	silenced := s.Silenced()
 	for ak, event := range r.Events {
+		// the leadership may end during the run
+		if !s.IsLeader() {
+			slog.Errorf("not saving the rest of the check at %s, no longer the leader", r.Start)
+			break
+		}
+		// nor may the lease of the alert
+		if !s.OwnsAlert(ak.Name()) {
+			slog.Errorf("not saving %s of the check at %s, the alert moved to another worker", ak, r.Start)
+			continue
+		}
 		shouldNotify, err := s.runHistory(r, ak, event, silenced)
 		checkNotify = checkNotify || shouldNotify
 		if err != nil {
@@ -98,6 +122,15 @@ func (s *Schedule) RunHistory(r *RunHistory) {
 	}
 }
 
+// eventNow returns the current time for the processing of event, which is the time of
+// the check in simulations.
+func (s *Schedule) eventNow(event *models.Event) time.Time {
+	if s.simulation {
+		return event.Time
+	}
+	return utcNow()
+}
+
 // RunHistory for a single alert key. Returns true if notifications were altered.
 func (s *Schedule) runHistory(r *RunHistory, ak models.AlertKey, event *models.Event, silenced SilenceTester) (checkNotify bool, err error) {
 	event.Time = r.Start
@@ -107,7 +140,7 @@ func (s *Schedule) runHistory(r *RunHistory, ak models.AlertKey, event *models.E
 	}
 
 	data := s.DataAccess.State()
-	err = data.TouchAlertKey(ak, utcNow())
+	err = data.TouchAlertKey(ak, s.eventNow(event))
 	if err != nil {
 		return
 	}
@@ -122,12 +155,24 @@ func (s *Schedule) runHistory(r *RunHistory, ak models.AlertKey, event *models.E
 	if err != nil {
 		return
 	}
+	flapping := false
+	if a.FlapThreshold > 0 && !event.Unevaluated {
+		last := models.StNormal
+		if incident != nil {
+			last = incident.CurrentStatus
+		}
+		flapping = s.flaps.record(ak, last, event.Status, event.Time, a.FlapWindow) >= a.FlapThreshold
+	}
 
+	statusChanged := false
 	defer func() {
 		// save unless incident is new and closed (log alert)
 		if incident != nil && (incident.Id != 0 || incident.Open) {
 			_, err = data.UpdateIncidentState(incident)
 			err = data.SetRenderedTemplates(incident.Id, rt)
+			if statusChanged {
+				s.saveSnapshot(r, incident.Id)
+			}
 		} else {
 			err = data.SetUnevaluated(ak, event.Unevaluated) // if nothing to save, at least store the unevaluated state
 			if err != nil {
@@ -216,6 +261,9 @@ func (s *Schedule) runHistory(r *RunHistory, ak models.AlertKey, event *models.E
 	newIncident := false
 	if incident == nil {
 		incident = NewIncident(ak)
+		if s.simulation {
+			incident.Start = event.Time
+		}
 		newIncident = true
 		shouldNotify = true
 	}
@@ -236,8 +284,13 @@ func (s *Schedule) runHistory(r *RunHistory, ak models.AlertKey, event *models.E
 	}
 	if event.Status != incident.CurrentStatus {
 		incident.Events = append(incident.Events, *event)
+		statusChanged = true
 	}
 	incident.CurrentStatus = event.Status
+	if flapping != incident.Flapping {
+		slog.Infof("%s flapping: %v", ak, flapping)
+	}
+	incident.Flapping = flapping
 
 	//run a preliminary save on new incidents to get an id
 	if newIncident {
@@ -265,7 +318,7 @@ func (s *Schedule) runHistory(r *RunHistory, ak models.AlertKey, event *models.E
 	notify := func(ns *conf.Notifications) {
 		if a.Log {
 			lastLogTime := s.lastLogTimes[ak]
-			now := utcNow()
+			now := s.eventNow(event)
 			if now.Before(lastLogTime.Add(a.MaxLogFrequency)) {
 				return
 			}
@@ -285,6 +338,10 @@ func (s *Schedule) runHistory(r *RunHistory, ak models.AlertKey, event *models.E
 			return
 		}
 		incident.NeedAck = true
+		if incident.Flapping {
+			// the notifications sent before it started flapping are enough
+			return
+		}
 		switch event.Status {
 		case models.StCritical, models.StUnknown:
 			notify(a.CritNotification)
@@ -317,6 +374,29 @@ func (s *Schedule) runHistory(r *RunHistory, ak models.AlertKey, event *models.E
 	return checkNotify, nil
 }
 
+// saveSnapshot stores the backend responses recorded by the run, once for all the
+// incidents of the run, as the snapshot of an incident.
+func (s *Schedule) saveSnapshot(r *RunHistory, incidentId int64) {
+	if r.Snapshot == nil {
+		return
+	}
+	if r.snapshotId == 0 {
+		snap := r.Snapshot.Recorded()
+		if snap == nil {
+			return
+		}
+		id, err := s.DataAccess.State().AddSnapshot(snap, s.SystemConf.GetSnapshotRetention())
+		if err != nil {
+			slog.Errorf("Error storing snapshot of the check of %s: %s", snap.Alert, err)
+			return
+		}
+		r.snapshotId = id
+	}
+	if err := s.DataAccess.State().SetIncidentSnapshot(incidentId, r.snapshotId); err != nil {
+		slog.Errorf("Error storing snapshot of incident %d: %s", incidentId, err)
+	}
+}
+
 func silencedOrIgnored(a *conf.Alert, event *models.Event, si *models.Silence) bool {
 	if a.IgnoreUnknown && event.Status == models.StUnknown {
 		return true
@@ -416,8 +496,10 @@ func (s *Schedule) CollectStates() {
 	ackByNotificationCounts := make(map[string]map[bool]int64)
 	unAckOldestByNotification := make(map[string]time.Time)
 	activeStatusCounts := make(map[string]map[bool]int64)
+	flappingCounts := make(map[string]int64)
 	// Initalize the Counts
 	for _, alert := range s.RuleConf.GetAlerts() {
+		flappingCounts[alert.Name] = 0
 		severityCounts[alert.Name] = make(map[string]int64)
 		abnormalCounts[alert.Name] = make(map[string]int64)
 		var i models.Status
@@ -438,6 +520,15 @@ func (s *Schedule) CollectStates() {
 		ackByNotificationCounts[notificationName][false] = 0
 		ackByNotificationCounts[notificationName][true] = 0
 	}
+	open, err := s.DataAccess.State().GetAllOpenIncidents()
+	if err != nil {
+		slog.Errorln(err)
+	}
+	for _, incident := range open {
+		if _, ok := flappingCounts[incident.Alert]; ok && incident.Flapping {
+			flappingCounts[incident.Alert]++
+		}
+	}
 	//TODO:
 	//	for _, state := range s.status {
 	//		if !state.Open {
@@ -538,6 +629,10 @@ func (s *Schedule) CollectStates() {
 		if err != nil {
 			slog.Errorln(err)
 		}
+		err = collect.Put("alerts.flapping", ts, flappingCounts[alertName])
+		if err != nil {
+			slog.Errorln(err)
+		}
 	}
 }
 
@@ -554,12 +649,17 @@ var bosunStartupTime = utcNow()
 
 func (s *Schedule) findUnknownAlerts(now time.Time, alert string) []models.AlertKey {
 	keys := []models.AlertKey{}
-	if utcNow().Sub(bosunStartupTime) < s.SystemConf.GetCheckFrequency() {
+	// a simulation has no downtime to wait out
+	if !s.simulation && utcNow().Sub(bosunStartupTime) < s.SystemConf.GetCheckFrequency() {
 		return keys
 	}
 	if !s.AlertSuccessful(alert) {
 		return keys
 	}
+	// the keys of an alert this worker just took over were last touched by the worker before
+	if s.Shards != nil && now.Sub(s.Shards.OwnedSince(alert)) < s.SystemConf.GetCheckFrequency() {
+		return keys
+	}
 	a := s.RuleConf.GetAlert(alert)
 	t := a.Unknown
 	if t == 0 {
@@ -615,7 +715,9 @@ func (s *Schedule) CheckAlert(T miniprofiler.Timer, r *RunHistory, a *conf.Alert
 	}
 	var deps expr.ResultSlice
 	if err == nil {
-		deps = filterDependencyResults(d)
+		deps, err = s.dependsOnResults(a, filterDependencyResults(d))
+	}
+	if err == nil {
 		crits, err, cancelled = s.CheckExpr(T, r, a, a.Crit, models.StCritical, nil)
 		if err == nil && !cancelled {
 			warns, err, cancelled = s.CheckExpr(T, r, a, a.Warn, models.StWarning, crits)
@@ -695,6 +797,9 @@ func (s *Schedule) executeExpr(T miniprofiler.Timer, rh *RunHistory, a *conf.Ale
 		Squelched: s.RuleConf.AlertSquelched(a),
 		History:   s,
 		Annotate:  s.annotate,
+
+		QueryCache: s.QueryCache,
+		Snapshot:   rh.Snapshot,
 	}
 	results, _, err := e.Execute(rh.Backends, providers, T, rh.Start, 0, a.UnjoinedOK)
 	return results, err
@@ -1,6 +1,7 @@
 package sched
 
 import (
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -11,6 +12,7 @@ import (
 
 	"bosun.org/cmd/bosun/conf"
 	"bosun.org/cmd/bosun/conf/rule"
+	"bosun.org/cmd/bosun/expr"
 	"bosun.org/models"
 	"bosun.org/opentsdb"
 )
@@ -127,7 +129,7 @@ func TestCheckSilence(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	_, err = s.AddSilence(utcNow().Add(-time.Hour), utcNow().Add(time.Hour), "a", "", false, true, "", "user", "message")
+	_, err = s.AddSilence(utcNow().Add(-time.Hour), utcNow().Add(time.Hour), "a", "", false, true, "", "user", "message", nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -141,6 +143,117 @@ func TestCheckSilence(t *testing.T) {
 	}
 }
 
+func TestRecurringSilence(t *testing.T) {
+	defer setup()()
+	c, err := rule.NewConf("", conf.EnabledBackends{}, nil, `
+		alert a {
+			warn = 1
+		}
+		alert b {
+			warn = 1
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := initSched(&conf.SystemConf{}, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := utcNow()
+	add := func(alert string, r *models.Recurrence) error {
+		_, err := s.AddSilence(now.Add(-time.Hour), now.Add(time.Hour*24*7), alert, "", false, true, "", "user", "message", r)
+		return err
+	}
+	// a window starts every minute, so a is silenced now
+	if err := add("a", &models.Recurrence{Cron: "* * * * *", Window: "5m"}); err != nil {
+		t.Fatal(err)
+	}
+	// the only window of b is twelve hours away
+	other := now.Add(time.Hour * 12)
+	if err := add("b", &models.Recurrence{Cron: fmt.Sprintf("0 %d * * *", other.Hour()), Window: "1h"}); err != nil {
+		t.Fatal(err)
+	}
+	silenced := s.Silenced()
+	if silenced(models.NewAlertKey("a", nil)) == nil {
+		t.Error("expected a to be silenced")
+	}
+	if silenced(models.NewAlertKey("b", nil)) != nil {
+		t.Error("expected b not to be silenced outside of its window")
+	}
+
+	for _, r := range []*models.Recurrence{
+		{Cron: "61 * * * *", Window: "1h"},
+		{Cron: "* * * * *", Window: ""},
+		{Cron: "* * * * *", Window: "1h", TimeZone: "Nowhere/Special"},
+	} {
+		if err := add("a", r); err == nil {
+			t.Errorf("expected an error for %+v", r)
+		}
+	}
+}
+
+func TestFlapping(t *testing.T) {
+	defer setup()()
+	c, err := rule.NewConf("", conf.EnabledBackends{}, nil, `
+		alert a {
+			warn = 1
+			warnNotification = test
+			template = test
+			flapThreshold = 3
+			flapWindow = 1h
+		}
+		template test {
+			subject = test
+		}
+		notification test {
+			print = true
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, _ := initSched(&conf.SystemConf{}, c)
+	ak := models.NewAlertKey("a", nil)
+	r := &RunHistory{
+		Start: time.Now(),
+		Events: map[models.AlertKey]*models.Event{
+			ak: {Status: models.StWarning},
+		},
+	}
+	run := func(status models.Status, d time.Duration) {
+		r.Start = r.Start.Add(d)
+		r.Events[ak].Status = status
+		s.RunHistory(r)
+	}
+	expect := func(flapping bool, notifications int) {
+		incident, err := s.DataAccess.State().GetLatestIncident(ak)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if incident.Flapping != flapping {
+			t.Fatalf("expected flapping to be %v", flapping)
+		}
+		if n := len(s.pendingNotifications[s.RuleConf.GetNotification("test")]); n != notifications {
+			t.Fatalf("expected %v pending notifications but got %v", notifications, n)
+		}
+		s.pendingNotifications = nil
+	}
+	run(models.StWarning, 0)
+	expect(false, 1)
+	run(models.StNormal, time.Minute)
+	if err := s.ActionByAlertKey("", "", models.ActionClose, nil, ak); err != nil {
+		t.Fatal(err)
+	}
+	// the third state change within the window starts flapping, so the new
+	// incident does not notify
+	run(models.StWarning, time.Minute)
+	expect(true, 0)
+	// once the changes are outside of the window it settles
+	run(models.StWarning, time.Hour)
+	expect(false, 0)
+}
+
 func TestDelayedClose(t *testing.T) {
 	defer setup()()
 	c, err := rule.NewConf("", conf.EnabledBackends{}, nil, `
@@ -589,3 +702,41 @@ Loop:
 		}
 	}
 }
+
+func TestSnapshotPerRun(t *testing.T) {
+	defer setup()()
+	c, err := rule.NewConf("", conf.EnabledBackends{}, nil, `
+		alert a {
+			crit = 1
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, _ := initSched(&conf.SystemConf{SnapshotRetention: 1}, c)
+	x, y := models.NewAlertKey("a", opentsdb.TagSet{"host": "x"}), models.NewAlertKey("a", opentsdb.TagSet{"host": "y"})
+	// a snapshot that has recorded responses
+	snap := &models.Snapshot{Alert: "a", Responses: map[string]map[string]json.RawMessage{"opentsdb": {"q": json.RawMessage(`[]`)}}}
+	r := &RunHistory{
+		Events: map[models.AlertKey]*models.Event{
+			x: {Status: models.StWarning},
+			y: {Status: models.StWarning},
+		},
+		Snapshot: expr.ReplaySnapshot(snap),
+	}
+	s.RunHistory(r)
+	// both incidents of the run have its snapshot, which counts once against the retention
+	for _, ak := range []models.AlertKey{x, y} {
+		incident, err := s.DataAccess.State().GetLatestIncident(ak)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := s.DataAccess.State().GetSnapshot(incident.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got == nil || got.Alert != "a" {
+			t.Errorf("%s: expected the snapshot of the run, got %+v", ak, got)
+		}
+	}
+}
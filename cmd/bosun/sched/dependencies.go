@@ -0,0 +1,134 @@
+package sched
+
+import (
+	"sort"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/expr"
+	"bosun.org/models"
+	"bosun.org/opentsdb"
+)
+
+// downGroups returns the groups of the open incidents of each alert that are critical
+// or unevaluated.
+func (s *Schedule) downGroups() (map[string][]opentsdb.TagSet, error) {
+	open, err := s.DataAccess.State().GetAllOpenIncidents()
+	if err != nil {
+		return nil, err
+	}
+	down := make(map[string][]opentsdb.TagSet)
+	for _, incident := range open {
+		if incident.CurrentStatus == models.StCritical || incident.Unevaluated {
+			down[incident.Alert] = append(down[incident.Alert], incident.AlertKey.Group())
+		}
+	}
+	return down, nil
+}
+
+// suppressedGroups returns the groups of a that are suppressed by the alerts in
+// a.DependsOn. A parent suppresses a group if it is down for it, or if it is
+// suppressed by its own parents, so a is suppressed even if the parent has no
+// incident because it has been unevaluated since it first ran.
+func suppressedGroups(a *conf.Alert, alerts map[string]*conf.Alert, down map[string][]opentsdb.TagSet) []opentsdb.TagSet {
+	var groups []opentsdb.TagSet
+	for _, dep := range a.DependsOn {
+		parent := alerts[dep.Alert]
+		if parent == nil {
+			continue
+		}
+		parentGroups := append([]opentsdb.TagSet{}, down[dep.Alert]...)
+		parentGroups = append(parentGroups, suppressedGroups(parent, alerts, down)...)
+		for _, g := range parentGroups {
+			if group, ok := dep.Group(g); ok {
+				groups = append(groups, group)
+			}
+		}
+	}
+	return groups
+}
+
+// dependsOnResults appends a result to deps for every group of a that is suppressed
+// by an alert in a.DependsOn, so they are treated like the non-zero results of the
+// depends expression.
+func (s *Schedule) dependsOnResults(a *conf.Alert, deps expr.ResultSlice) (expr.ResultSlice, error) {
+	if len(a.DependsOn) == 0 {
+		return deps, nil
+	}
+	down, err := s.downGroups()
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range suppressedGroups(a, s.RuleConf.GetAlerts(), down) {
+		deps = append(deps, &expr.Result{Value: expr.Number(1), Group: group})
+	}
+	return deps, nil
+}
+
+// AlertDependencies is the place of an alert in the graph of dependsOn declarations.
+type AlertDependencies struct {
+	DependsOn  []*DependencyNode `json:",omitempty"`
+	Dependents []string          `json:",omitempty"`
+}
+
+// DependencyNode is a parent alert in a dependency tree.
+type DependencyNode struct {
+	Alert string
+	// Tags maps the tag keys of the dependent alert to those of this alert.
+	Tags map[string]string `json:",omitempty"`
+	// Down is true if this alert has an open incident that is critical or unevaluated
+	// for the group the tree was built for.
+	Down      bool
+	DependsOn []*DependencyNode `json:",omitempty"`
+}
+
+// GetAlertDependencies returns the alerts that the alert key depends on, recursively,
+// and the alerts that depend on its alert.
+func (s *Schedule) GetAlertDependencies(ak models.AlertKey) (*AlertDependencies, error) {
+	down, err := s.downGroups()
+	if err != nil {
+		return nil, err
+	}
+	alerts := s.RuleConf.GetAlerts()
+	var tree func(name string, group opentsdb.TagSet) []*DependencyNode
+	tree = func(name string, group opentsdb.TagSet) []*DependencyNode {
+		a := alerts[name]
+		if a == nil {
+			return nil
+		}
+		var nodes []*DependencyNode
+		for _, dep := range a.DependsOn {
+			parentGroup := make(opentsdb.TagSet)
+			for k, pk := range dep.Tags {
+				if v, ok := group[k]; ok {
+					parentGroup[pk] = v
+				}
+			}
+			n := &DependencyNode{
+				Alert:     dep.Alert,
+				Tags:      dep.Tags,
+				DependsOn: tree(dep.Alert, parentGroup),
+			}
+			for _, g := range down[dep.Alert] {
+				if g.Subset(parentGroup) {
+					n.Down = true
+					break
+				}
+			}
+			nodes = append(nodes, n)
+		}
+		return nodes
+	}
+	deps := &AlertDependencies{
+		DependsOn: tree(ak.Name(), ak.Group()),
+	}
+	for name, a := range alerts {
+		for _, dep := range a.DependsOn {
+			if dep.Alert == ak.Name() {
+				deps.Dependents = append(deps.Dependents, name)
+				break
+			}
+		}
+	}
+	sort.Strings(deps.Dependents)
+	return deps, nil
+}
@@ -304,3 +304,65 @@ alert c {
 		},
 	})
 }
+
+// a.host is critical for ny01, which suppresses b.app on ny01 and through it c.web on
+// ny01, even though b.app never had an incident there. c.web maps its server tag to
+// the host tag of b.app.
+func TestDependency_DependsOn(t *testing.T) {
+	defer setup()()
+	testSched(t, &schedTest{
+		conf: `alert a.host {
+			crit = avg(q("avg:a{host=*}", "5m", "")) > 0
+		}
+		alert b.app {
+			dependsOn = a.host
+			crit = avg(q("avg:b{host=*}", "5m", "")) > 0
+		}
+		alert c.web {
+			dependsOn = b.app{server=host}
+			crit = avg(q("avg:c{server=*}", "5m", "")) > 0
+		}`,
+		queries: map[string]opentsdb.ResponseSet{
+			`q("avg:a{host=*}", ` + window5Min + `)`: {
+				{
+					Metric: "a",
+					Tags:   opentsdb.TagSet{"host": "ny01"},
+					DPS:    map[string]opentsdb.Point{"0": 1},
+				},
+				{
+					Metric: "a",
+					Tags:   opentsdb.TagSet{"host": "ny02"},
+					DPS:    map[string]opentsdb.Point{"0": 0},
+				},
+			},
+			`q("avg:b{host=*}", ` + window5Min + `)`: {
+				{
+					Metric: "b",
+					Tags:   opentsdb.TagSet{"host": "ny01"},
+					DPS:    map[string]opentsdb.Point{"0": 1},
+				},
+				{
+					Metric: "b",
+					Tags:   opentsdb.TagSet{"host": "ny02"},
+					DPS:    map[string]opentsdb.Point{"0": 0},
+				},
+			},
+			`q("avg:c{server=*}", ` + window5Min + `)`: {
+				{
+					Metric: "c",
+					Tags:   opentsdb.TagSet{"server": "ny01"},
+					DPS:    map[string]opentsdb.Point{"0": 1},
+				},
+				{
+					Metric: "c",
+					Tags:   opentsdb.TagSet{"server": "ny02"},
+					DPS:    map[string]opentsdb.Point{"0": 1},
+				},
+			},
+		},
+		state: map[schedState]bool{
+			{"a.host{host=ny01}", "critical"}:  true,
+			{"c.web{server=ny02}", "critical"}: true,
+		},
+	})
+}
@@ -0,0 +1,69 @@
+package sched
+
+import (
+	"sync"
+	"time"
+
+	"bosun.org/models"
+)
+
+// flapSweepEvery is how often the history of alert keys that were not checked within
+// their flap window is dropped.
+const flapSweepEvery = 10 * time.Minute
+
+// flapHistory remembers the recent state changes of alert keys whose alert has a
+// flapThreshold. It is kept in memory, so flap detection starts over on restart.
+type flapHistory struct {
+	sync.Mutex
+	keys  map[models.AlertKey]*flapState
+	swept time.Time
+}
+
+type flapState struct {
+	status  models.Status
+	changes []time.Time
+	seen    time.Time     // of the last check
+	window  time.Duration // of the alert at the last check
+}
+
+// record notes the status of ak at t and returns the number of state changes within
+// window before t. last is the status to compare against when ak has no history yet.
+func (f *flapHistory) record(ak models.AlertKey, last, status models.Status, t time.Time, window time.Duration) int {
+	f.Lock()
+	defer f.Unlock()
+	if f.keys == nil {
+		f.keys = make(map[models.AlertKey]*flapState)
+	}
+	fs := f.keys[ak]
+	if fs == nil {
+		fs = &flapState{status: last}
+		f.keys[ak] = fs
+	}
+	if status != fs.status {
+		fs.changes = append(fs.changes, t)
+		fs.status = status
+	}
+	// drop the changes that are outside of the window
+	i := 0
+	for i < len(fs.changes) && !fs.changes[i].After(t.Add(-window)) {
+		i++
+	}
+	fs.changes = fs.changes[i:]
+	fs.seen, fs.window = t, window
+	if t.Sub(f.swept) >= flapSweepEvery {
+		f.sweep(t)
+	}
+	return len(fs.changes)
+}
+
+// sweep drops the alert keys that were not checked within their window before t, such
+// as those of removed alerts or forgotten keys. They have no changes in the window left,
+// and start over from the status of their incident.
+func (f *flapHistory) sweep(t time.Time) {
+	for ak, fs := range f.keys {
+		if t.Sub(fs.seen) > fs.window {
+			delete(f.keys, ak)
+		}
+	}
+	f.swept = t
+}
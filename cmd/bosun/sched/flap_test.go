@@ -0,0 +1,26 @@
+package sched
+
+import (
+	"testing"
+	"time"
+
+	"bosun.org/models"
+)
+
+func TestFlapHistorySweep(t *testing.T) {
+	var f flapHistory
+	old, current := models.AlertKey("old{}"), models.AlertKey("current{}")
+	start := time.Unix(0, 0)
+	f.record(old, models.StNormal, models.StWarning, start, time.Minute)
+	f.record(current, models.StNormal, models.StWarning, start, time.Hour)
+	// only current is checked after that
+	for t := start; !t.After(start.Add(flapSweepEvery)); t = t.Add(time.Minute) {
+		f.record(current, models.StWarning, models.StWarning, t, time.Hour)
+	}
+	if _, ok := f.keys[old]; ok {
+		t.Error("expected the key that was not checked within its window to be dropped")
+	}
+	if n := f.record(current, models.StWarning, models.StNormal, start.Add(flapSweepEvery), time.Hour); n != 2 {
+		t.Errorf("expected 2 changes of the current key, got %d", n)
+	}
+}
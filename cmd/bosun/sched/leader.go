@@ -0,0 +1,166 @@
+package sched
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"bosun.org/cmd/bosun/database"
+	"bosun.org/collect"
+	"bosun.org/metadata"
+	"bosun.org/opentsdb"
+	"bosun.org/slog"
+	"bosun.org/util"
+)
+
+func init() {
+	metadata.AddMetricMeta("bosun.leader", metadata.Gauge, metadata.Bool,
+		"1 if this bosun is the leader that runs checks and sends notifications, else 0.")
+}
+
+// An Election elects the one of the bosun instances sharing a data store that runs the
+// checks and sends notifications. The leader renews its lock every third of the timeout,
+// and considers itself the leader only until the timeout has passed since it last renewed
+// it, so that it stops before a follower can take over.
+type Election struct {
+	DataAccess database.LeaderDataAccess
+	Id         string // of this instance, hostname:pid by default
+	Timeout    time.Duration
+
+	sync.Mutex
+	token int64     // fencing token of the leadership, 0 while following
+	until time.Time // the leadership must be renewed by
+	stop  chan bool
+}
+
+// LeaderStatus is the state of an election.
+type LeaderStatus struct {
+	Id          string // of this instance
+	LeaderId    string `json:",omitempty"` // empty if there is no leader
+	LeaderToken int64  `json:",omitempty"`
+}
+
+// NewElection returns an election of the instances that share da, which take over from
+// a leader that has not renewed its lock for timeout.
+func NewElection(da database.DataAccess, timeout time.Duration) *Election {
+	e := &Election{
+		DataAccess: da.Leader(),
+		Id:         fmt.Sprintf("%s:%d", util.Hostname, os.Getpid()),
+		Timeout:    timeout,
+		stop:       make(chan bool),
+	}
+	collect.Set("leader", opentsdb.TagSet{}, func() interface{} {
+		if e.IsLeader() {
+			return 1
+		}
+		return 0
+	})
+	return e
+}
+
+// Run takes part in the election until Resign is called.
+func (e *Election) Run() {
+	ticker := time.NewTicker(e.Timeout / 3)
+	defer ticker.Stop()
+	for {
+		e.campaign()
+		select {
+		case <-e.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// campaign acquires or renews the leadership.
+func (e *Election) campaign() {
+	start := time.Now()
+	e.Lock()
+	defer e.Unlock()
+	select {
+	case <-e.stop:
+		return
+	default:
+	}
+	token, err := e.DataAccess.AcquireLeader(e.Id, e.token, e.Timeout)
+	if err != nil {
+		slog.Errorf("leader election: %v", err)
+		// keep leading until the lock expires, the next attempt may succeed
+		token = e.token
+	}
+	switch {
+	case token != 0 && token != e.token:
+		slog.Infof("leader election: %s is the leader with token %d", e.Id, token)
+	case token == 0 && e.token != 0:
+		slog.Warningf("leader election: %s lost the leadership with token %d", e.Id, e.token)
+	}
+	if token != 0 && err == nil {
+		e.until = start.Add(e.Timeout)
+	}
+	e.token = token
+}
+
+// IsLeader reports whether this instance is the leader.
+func (e *Election) IsLeader() bool {
+	e.Lock()
+	defer e.Unlock()
+	return e.token != 0 && time.Now().Before(e.until)
+}
+
+// Verify returns an error unless this instance leads with its token according to the
+// data store, so that a leader that paused after IsLeader does not write state or send
+// notifications after another instance took over.
+func (e *Election) Verify() error {
+	e.Lock()
+	token, until := e.token, e.until
+	e.Unlock()
+	if token == 0 || !time.Now().Before(until) {
+		return fmt.Errorf("leader election: %s is not the leader", e.Id)
+	}
+	id, t, err := e.DataAccess.GetLeader()
+	if err != nil {
+		return err
+	}
+	if id != e.Id || t != token {
+		return fmt.Errorf("leader election: %s lost the leadership with token %d", e.Id, token)
+	}
+	return nil
+}
+
+// Status returns the state of the election.
+func (e *Election) Status() (*LeaderStatus, error) {
+	st := &LeaderStatus{Id: e.Id}
+	var err error
+	st.LeaderId, st.LeaderToken, err = e.DataAccess.GetLeader()
+	return st, err
+}
+
+// Resign stops taking part in the election and releases the leadership, so that a follower
+// can take over without waiting for the timeout.
+func (e *Election) Resign() {
+	close(e.stop)
+	e.Lock()
+	defer e.Unlock()
+	if e.token == 0 {
+		return
+	}
+	if err := e.DataAccess.ReleaseLeader(e.Id, e.token); err != nil {
+		slog.Errorf("leader election: %v", err)
+	}
+	e.token = 0
+}
+
+// IsLeader reports whether the schedule should run checks and send notifications, which
+// is always the case without an election.
+func (s *Schedule) IsLeader() bool {
+	return s.Election == nil || s.Election.IsLeader()
+}
+
+// verifyLeader is Election.Verify, which always succeeds without an election.
+func (s *Schedule) verifyLeader() error {
+	if s.Election == nil {
+		return nil
+	}
+	return s.Election.Verify()
+}
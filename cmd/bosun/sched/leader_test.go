@@ -0,0 +1,44 @@
+package sched
+
+import (
+	"testing"
+	"time"
+
+	"bosun.org/cmd/bosun/database"
+)
+
+func TestElectionVerify(t *testing.T) {
+	da, closeDA, err := database.NewMemoryDataAccess()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeDA()
+	newElection := func(id string) *Election {
+		e := NewElection(da, time.Minute)
+		e.Id = id
+		return e
+	}
+	a, b := newElection("a"), newElection("b")
+	a.campaign()
+	b.campaign()
+	if err := a.Verify(); err != nil {
+		t.Fatalf("a: expected to lead, got %v", err)
+	}
+	if err := b.Verify(); err == nil {
+		t.Fatal("b: expected an error while a leads")
+	}
+	// a pauses while its lock expires, and b takes over
+	if err := da.Leader().ReleaseLeader("a", a.token); err != nil {
+		t.Fatal(err)
+	}
+	b.campaign()
+	if !a.IsLeader() {
+		t.Fatal("a: expected to still consider itself the leader")
+	}
+	if err := a.Verify(); err == nil {
+		t.Error("a: expected an error after b took over")
+	}
+	if err := b.Verify(); err != nil {
+		t.Errorf("b: expected to lead, got %v", err)
+	}
+}
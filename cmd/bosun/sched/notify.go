@@ -26,15 +26,25 @@ func (s *Schedule) dispatchNotifications() {
 		}
 		next = time.After(diff)
 	}
+	// followers look again once a leader that stopped would have been replaced
+	check := func() {
+		if s.IsLeader() {
+			nextAt(s.CheckNotifications())
+		} else {
+			nextAt(utcNow().Add(s.SystemConf.GetLeaderTimeout()))
+		}
+	}
 	nextAt(utcNow())
 	for {
 		select {
 		case <-next:
-			nextAt(s.CheckNotifications())
+			check()
 		case <-s.nc:
-			nextAt(s.CheckNotifications())
+			check()
 		case <-ticker.C:
-			s.sendUnknownNotifications()
+			if s.IsLeader() {
+				s.sendUnknownNotifications()
+			}
 		}
 	}
 
@@ -58,6 +68,10 @@ func (s *Schedule) Notify(st *models.IncidentState, rt *models.RenderedTemplates
 
 // CheckNotifications processes past notification events. It returns the next time a notification is needed.
 func (s *Schedule) CheckNotifications() time.Time {
+	if err := s.verifyLeader(); err != nil {
+		slog.Errorf("not checking notifications: %v", err)
+		return utcNow().Add(s.SystemConf.GetLeaderTimeout())
+	}
 	silenced := s.Silenced()
 	s.Lock("CheckNotifications")
 	defer s.Unlock()
@@ -68,6 +82,18 @@ func (s *Schedule) CheckNotifications() time.Time {
 		return utcNow().Add(time.Minute)
 	}
 	for ak, ns := range notifications {
+		if !s.OwnsAlert(ak.Name()) {
+			continue
+		}
+		if s.Shards != nil {
+			// the notifications of other workers' alerts are left to them, so only
+			// these can be cleared
+			for name := range ns {
+				if err := s.DataAccess.Notifications().ClearNotification(ak, name); err != nil {
+					slog.Error("Error clearing notification", err)
+				}
+			}
+		}
 		if si := silenced(ak); si != nil {
 			slog.Infoln("silencing", ak)
 			continue
@@ -110,16 +136,24 @@ func (s *Schedule) CheckNotifications() time.Time {
 	}
 	s.sendNotifications(silenced)
 	s.pendingNotifications = nil
-	err = s.DataAccess.Notifications().ClearNotificationsBefore(latestTime)
-	if err != nil {
-		slog.Error("Error clearing notifications", err)
-		return utcNow().Add(time.Minute)
+	if s.Shards == nil {
+		err = s.DataAccess.Notifications().ClearNotificationsBefore(latestTime)
+		if err != nil {
+			slog.Error("Error clearing notifications", err)
+			return utcNow().Add(time.Minute)
+		}
 	}
 	timeout, err := s.DataAccess.Notifications().GetNextNotificationTime()
 	if err != nil {
 		slog.Error("Error getting next notification time", err)
 		return utcNow().Add(time.Minute)
 	}
+	// notifications that are due already are those of other workers, which send them
+	// within the shard timeout, or of alerts that move to this worker by then. The checks
+	// of this worker's alerts wake the notifier for notifications of their own.
+	if s.Shards != nil && !timeout.After(utcNow()) {
+		return utcNow().Add(s.Shards.Timeout)
+	}
 	return timeout
 }
 
@@ -132,9 +166,21 @@ func (s *Schedule) sendNotifications(silenced SilenceTester) {
 		slog.Infoln("quiet mode prevented", len(s.pendingNotifications), "notifications")
 		return
 	}
+	if err := s.verifyLeader(); err != nil {
+		slog.Errorf("not sending %d notifications: %v", len(s.pendingNotifications), err)
+		return
+	}
 	for n, states := range s.pendingNotifications {
 		for _, st := range states {
+			if !s.IsLeader() {
+				slog.Errorf("not sending the rest of the notifications, no longer the leader")
+				return
+			}
 			ak := st.AlertKey
+			if !s.OwnsAlert(ak.Name()) {
+				slog.Errorf("not notifying %s, the alert moved to another worker", ak)
+				continue
+			}
 			alert := s.RuleConf.GetAlert(ak.Name())
 			if alert == nil {
 				continue
@@ -169,6 +215,11 @@ func (s *Schedule) sendNotifications(silenced SilenceTester) {
 // to be processed by the schedule's utnotify method. When it is done processing the pendingUnknowns queue
 // it reinitializes the queue.
 func (s *Schedule) sendUnknownNotifications() {
+	if err := s.verifyLeader(); err != nil {
+		slog.Errorf("not sending unknown notifications: %v", err)
+		s.pendingUnknowns = make(map[*conf.Notification][]*models.IncidentState)
+		return
+	}
 	slog.Info("Batching and sending unknown notifications")
 	defer slog.Info("Done sending unknown notifications")
 	for n, states := range s.pendingUnknowns {
@@ -218,8 +269,8 @@ var unknownMultiGroup = ttemplate.Must(ttemplate.New("unknownMultiGroup").Parse(
 	</ul>
 	`))
 
-// notify is a wrapper for the notifications Notify method that sets the EmailSubject and EmailBody for the rendered
-// template. It passes properties from the schedule that the Notification's Notify method requires.
+// notify delivers the rendered templates of an incident through the notification, defaulting the EmailSubject
+// and EmailBody to the subject and body when the alert has no email specific templates.
 func (s *Schedule) notify(st *models.IncidentState, rt *models.RenderedTemplates, n *conf.Notification) {
 	if len(rt.EmailSubject) == 0 {
 		rt.EmailSubject = []byte(st.Subject)
@@ -227,7 +278,14 @@ func (s *Schedule) notify(st *models.IncidentState, rt *models.RenderedTemplates
 	if len(rt.EmailBody) == 0 {
 		rt.EmailBody = []byte(rt.Body)
 	}
-	n.Notify(st.Subject, rt.Body, rt.EmailSubject, rt.EmailBody, s.SystemConf, string(st.AlertKey), rt.Attachments...)
+	s.deliver(n, st.Id, &conf.NotificationPayload{
+		AlertKey:     string(st.AlertKey),
+		Subject:      st.Subject,
+		Body:         rt.Body,
+		EmailSubject: rt.EmailSubject,
+		EmailBody:    rt.EmailBody,
+		Attachments:  rt.Attachments,
+	})
 }
 
 // utnotify is single notification for N unknown groups into a single notification
@@ -250,7 +308,13 @@ func (s *Schedule) utnotify(groups map[string]models.AlertKeys, n *conf.Notifica
 	}); err != nil {
 		slog.Errorln(err)
 	}
-	n.Notify(subject, body.String(), []byte(subject), body.Bytes(), s.SystemConf, "unknown_treshold")
+	s.deliver(n, 0, &conf.NotificationPayload{
+		AlertKey:     "unknown_treshold",
+		Subject:      subject,
+		Body:         body.String(),
+		EmailSubject: []byte(subject),
+		EmailBody:    body.Bytes(),
+	})
 }
 
 var defaultUnknownTemplate = &conf.Template{
@@ -266,7 +330,7 @@ var defaultUnknownTemplate = &conf.Template{
 }
 
 // unotify builds an unknown notification for an alertkey or a group of alert keys. It renders the template
-// and calls the notification's Notify method to trigger the action.
+// and delivers it through the notification.
 func (s *Schedule) unotify(name string, group models.AlertKeys, n *conf.Notification) {
 	subject := new(bytes.Buffer)
 	body := new(bytes.Buffer)
@@ -287,7 +351,13 @@ func (s *Schedule) unotify(name string, group models.AlertKeys, n *conf.Notifica
 			slog.Infoln("unknown template error:", err)
 		}
 	}
-	n.Notify(subject.String(), body.String(), subject.Bytes(), body.Bytes(), s.SystemConf, name)
+	s.deliver(n, 0, &conf.NotificationPayload{
+		AlertKey:     name,
+		Subject:      subject.String(),
+		Body:         body.String(),
+		EmailSubject: subject.Bytes(),
+		EmailBody:    body.Bytes(),
+	})
 }
 
 // QueueNotification persists a notification to the datastore to be sent in the future. This happens when
@@ -345,7 +415,13 @@ func (s *Schedule) ActionNotify(at models.ActionType, user, message string, aks
 			slog.Error("Error rendering action notification body", err)
 		}
 
-		notification.Notify(subject, buf.String(), []byte(subject), buf.Bytes(), s.SystemConf, "actionNotification")
+		s.deliver(notification, 0, &conf.NotificationPayload{
+			AlertKey:     "actionNotification",
+			Subject:      subject,
+			Body:         buf.String(),
+			EmailSubject: []byte(subject),
+			EmailBody:    buf.Bytes(),
+		})
 	}
 	return nil
 }
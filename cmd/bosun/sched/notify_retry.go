@@ -0,0 +1,233 @@
+package sched
+
+import (
+	"time"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/collect"
+	"bosun.org/metadata"
+	"bosun.org/models"
+	"bosun.org/opentsdb"
+	"bosun.org/slog"
+)
+
+func init() {
+	metadata.AddMetricMeta(
+		"bosun.notification.failed", metadata.Counter, metadata.PerSecond,
+		"The number of notification delivery attempts that failed, by notification and transport.")
+	metadata.AddMetricMeta(
+		"bosun.notification.gave_up", metadata.Counter, metadata.PerSecond,
+		"The number of notification deliveries that were abandoned after exhausting all retries.")
+}
+
+// deliver sends the payload through each of the notification's notifiers. Each notifier is
+// run in its own goroutine. Failed deliveries are persisted so that retryNotifications can
+// attempt them again. incidentId is zero if the payload is not about a single incident,
+// otherwise every attempt is recorded against the incident.
+func (s *Schedule) deliver(n *conf.Notification, incidentId int64, p *conf.NotificationPayload) {
+	for _, nt := range n.Notifiers {
+		go func(nt conf.Notifier) {
+			err := nt.Send(p, s.SystemConf)
+			now := utcNow()
+			s.recordAttempt(incidentId, n.Name, nt, 1, now, err)
+			if err == nil {
+				return
+			}
+			f := &models.FailedNotification{
+				IncidentId:   incidentId,
+				AlertKey:     p.AlertKey,
+				Notification: n.Name,
+				Transport:    nt.Type(),
+				Target:       nt.Target(),
+				FirstFailed:  now,
+				Subject:      p.Subject,
+				Body:         p.Body,
+				EmailSubject: p.EmailSubject,
+				EmailBody:    p.EmailBody,
+				Attachments:  p.Attachments,
+			}
+			s.recordFailedAttempt(f, now, err)
+		}(nt)
+	}
+}
+
+// recordAttempt adds a delivery attempt to the notification history of the incident. It does
+// nothing for notifications that are not about a single incident.
+func (s *Schedule) recordAttempt(incidentId int64, notification string, nt conf.Notifier, attempt int, t time.Time, err error) {
+	if incidentId == 0 {
+		return
+	}
+	r := &models.NotificationRecord{
+		Notification: notification,
+		Transport:    nt.Type(),
+		Target:       nt.Target(),
+		Time:         t,
+		Attempt:      attempt,
+		Success:      err == nil,
+	}
+	if err != nil {
+		r.Error = err.Error()
+	}
+	if err := s.DataAccess.State().AddNotificationRecord(incidentId, r); err != nil {
+		slog.Errorln("Error recording notification attempt:", err)
+	}
+}
+
+// recordFailedAttempt updates f with a failed delivery attempt, schedules the next attempt
+// with exponential backoff and persists it. When the retry limit has been reached no further
+// attempt is scheduled, but the failure is kept so it can be inspected through the API.
+func (s *Schedule) recordFailedAttempt(f *models.FailedNotification, now time.Time, err error) {
+	f.Attempts++
+	f.LastError = err.Error()
+	f.LastAttempt = now
+	f.NextAttempt = time.Time{}
+	if retries := f.Attempts - 1; retries < s.SystemConf.GetNotificationRetryLimit() {
+		f.NextAttempt = now.Add(s.SystemConf.GetNotificationRetryDelay() << uint(retries))
+	}
+	tags := opentsdb.TagSet{"notification": f.Notification, "transport": f.Transport}
+	collect.Add("notification.failed", tags, 1)
+	if f.GaveUp() {
+		collect.Add("notification.gave_up", tags, 1)
+		slog.Errorf("%s notification %s to %s failed for alert %s after %d attempts, giving up: %v", f.Transport, f.Notification, f.Target, f.AlertKey, f.Attempts, err)
+	} else {
+		slog.Errorf("%s notification %s to %s failed for alert %s, retrying at %v: %v", f.Transport, f.Notification, f.Target, f.AlertKey, f.NextAttempt, err)
+	}
+	if err := s.DataAccess.Notifications().PutFailedNotification(f); err != nil {
+		slog.Errorln("Error storing failed notification:", err)
+	}
+}
+
+// retryNotifications periodically retries failed notification deliveries that are due, and
+// hourly removes those that are no longer retried once the retention has passed.
+func (s *Schedule) retryNotifications() {
+	interval := s.SystemConf.GetNotificationRetryDelay()
+	if interval < time.Second {
+		interval = time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	prune := time.NewTicker(time.Hour)
+	defer prune.Stop()
+	for {
+		select {
+		case <-s.runnerContext.Done():
+			return
+		case <-ticker.C:
+			if s.IsLeader() {
+				s.retryFailedNotifications()
+			}
+		case <-prune.C:
+			if s.IsLeader() {
+				s.pruneFailedNotifications()
+			}
+		}
+	}
+}
+
+// pruneFailedNotifications removes the failed notifications that are no longer retried and
+// whose last attempt is older than the retention.
+func (s *Schedule) pruneFailedNotifications() {
+	retention := s.SystemConf.GetNotificationRetention()
+	if retention <= 0 {
+		return
+	}
+	fs, err := s.DataAccess.Notifications().GetFailedNotifications()
+	if err != nil {
+		slog.Errorln("Error getting failed notifications:", err)
+		return
+	}
+	before := utcNow().Add(-retention)
+	for _, f := range fs {
+		if !f.GaveUp() || !f.LastAttempt.Before(before) {
+			continue
+		}
+		if err := s.DataAccess.Notifications().DeleteFailedNotification(f.Id); err != nil {
+			slog.Errorln("Error deleting failed notification:", err)
+		}
+	}
+}
+
+// retryFailedNotifications makes one more delivery attempt for each failed notification that
+// is due. Failures are dropped instead of retried when the notification or transport has been
+// removed from the configuration, or when the incident no longer needs to be notified about.
+func (s *Schedule) retryFailedNotifications() {
+	if s.quiet {
+		return
+	}
+	if err := s.verifyLeader(); err != nil {
+		slog.Errorf("not retrying failed notifications: %v", err)
+		return
+	}
+	fs, err := s.DataAccess.Notifications().GetDueFailedNotifications()
+	if err != nil {
+		slog.Errorln("Error getting failed notifications:", err)
+		return
+	}
+	for _, f := range fs {
+		// the worker of the alert retries it
+		if !s.OwnsAlert(models.AlertKey(f.AlertKey).Name()) {
+			continue
+		}
+		nt := s.failedNotifier(f)
+		if nt == nil || !s.needsRetry(f) {
+			slog.Infof("dropping failed %s notification %s for alert %s", f.Transport, f.Notification, f.AlertKey)
+			if err := s.DataAccess.Notifications().DeleteFailedNotification(f.Id); err != nil {
+				slog.Errorln("Error deleting failed notification:", err)
+			}
+			continue
+		}
+		err := nt.Send(&conf.NotificationPayload{
+			AlertKey:     f.AlertKey,
+			Subject:      f.Subject,
+			Body:         f.Body,
+			EmailSubject: f.EmailSubject,
+			EmailBody:    f.EmailBody,
+			Attachments:  f.Attachments,
+		}, s.SystemConf)
+		now := utcNow()
+		s.recordAttempt(f.IncidentId, f.Notification, nt, f.Attempts+1, now, err)
+		if err != nil {
+			s.recordFailedAttempt(f, now, err)
+			continue
+		}
+		slog.Infof("%s notification %s to %s for alert %s delivered after %d failed attempts", f.Transport, f.Notification, f.Target, f.AlertKey, f.Attempts)
+		if err := s.DataAccess.Notifications().DeleteFailedNotification(f.Id); err != nil {
+			slog.Errorln("Error deleting failed notification:", err)
+		}
+	}
+}
+
+// failedNotifier returns the notifier of the current rule configuration that the failed
+// notification was sent with, or nil if it no longer exists.
+func (s *Schedule) failedNotifier(f *models.FailedNotification) conf.Notifier {
+	n := s.RuleConf.GetNotification(f.Notification)
+	if n == nil {
+		return nil
+	}
+	for _, nt := range n.Notifiers {
+		if nt.Type() == f.Transport {
+			return nt
+		}
+	}
+	return nil
+}
+
+// needsRetry returns false if the incident the failed notification is about has been
+// closed or acknowledged since the notification was sent.
+func (s *Schedule) needsRetry(f *models.FailedNotification) bool {
+	if f.IncidentId == 0 {
+		return true
+	}
+	st, err := s.DataAccess.State().GetIncidentState(f.IncidentId)
+	if err != nil {
+		slog.Errorln("Error getting incident for failed notification:", err)
+		return true
+	}
+	if st == nil {
+		return false
+	}
+	if alert := s.RuleConf.GetAlert(st.AlertKey.Name()); alert != nil && alert.Log {
+		return true
+	}
+	return st.Open && st.NeedAck
+}
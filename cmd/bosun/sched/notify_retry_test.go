@@ -0,0 +1,102 @@
+package sched
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/conf/rule"
+	"bosun.org/models"
+)
+
+func TestNotificationRetry(t *testing.T) {
+	defer setup()()
+	c, err := rule.NewConf("", conf.EnabledBackends{}, nil, `
+		notification test {
+			print = true
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, _ := initSched(&conf.SystemConf{
+		NotificationRetryLimit: 2,
+		NotificationRetryDelay: conf.Duration{Duration: time.Minute},
+	}, c)
+
+	now := utcNow().Truncate(time.Second)
+	f := &models.FailedNotification{
+		AlertKey:     "a{}",
+		Notification: "test",
+		Transport:    "print",
+		FirstFailed:  now,
+		Subject:      "subject",
+	}
+	// backoff doubles until the retry limit is reached
+	for i, expect := range []time.Duration{time.Minute, 2 * time.Minute, 0} {
+		s.recordFailedAttempt(f, now, fmt.Errorf("attempt %d", i))
+		if f.Attempts != i+1 {
+			t.Fatalf("expected %d attempts, got %d", i+1, f.Attempts)
+		}
+		if expect == 0 {
+			if !f.GaveUp() {
+				t.Fatalf("expected to give up after %d attempts, next attempt at %v", f.Attempts, f.NextAttempt)
+			}
+		} else if got := f.NextAttempt.Sub(now); got != expect {
+			t.Fatalf("attempt %d: expected retry after %v, got %v", f.Attempts, expect, got)
+		}
+	}
+
+	// a due retry that succeeds is removed
+	f.NextAttempt = now.Add(-time.Second)
+	if err := s.DataAccess.Notifications().PutFailedNotification(f); err != nil {
+		t.Fatal(err)
+	}
+	// a retry for a notification that no longer exists is dropped
+	gone := &models.FailedNotification{
+		Notification: "gone",
+		Transport:    "post",
+		NextAttempt:  now.Add(-time.Second),
+	}
+	if err := s.DataAccess.Notifications().PutFailedNotification(gone); err != nil {
+		t.Fatal(err)
+	}
+	s.retryFailedNotifications()
+	fs, err := s.DataAccess.Notifications().GetFailedNotifications()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fs) != 0 {
+		t.Fatalf("expected no failed notifications, got %d", len(fs))
+	}
+}
+
+func TestPruneFailedNotifications(t *testing.T) {
+	defer setup()()
+	c, err := rule.NewConf("", conf.EnabledBackends{}, nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, _ := initSched(&conf.SystemConf{
+		NotificationRetention: conf.Duration{Duration: time.Hour},
+	}, c)
+	now := utcNow()
+	for _, f := range []*models.FailedNotification{
+		{Notification: "old", LastAttempt: now.Add(-2 * time.Hour)},
+		{Notification: "recent", LastAttempt: now.Add(-time.Minute)},
+		{Notification: "retried", LastAttempt: now.Add(-2 * time.Hour), NextAttempt: now.Add(time.Hour)},
+	} {
+		if err := s.DataAccess.Notifications().PutFailedNotification(f); err != nil {
+			t.Fatal(err)
+		}
+	}
+	s.pruneFailedNotifications()
+	fs, err := s.DataAccess.Notifications().GetFailedNotifications()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fs) != 2 || fs[0].Notification != "recent" || fs[1].Notification != "retried" {
+		t.Fatalf("expected the recent and the retried failed notifications, got %v", fs)
+	}
+}
@@ -43,10 +43,23 @@ type Schedule struct {
 
 	Search *search.Search
 
+	// QueryCache caches query results across check runs and reloads.
+	QueryCache *cache.QueryCache
+
+	// Election is the leader election with other instances, nil if there are none. Only the
+	// leader runs checks and sends notifications.
+	Election *Election
+
+	// Shards shares the alerts with other workers, nil if there are none. Only the alerts
+	// this worker owns are checked and notified about.
+	Shards *Shards
+
 	annotate backend.Backend
 
 	skipLast bool
 	quiet    bool
+	// simulation is set for the throw-away schedules of Simulate.
+	simulation bool
 
 	//channel signals an alert has added notifications, and notifications should be processed.
 	nc chan interface{}
@@ -57,6 +70,7 @@ type Schedule struct {
 	pendingUnknowns map[*conf.Notification][]*models.IncidentState
 
 	lastLogTimes map[models.AlertKey]time.Time
+	flaps        flapHistory
 	LastCheck    time.Time
 
 	ctx *checkContext
@@ -97,6 +111,9 @@ func (s *Schedule) Init(systemConf conf.SystemConfProvider, ruleConf conf.RuleCo
 	if s.Search == nil {
 		s.Search = search.NewSearch(s.DataAccess, skipLast)
 	}
+	if s.QueryCache == nil {
+		s.QueryCache = cache.NewQueryCache(systemConf.GetQueryCacheSize(), systemConf.GetQueryCacheRefetch())
+	}
 	return nil
 }
 
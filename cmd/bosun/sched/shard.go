@@ -0,0 +1,294 @@
+package sched
+
+import (
+	"fmt"
+	"hash/fnv"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"bosun.org/cmd/bosun/database"
+	"bosun.org/collect"
+	"bosun.org/metadata"
+	"bosun.org/opentsdb"
+	"bosun.org/slog"
+	"bosun.org/util"
+)
+
+func init() {
+	metadata.AddMetricMeta("bosun.shard.workers", metadata.Gauge, metadata.Count,
+		"The number of bosun workers that share the alerts.")
+	metadata.AddMetricMeta("bosun.shard.alerts", metadata.Gauge, metadata.Count,
+		"The number of alerts this bosun worker runs the checks of.")
+}
+
+// shardReplicas is the number of points of each worker on the hash ring. More points
+// spread the alerts more evenly between the workers.
+const shardReplicas = 64
+
+// A hashRing assigns names to workers by consistent hashing, so that adding or removing
+// a worker moves only the names it gains or loses.
+type hashRing struct {
+	points  []uint32
+	workers map[uint32]string
+}
+
+func shardHash(s string) uint32 {
+	h := fnv.New32a()
+	h.Write([]byte(s))
+	return h.Sum32()
+}
+
+func newHashRing(workers []string) *hashRing {
+	r := &hashRing{workers: make(map[uint32]string, len(workers)*shardReplicas)}
+	for _, w := range workers {
+		for i := 0; i < shardReplicas; i++ {
+			p := shardHash(fmt.Sprintf("%s-%d", w, i))
+			// on a collision the lowest id wins, so that all workers agree
+			o, ok := r.workers[p]
+			if !ok {
+				r.points = append(r.points, p)
+			} else if o < w {
+				continue
+			}
+			r.workers[p] = w
+		}
+	}
+	sort.Slice(r.points, func(i, j int) bool { return r.points[i] < r.points[j] })
+	return r
+}
+
+// get returns the worker of name, or an empty string if the ring has no workers.
+func (r *hashRing) get(name string) string {
+	if len(r.points) == 0 {
+		return ""
+	}
+	h := shardHash(name)
+	i := sort.Search(len(r.points), func(i int) bool { return r.points[i] >= h })
+	if i == len(r.points) {
+		i = 0
+	}
+	return r.workers[r.points[i]]
+}
+
+// Shards shares the alerts between the bosun workers that share a data store. Each
+// worker renews its membership every third of the timeout, and runs the alerts the
+// hash ring of the current members assigns to it. A worker considers itself a member
+// only until the timeout has passed since it last renewed, so that it stops before
+// the others take over its alerts.
+//
+// As the workers rebuild their rings at different times, a worker also has to hold the
+// lease of an alert in the data store to own it. It renews the leases of its alerts with
+// its membership and gives up those the ring assigns to another worker, which gets them
+// only then, or once they expired.
+type Shards struct {
+	DataAccess database.ShardDataAccess
+	Id         string // of this worker, hostname:pid by default
+	Timeout    time.Duration
+
+	sync.Mutex
+	workers []string
+	ring    *hashRing
+	until   time.Time            // the membership must be renewed by
+	leases  map[string]time.Time // alerts leased by this worker by when to renew the lease
+	owned   map[string]time.Time // alerts of this worker by when it got them
+	stop    chan bool
+}
+
+// ShardStatus is the assignment of alerts to workers.
+type ShardStatus struct {
+	Id      string            // of this worker
+	Workers []string          // ids of all workers
+	Alerts  map[string]string // worker by alert name
+}
+
+// NewShards returns the sharding of alerts between the workers that share da, which
+// take over the alerts of a worker that has not renewed its membership for timeout.
+func NewShards(da database.DataAccess, timeout time.Duration) *Shards {
+	s := &Shards{
+		DataAccess: da.Shards(),
+		Id:         fmt.Sprintf("%s:%d", util.Hostname, os.Getpid()),
+		Timeout:    timeout,
+		ring:       newHashRing(nil),
+		leases:     make(map[string]time.Time),
+		owned:      make(map[string]time.Time),
+		stop:       make(chan bool),
+	}
+	collect.Set("shard.workers", opentsdb.TagSet{}, func() interface{} {
+		s.Lock()
+		defer s.Unlock()
+		return len(s.workers)
+	})
+	collect.Set("shard.alerts", opentsdb.TagSet{}, func() interface{} {
+		s.Lock()
+		defer s.Unlock()
+		return len(s.owned)
+	})
+	return s
+}
+
+// Run takes part in the sharding until Leave is called.
+func (s *Shards) Run() {
+	ticker := time.NewTicker(s.Timeout / 3)
+	defer ticker.Stop()
+	for {
+		s.renew()
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// renew renews the membership of this worker, rebuilds the ring from the current
+// members, and renews or gives up the leases of the alerts.
+func (s *Shards) renew() {
+	start := time.Now()
+	s.Lock()
+	defer s.Unlock()
+	select {
+	case <-s.stop:
+		return
+	default:
+	}
+	err := s.DataAccess.PutShardWorker(s.Id, s.Timeout)
+	var workers []string
+	if err == nil {
+		workers, err = s.DataAccess.GetShardWorkers()
+	}
+	if err != nil {
+		// keep the alerts until the membership expires, the next attempt may succeed
+		slog.Errorf("sharding: %v", err)
+		return
+	}
+	s.until = start.Add(s.Timeout)
+	if !equalStrings(workers, s.workers) {
+		slog.Infof("sharding: %s shares the alerts with workers %v", s.Id, workers)
+		s.workers = workers
+		s.ring = newHashRing(workers)
+	}
+	for alert := range s.leases {
+		if s.ring.get(alert) != s.Id {
+			s.release(alert)
+			continue
+		}
+		s.acquire(alert)
+	}
+}
+
+// acquire acquires or renews the lease of the alert, and returns whether this worker
+// holds it.
+func (s *Shards) acquire(alert string) bool {
+	start := time.Now()
+	ok, err := s.DataAccess.AcquireAlertLease(alert, s.Id, s.Timeout)
+	if err != nil {
+		// keep the lease until it expires, the next attempt may succeed
+		slog.Errorf("sharding: %v", err)
+		return start.Before(s.leases[alert])
+	}
+	if !ok {
+		delete(s.leases, alert)
+		return false
+	}
+	s.leases[alert] = start.Add(s.Timeout)
+	return true
+}
+
+// release gives up the lease of the alert.
+func (s *Shards) release(alert string) {
+	delete(s.leases, alert)
+	delete(s.owned, alert)
+	if err := s.DataAccess.ReleaseAlertLease(alert, s.Id); err != nil {
+		slog.Errorf("sharding: %v", err)
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Owns reports whether this worker runs the checks of the alert and sends its
+// notifications.
+func (s *Shards) Owns(alert string) bool {
+	s.Lock()
+	defer s.Unlock()
+	return s.owns(alert)
+}
+
+// owns acquires the lease of an alert of this worker's ring if it does not hold it yet.
+func (s *Shards) owns(alert string) bool {
+	now := time.Now()
+	if !now.Before(s.until) || s.ring.get(alert) != s.Id {
+		return false
+	}
+	return now.Before(s.leases[alert]) || s.acquire(alert)
+}
+
+// Claim is Owns for the schedule's run loop, which also records when this worker got
+// the alert.
+func (s *Shards) Claim(alert string) bool {
+	s.Lock()
+	defer s.Unlock()
+	if !s.owns(alert) {
+		delete(s.owned, alert)
+		return false
+	}
+	if _, ok := s.owned[alert]; !ok {
+		s.owned[alert] = utcNow()
+	}
+	return true
+}
+
+// OwnedSince returns when this worker got the alert by Claim, or the zero time if it
+// does not have it.
+func (s *Shards) OwnedSince(alert string) time.Time {
+	s.Lock()
+	defer s.Unlock()
+	return s.owned[alert]
+}
+
+// Status returns the worker of each of the alerts.
+func (s *Shards) Status(alerts []string) *ShardStatus {
+	s.Lock()
+	defer s.Unlock()
+	st := &ShardStatus{
+		Id:      s.Id,
+		Workers: s.workers,
+		Alerts:  make(map[string]string, len(alerts)),
+	}
+	for _, a := range alerts {
+		st.Alerts[a] = s.ring.get(a)
+	}
+	return st
+}
+
+// Leave stops taking part in the sharding and gives up the membership, so that the
+// others take over the alerts without waiting for the timeout.
+func (s *Shards) Leave() {
+	close(s.stop)
+	s.Lock()
+	defer s.Unlock()
+	for alert := range s.leases {
+		s.release(alert)
+	}
+	if err := s.DataAccess.DeleteShardWorker(s.Id); err != nil {
+		slog.Errorf("sharding: %v", err)
+	}
+	s.until = time.Time{}
+}
+
+// OwnsAlert reports whether the schedule should run the checks of the alert and send its
+// notifications, which is always the case without sharding.
+func (s *Schedule) OwnsAlert(name string) bool {
+	return s.Shards == nil || s.Shards.Owns(name)
+}
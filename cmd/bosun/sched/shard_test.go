@@ -0,0 +1,137 @@
+package sched
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"bosun.org/cmd/bosun/database"
+)
+
+func TestHashRing(t *testing.T) {
+	var alerts []string
+	for i := 0; i < 300; i++ {
+		alerts = append(alerts, fmt.Sprintf("alert%d", i))
+	}
+	assign := func(workers ...string) map[string]string {
+		r := newHashRing(workers)
+		m := make(map[string]string)
+		for _, a := range alerts {
+			m[a] = r.get(a)
+		}
+		return m
+	}
+	abc := assign("a", "b", "c")
+	counts := make(map[string]int)
+	for _, w := range abc {
+		counts[w]++
+	}
+	for _, w := range []string{"a", "b", "c"} {
+		if counts[w] < 50 {
+			t.Errorf("worker %s got only %d of %d alerts", w, counts[w], len(alerts))
+		}
+	}
+	// removing a worker only moves its alerts
+	ac := assign("c", "a")
+	for _, a := range alerts {
+		if abc[a] != "b" && ac[a] != abc[a] {
+			t.Errorf("%s moved from %s to %s", a, abc[a], ac[a])
+		}
+		if ac[a] == "b" {
+			t.Errorf("%s still on removed worker b", a)
+		}
+	}
+	// adding a worker only moves alerts to it
+	abcd := assign("a", "b", "c", "d")
+	for _, a := range alerts {
+		if abcd[a] != "d" && abcd[a] != abc[a] {
+			t.Errorf("%s moved from %s to %s", a, abc[a], abcd[a])
+		}
+	}
+	if w := newHashRing(nil).get("alert0"); w != "" {
+		t.Errorf("empty ring returned worker %q", w)
+	}
+}
+
+func TestShards(t *testing.T) {
+	da, closeDA, err := database.NewMemoryDataAccess()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeDA()
+	newShards := func(id string) *Shards {
+		s := NewShards(da, time.Minute)
+		s.Id = id
+		return s
+	}
+	a, b := newShards("a"), newShards("b")
+	alerts := []string{"w", "x", "y", "z", "alert", "other"}
+	for _, name := range alerts {
+		if a.Owns(name) {
+			t.Fatalf("a owns %s before joining", name)
+		}
+	}
+	a.renew()
+	b.renew()
+	a.renew()
+	owners := a.Status(alerts).Alerts
+	for _, name := range alerts {
+		if a.Owns(name) == b.Owns(name) {
+			t.Errorf("%s: expected exactly one owner, a: %v, b: %v", name, a.Owns(name), b.Owns(name))
+		}
+		if a.Owns(name) != (owners[name] == "a") {
+			t.Errorf("%s: status says %s", name, owners[name])
+		}
+	}
+	b.Leave()
+	a.renew()
+	for _, name := range alerts {
+		if !a.Owns(name) {
+			t.Errorf("a does not own %s after b left", name)
+		}
+		if b.Owns(name) {
+			t.Errorf("b owns %s after leaving", name)
+		}
+	}
+	if since := a.OwnedSince("x"); !since.IsZero() {
+		t.Errorf("x was claimed at %v without Claim", since)
+	}
+	if !a.Claim("x") || a.OwnedSince("x").IsZero() {
+		t.Error("a did not claim x")
+	}
+}
+
+func TestShardLeases(t *testing.T) {
+	da, closeDA, err := database.NewMemoryDataAccess()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeDA()
+	newShards := func(id string) *Shards {
+		s := NewShards(da, time.Minute)
+		s.Id = id
+		return s
+	}
+	a, b := newShards("a"), newShards("b")
+	alerts := []string{"w", "x", "y", "z", "alert", "other"}
+	a.renew()
+	for _, name := range alerts {
+		if !a.Claim(name) {
+			t.Fatalf("a does not own %s alone", name)
+		}
+	}
+	// b joins, but a has yet to see it
+	b.renew()
+	for _, name := range alerts {
+		if b.Owns(name) {
+			t.Errorf("b owns %s while a holds its lease", name)
+		}
+	}
+	// a gives up the leases of the alerts that moved to b
+	a.renew()
+	for _, name := range alerts {
+		if a.Owns(name) == b.Owns(name) {
+			t.Errorf("%s: expected exactly one owner, a: %v, b: %v", name, a.Owns(name), b.Owns(name))
+		}
+	}
+}
@@ -20,14 +20,22 @@ func (s *Schedule) Silenced() SilenceTester {
 		slog.Error("Error fetching silences.", err)
 		return nil
 	}
+	// only keep the silences that are active now, with the end of their current window
+	active := make([]*models.Silence, 0, len(silences))
+	ends := make(map[*models.Silence]time.Time, len(silences))
+	for _, si := range silences {
+		if !si.ActiveAt(now) {
+			continue
+		}
+		_, end, _ := si.Window(now)
+		active = append(active, si)
+		ends[si] = end
+	}
 	return func(ak models.AlertKey) *models.Silence {
 		var lastEnding *models.Silence
-		for _, si := range silences {
-			if !si.ActiveAt(now) {
-				continue
-			}
-			if si.Silenced(now, ak.Name(), ak.Group()) {
-				if lastEnding == nil || lastEnding.End.Before(si.End) {
+		for _, si := range active {
+			if si.Matches(ak.Name(), ak.Group()) {
+				if lastEnding == nil || ends[lastEnding].Before(ends[si]) {
 					lastEnding = si
 				}
 			}
@@ -36,7 +44,9 @@ func (s *Schedule) Silenced() SilenceTester {
 	}
 }
 
-func (s *Schedule) AddSilence(start, end time.Time, alert, tagList string, forget, confirm bool, edit, user, message string) (map[models.AlertKey]bool, error) {
+// AddSilence adds a silence from start to end. If recurrence is not nil the silence is
+// only active during its windows.
+func (s *Schedule) AddSilence(start, end time.Time, alert, tagList string, forget, confirm bool, edit, user, message string, recurrence *models.Recurrence) (map[models.AlertKey]bool, error) {
 	if start.IsZero() || end.IsZero() {
 		return nil, fmt.Errorf("both start and end must be specified")
 	}
@@ -49,23 +59,34 @@ func (s *Schedule) AddSilence(start, end time.Time, alert, tagList string, forge
 	if alert == "" && tagList == "" {
 		return nil, fmt.Errorf("must specify either alert or tags")
 	}
+	if err := models.ValidateAlertPattern(alert); err != nil {
+		return nil, err
+	}
+	if recurrence != nil {
+		if err := recurrence.Validate(); err != nil {
+			return nil, err
+		}
+	}
 	si := &models.Silence{
-		Start:   start,
-		End:     end,
-		Alert:   alert,
-		Tags:    make(opentsdb.TagSet),
-		Forget:  forget,
-		User:    user,
-		Message: message,
+		Start:      start,
+		End:        end,
+		Alert:      alert,
+		Tags:       make(opentsdb.TagSet),
+		Forget:     forget,
+		User:       user,
+		Message:    message,
+		Recurrence: recurrence,
 	}
 	if tagList != "" {
-		tags, err := opentsdb.ParseTags(tagList)
-		if err != nil && tags == nil {
+		tags, filters, err := models.ParseTagFilters(tagList)
+		if err != nil {
 			return nil, err
 		}
 		si.Tags = tags
-		si.TagString = tags.Tags()
+		si.Filters = filters
+		si.TagString = models.TagFilterString(tags, filters)
 	}
+	si.Compile()
 	if confirm {
 		if edit != "" {
 			if err := s.DataAccess.Silence().DeleteSilence(edit); err != nil {
@@ -0,0 +1,82 @@
+package sched
+
+import (
+	"testing"
+	"time"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/conf/rule"
+	"bosun.org/models"
+	"bosun.org/opentsdb"
+)
+
+func TestSilenceMatchers(t *testing.T) {
+	c, err := rule.NewConf("", conf.EnabledBackends{}, nil, ``)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		alert, tags string
+		silenced    map[string]bool
+	}{
+		{"disk.*", "dc=ny,host!=db*", map[string]bool{
+			"disk.free{dc=ny,host=web01}": true,
+			"disk.free{dc=ny,host=db01}":  false,
+			"disk.free{dc=ny}":            true,
+			"disk.used{dc=la,host=web01}": false,
+			"cpu{dc=ny,host=web01}":       false,
+		}},
+		{"~(disk|cpu)\\..*", "host=~web0[1-3]", map[string]bool{
+			"disk.free{host=web01}": true,
+			"cpu.user{host=web03}":  true,
+			"cpu.user{host=web04}":  false,
+			"cpu.user{host=xweb01}": false,
+			"mem{host=web01}":       false,
+		}},
+		{"", "host!~db[0-9]{1,2},dc=ny|la", map[string]bool{
+			"a{dc=ny,host=web01}": true,
+			"a{dc=la,host=db1}":   false,
+			"a{dc=la,host=db100}": true,
+			"a{dc=sf,host=web01}": false,
+		}},
+	}
+	for _, test := range tests {
+		func() {
+			defer setup()()
+			s, err := initSched(&conf.SystemConf{}, c)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := s.AddSilence(utcNow().Add(-time.Hour), utcNow().Add(time.Hour), test.alert, test.tags, false, true, "", "user", "", nil); err != nil {
+				t.Fatalf("%s %s: %v", test.alert, test.tags, err)
+			}
+			silenced := s.Silenced()
+			for ak, expected := range test.silenced {
+				if got := silenced(models.AlertKey(ak)) != nil; got != expected {
+					t.Errorf("%s %s: expected silenced %v for %s, got %v", test.alert, test.tags, expected, ak, got)
+				}
+			}
+		}()
+	}
+}
+
+func TestParseTagFilters(t *testing.T) {
+	tags, filters, err := models.ParseTagFilters("host!~db[0-9]{1,2}, dc=ny|la,cluster=~a=b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !tags.Equal(opentsdb.TagSet{"dc": "ny|la"}) {
+		t.Errorf("unexpected tags %v", tags)
+	}
+	if s := models.TagFilterString(tags, filters); s != "dc=ny|la,cluster=~a=b,host!~db[0-9]{1,2}" {
+		t.Errorf("unexpected tag string %s", s)
+	}
+	for _, bad := range []string{"host", "host=", "host=~(", "a=b,a=c"} {
+		if _, _, err := models.ParseTagFilters(bad); err == nil {
+			t.Errorf("%s: expected an error", bad)
+		}
+	}
+	if err := models.ValidateAlertPattern("~("); err == nil {
+		t.Error("expected an error for a bad alert regexp")
+	}
+}
@@ -0,0 +1,261 @@
+package sched
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"bosun.org/cmd/bosun/cache"
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/database"
+	"bosun.org/models"
+)
+
+// MaxSimulatedChecks is the maximum number of checks of a simulation.
+const MaxSimulatedChecks = 10000
+
+// Simulation is what would have happened had an alert been checked at its check
+// frequency over a past time range, starting without any incidents. Nobody acts
+// on the incidents during a simulation, so they stay open and their notification
+// chains continue.
+type Simulation struct {
+	Alert    string
+	From     time.Time
+	To       time.Time
+	Interval string // between checks
+	Checks   int
+
+	Changes       []*SimulatedChange
+	Notifications []*SimulatedNotification
+	Incidents     []*models.IncidentState
+	Errors        []*SimulatedError `json:",omitempty"`
+}
+
+// SimulatedChange is a status change of an alert key during a simulation.
+type SimulatedChange struct {
+	Time       time.Time
+	AlertKey   models.AlertKey
+	IncidentId int64
+	From       models.Status
+	To         models.Status
+}
+
+// SimulatedNotification is a notification that would have been sent during a
+// simulation.
+type SimulatedNotification struct {
+	Time         time.Time
+	AlertKey     models.AlertKey
+	IncidentId   int64
+	Notification string
+	Status       models.Status
+	Subject      string
+}
+
+// SimulatedError is a check of a simulation that failed.
+type SimulatedError struct {
+	Time  time.Time
+	Error string
+}
+
+// Simulate checks alert a of ruleConf from from to to at the check frequency of the
+// alert, as the schedule would have, against a throw-away embedded ledis.
+// Queries go to the backends of the system configuration of s. Silences and the
+// state of other alerts, such as those a depends on, are not taken into account.
+func (s *Schedule) Simulate(ruleConf conf.RuleConfProvider, a *conf.Alert, from, to time.Time) (*Simulation, error) {
+	runEvery := s.SystemConf.GetDefaultRunEvery()
+	if a.RunEvery != 0 {
+		runEvery = a.RunEvery
+	}
+	interval := s.SystemConf.GetCheckFrequency() * time.Duration(runEvery)
+	if interval <= 0 {
+		return nil, fmt.Errorf("check frequency of %s must be positive, is %v", a.Name, interval)
+	}
+	if !from.Before(to) {
+		return nil, fmt.Errorf("from must be before to")
+	}
+	if n := int(to.Sub(from)/interval) + 1; n > MaxSimulatedChecks {
+		return nil, fmt.Errorf("simulation of %s would take %d checks, more than the maximum of %d", a.Name, n, MaxSimulatedChecks)
+	}
+	da, closeData, err := database.StartTempLedis()
+	if err != nil {
+		return nil, err
+	}
+	defer closeData()
+	sim := &Schedule{
+		Search:     s.Search,
+		QueryCache: s.QueryCache,
+		simulation: true,
+	}
+	if err := sim.Init(s.SystemConf, ruleConf, da, s.annotate, true, true); err != nil {
+		return nil, err
+	}
+	res := &Simulation{
+		Alert:    a.Name,
+		From:     from.UTC(),
+		To:       to.UTC(),
+		Interval: interval.String(),
+	}
+	// chained notifications by alert key and when they are due
+	chains := make(map[models.AlertKey]map[*conf.Notification]time.Time)
+	for t := res.From; !t.After(res.To); t = t.Add(interval) {
+		if err := sim.sendChained(res, chains, t, interval); err != nil {
+			return nil, err
+		}
+		rh := sim.NewRunHistory(t, cache.New(0))
+		if sim.CheckAlert(nil, rh, a) {
+			return nil, fmt.Errorf("simulation of %s cancelled", a.Name)
+		}
+		res.Checks++
+		if !sim.AlertSuccessful(a.Name) {
+			res.Errors = append(res.Errors, &SimulatedError{Time: t, Error: sim.lastError(a.Name)})
+		}
+		sim.RunHistory(rh)
+		for ak := range rh.Events {
+			inc, err := da.State().GetLatestIncident(ak)
+			if err != nil {
+				return nil, err
+			}
+			if inc == nil || len(inc.Events) == 0 || !inc.Events[len(inc.Events)-1].Time.Equal(t) {
+				continue
+			}
+			c := &SimulatedChange{
+				Time:       t,
+				AlertKey:   ak,
+				IncidentId: inc.Id,
+				From:       models.StNormal,
+				To:         inc.CurrentStatus,
+			}
+			if n := len(inc.Events); n > 1 {
+				c.From = inc.Events[n-2].Status
+			}
+			res.Changes = append(res.Changes, c)
+		}
+		sim.collectNotifications(res, chains, t)
+	}
+	aks, err := da.State().GetAllAlertKeys()
+	if err != nil {
+		return nil, err
+	}
+	for _, ak := range aks {
+		incs, err := da.State().GetAllIncidentsByAlertKey(ak)
+		if err != nil {
+			return nil, err
+		}
+		res.Incidents = append(res.Incidents, incs...)
+	}
+	sort.Slice(res.Incidents, func(i, j int) bool {
+		return res.Incidents[i].Id < res.Incidents[j].Id
+	})
+	sort.SliceStable(res.Changes, func(i, j int) bool {
+		ci, cj := res.Changes[i], res.Changes[j]
+		if !ci.Time.Equal(cj.Time) {
+			return ci.Time.Before(cj.Time)
+		}
+		return ci.AlertKey < cj.AlertKey
+	})
+	sort.SliceStable(res.Notifications, func(i, j int) bool {
+		ni, nj := res.Notifications[i], res.Notifications[j]
+		if !ni.Time.Equal(nj.Time) {
+			return ni.Time.Before(nj.Time)
+		}
+		if ni.AlertKey != nj.AlertKey {
+			return ni.AlertKey < nj.AlertKey
+		}
+		return ni.Notification < nj.Notification
+	})
+	return res, nil
+}
+
+// collectNotifications moves the notifications of the check at t from the pending
+// queue of the simulation to its result, like sendNotifications would send them,
+// and queues the next notifications of their chains.
+func (s *Schedule) collectNotifications(res *Simulation, chains map[models.AlertKey]map[*conf.Notification]time.Time, t time.Time) {
+	for n, states := range s.pendingNotifications {
+		for _, st := range states {
+			// runHistory clears the queued notifications of an alert key it notifies
+			delete(chains, st.AlertKey)
+		}
+		for _, st := range states {
+			if st.CurrentStatus != models.StUnknown && !s.RuleConf.GetAlert(st.AlertKey.Name()).Log && (!st.Open || !st.NeedAck) {
+				continue
+			}
+			res.Notifications = append(res.Notifications, newSimulatedNotification(t, st.IncidentState, n))
+			if n.Next != nil {
+				queueChained(chains, st.AlertKey, n.Next, t)
+			}
+		}
+	}
+	s.pendingNotifications = nil
+}
+
+// sendChained adds the chained notifications that are due by t to the result if
+// their incident still needs an acknowledgement, and queues the next ones.
+func (s *Schedule) sendChained(res *Simulation, chains map[models.AlertKey]map[*conf.Notification]time.Time, t time.Time, interval time.Duration) error {
+	for ak, ns := range chains {
+		for {
+			var n *conf.Notification
+			var due time.Time
+			for next, d := range ns {
+				if !d.After(t) && (n == nil || d.Before(due)) {
+					n, due = next, d
+				}
+			}
+			if n == nil {
+				break
+			}
+			delete(ns, n)
+			st, err := s.DataAccess.State().GetLatestIncident(ak)
+			if err != nil {
+				return err
+			}
+			if st == nil || !st.Open || !st.NeedAck {
+				continue
+			}
+			res.Notifications = append(res.Notifications, newSimulatedNotification(due, st, n))
+			if n.Next == nil {
+				continue
+			}
+			if n.Next.Timeout > 0 {
+				queueChained(chains, ak, n.Next, due)
+			} else {
+				// without a timeout the chain would never end within this check
+				ns[n.Next] = t.Add(interval)
+			}
+		}
+		if len(ns) == 0 {
+			delete(chains, ak)
+		}
+	}
+	return nil
+}
+
+func queueChained(chains map[models.AlertKey]map[*conf.Notification]time.Time, ak models.AlertKey, n *conf.Notification, started time.Time) {
+	if chains[ak] == nil {
+		chains[ak] = make(map[*conf.Notification]time.Time)
+	}
+	chains[ak][n] = started.Add(n.Timeout)
+}
+
+func newSimulatedNotification(t time.Time, st *models.IncidentState, n *conf.Notification) *SimulatedNotification {
+	return &SimulatedNotification{
+		Time:         t,
+		AlertKey:     st.AlertKey,
+		IncidentId:   st.Id,
+		Notification: n.Name,
+		Status:       st.CurrentStatus,
+		Subject:      st.Subject,
+	}
+}
+
+// lastError returns the message of the last error of an alert.
+func (s *Schedule) lastError(alert string) string {
+	errs, err := s.DataAccess.Errors().GetFullErrorHistory()
+	if err != nil {
+		return err.Error()
+	}
+	// newest first
+	if events := errs[alert]; len(events) > 0 {
+		return events[0].Message
+	}
+	return ""
+}
@@ -0,0 +1,102 @@
+package sched
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/conf/rule"
+	"bosun.org/models"
+	"bosun.org/opentsdb"
+)
+
+func TestSimulate(t *testing.T) {
+	from := time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC)
+	// host a is critical for the checks from 12:10 to 12:20, host b stops
+	// reporting after 12:10
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct{ End float64 }
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Error(err)
+			return
+		}
+		end := time.Unix(int64(req.End), 0)
+		v := 0
+		if end.Sub(from) >= 10*time.Minute && end.Sub(from) <= 20*time.Minute {
+			v = 2
+		}
+		dps := map[string]opentsdb.Point{fmt.Sprint(end.Unix()): opentsdb.Point(v)}
+		resp := opentsdb.ResponseSet{{Metric: "m", Tags: opentsdb.TagSet{"host": "a"}, DPS: dps}}
+		if end.Sub(from) <= 10*time.Minute {
+			dps := map[string]opentsdb.Point{fmt.Sprint(end.Unix()): 0}
+			resp = append(resp, &opentsdb.Response{Metric: "m", Tags: opentsdb.TagSet{"host": "b"}, DPS: dps})
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer ts.Close()
+	u, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := rule.NewConf("", conf.EnabledBackends{OpenTSDB: true}, nil, `
+		template t {
+			subject = {{.Last.Status}} {{.Group.host}}
+		}
+		notification page {
+			print = true
+			next = page
+			timeout = 10m
+		}
+		alert a {
+			template = t
+			critNotification = page
+			crit = avg(q("avg:m{host=*}", "5m", "")) > 1
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &Schedule{SystemConf: &conf.SystemConf{
+		CheckFrequency:  conf.Duration{Duration: 5 * time.Minute},
+		DefaultRunEvery: 1,
+		OpenTSDBConf:    conf.OpenTSDBConf{Host: u.Host, ResponseLimit: 1 << 20},
+	}}
+	sim, err := s.Simulate(c, c.Alerts["a"], from, from.Add(40*time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sim.Checks != 9 || sim.Interval != "5m0s" || len(sim.Errors) != 0 {
+		t.Fatalf("unexpected simulation %+v", sim)
+	}
+	var changes []string
+	for _, c := range sim.Changes {
+		changes = append(changes, fmt.Sprintf("%s %s %s->%s", c.Time.Sub(from), c.AlertKey, c.From, c.To))
+	}
+	expected := "10m0s a{host=a} normal->critical, 20m0s a{host=b} normal->unknown, 25m0s a{host=a} critical->normal"
+	if got := strings.Join(changes, ", "); got != expected {
+		t.Errorf("expected changes %s, got %s", expected, got)
+	}
+	var nots []string
+	for _, n := range sim.Notifications {
+		nots = append(nots, fmt.Sprintf("%s %s %s", n.Time.Sub(from), n.AlertKey, n.Status))
+	}
+	// nobody acknowledges the incidents, so the chain continues
+	expected = "10m0s a{host=a} critical, 20m0s a{host=a} critical, 20m0s a{host=b} unknown, " +
+		"30m0s a{host=a} normal, 30m0s a{host=b} unknown, 40m0s a{host=a} normal, 40m0s a{host=b} unknown"
+	if got := strings.Join(nots, ", "); got != expected {
+		t.Errorf("expected notifications %s, got %s", expected, got)
+	}
+	if len(sim.Incidents) != 2 || !sim.Incidents[0].Start.Equal(from.Add(10*time.Minute)) || sim.Incidents[0].WorstStatus != models.StCritical {
+		t.Errorf("unexpected incidents %+v", sim.Incidents)
+	}
+
+	if _, err := s.Simulate(c, c.Alerts["a"], from, from.Add(time.Hour*24*365)); err == nil {
+		t.Error("expected an error for too many checks")
+	}
+}
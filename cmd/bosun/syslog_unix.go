@@ -4,6 +4,7 @@ package main
 
 import (
 	"flag"
+	"io"
 	"log"
 	"log/syslog"
 )
@@ -12,6 +13,9 @@ var noSyslog = flag.Bool("disable-syslog", false, "disables logging to syslog")
 
 func init() {
 	mains = append(mains, setSyslog)
+	auditSyslog = func() (io.Writer, error) {
+		return syslog.New(syslog.LOG_AUTH|syslog.LOG_INFO, "bosun-audit")
+	}
 }
 
 func setSyslog() {
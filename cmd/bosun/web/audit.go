@@ -0,0 +1,239 @@
+package web
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MiniProfiler/go/miniprofiler"
+	"github.com/captncraig/easyauth"
+	"github.com/gorilla/mux"
+
+	"bosun.org/models"
+	"bosun.org/slog"
+)
+
+// AuditLog, if set, gets every audit event as a line of json, i.e. for syslog.
+var AuditLog io.Writer
+
+const (
+	auditParamsLimit = 4096 // bytes of the query string and body kept
+	auditErrorLimit  = 1024 // bytes of the response body of failed requests kept
+	auditPruneEvery  = time.Hour
+	auditMaxLimit    = 10000 // events returned by Audit at most
+)
+
+// auditRedactedRoutes are the routes whose request bodies are not recorded, as they
+// have rule configuration, which may have secrets.
+var auditRedactedRoutes = map[string]bool{
+	"config_save": true,
+	"bulk_edit":   true,
+}
+
+var auditPruned struct {
+	sync.Mutex
+	last time.Time
+}
+
+func auditEnabled() bool {
+	return schedule.SystemConf.GetAuditRetention() > 0 || AuditLog != nil
+}
+
+// auditRecorder keeps the status and the start of the body of a response.
+type auditRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (a *auditRecorder) WriteHeader(status int) {
+	a.status = status
+	a.ResponseWriter.WriteHeader(status)
+}
+
+func (a *auditRecorder) Write(b []byte) (int, error) {
+	if n := auditErrorLimit - a.body.Len(); n > 0 {
+		if n > len(b) {
+			n = len(b)
+		}
+		a.body.Write(b[:n])
+	}
+	return a.ResponseWriter.Write(b)
+}
+
+// audited records the requests other than GET to a route of perms in the audit log.
+// It must be inside the auth wrapper of the route, which sets the user, and the route
+// must not change state on GET.
+func audited(h http.Handler, perms easyauth.Role) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet || r.Method == http.MethodHead || !auditEnabled() {
+			h.ServeHTTP(w, r)
+			return
+		}
+		e := &models.AuditEvent{
+			Time:   time.Now().UTC(),
+			User:   getUsername(r),
+			Method: r.Method,
+			Path:   r.URL.Path,
+		}
+		if u := easyauth.GetUser(r); u != nil {
+			e.Permissions = permissionNames(u.Access & perms)
+		}
+		if route := mux.CurrentRoute(r); route != nil {
+			e.Route = route.GetName()
+		}
+		// the bodies of redacted routes are only counted
+		var redacted *countingReader
+		if auditRedactedRoutes[e.Route] {
+			redacted = &countingReader{r: r.Body}
+			r.Body = struct {
+				io.Reader
+				io.Closer
+			}{redacted, r.Body}
+		} else {
+			// keep the start of the body for the event, and the whole body for the handler
+			body, err := ioutil.ReadAll(io.LimitReader(r.Body, auditParamsLimit))
+			if err != nil {
+				serveError(w, err)
+				return
+			}
+			r.Body = struct {
+				io.Reader
+				io.Closer
+			}{io.MultiReader(bytes.NewReader(body), r.Body), r.Body}
+			e.Params = auditParams(r.URL.RawQuery, body)
+		}
+
+		rec := &auditRecorder{ResponseWriter: w, status: http.StatusOK}
+		h.ServeHTTP(rec, r)
+		if redacted != nil {
+			e.Params = auditParams(r.URL.RawQuery, []byte(fmt.Sprintf("<%d bytes redacted>", redacted.n)))
+		}
+		e.Status = rec.status
+		if rec.status >= http.StatusBadRequest {
+			e.Error = strings.TrimSpace(rec.body.String())
+		}
+		recordAudit(e)
+	})
+}
+
+// countingReader counts the bytes read from r.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(b []byte) (int, error) {
+	n, err := c.r.Read(b)
+	c.n += int64(n)
+	return n, err
+}
+
+func auditParams(query string, body []byte) string {
+	params := query
+	if len(body) > 0 {
+		if params != "" {
+			params += " "
+		}
+		params += string(body)
+	}
+	if len(params) > auditParamsLimit {
+		params = params[:auditParamsLimit]
+	}
+	return params
+}
+
+// permissionNames returns the names of the permissions, or else of the scopes, in
+// access.
+func permissionNames(access easyauth.Role) string {
+	if access&^scopeBits != 0 {
+		access &^= scopeBits
+	}
+	var names []string
+	for _, p := range roleDefs.Permissions {
+		if access&p.Bits != 0 {
+			names = append(names, p.Name)
+		}
+	}
+	return strings.Join(names, ",")
+}
+
+func recordAudit(e *models.AuditEvent) {
+	if retention := schedule.SystemConf.GetAuditRetention(); retention > 0 {
+		if err := schedule.DataAccess.Audit().AddAuditEvent(e); err != nil {
+			slog.Errorf("audit: %v", err)
+		}
+		pruneAudit(e.Time.Add(-retention))
+	}
+	if AuditLog != nil {
+		b, err := json.Marshal(e)
+		if err != nil {
+			slog.Errorf("audit: %v", err)
+			return
+		}
+		if _, err := fmt.Fprintf(AuditLog, "%s\n", b); err != nil {
+			slog.Errorf("audit: %v", err)
+		}
+	}
+}
+
+// pruneAudit deletes the events before t, at most every auditPruneEvery.
+func pruneAudit(t time.Time) {
+	auditPruned.Lock()
+	defer auditPruned.Unlock()
+	if time.Since(auditPruned.last) < auditPruneEvery {
+		return
+	}
+	auditPruned.last = time.Now()
+	if err := schedule.DataAccess.Audit().DeleteAuditEventsBefore(t); err != nil {
+		slog.Errorf("audit: %v", err)
+	}
+}
+
+// Audit returns the audit events selected by the start, end, user and route
+// parameters, most recent first. limit defaults to 100, and is at most auditMaxLimit.
+func Audit(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	if schedule.SystemConf.GetAuditRetention() <= 0 {
+		return nil, fmt.Errorf("the audit log is not stored, AuditRetention is 0")
+	}
+	f := &models.AuditFilter{
+		User:  r.FormValue("user"),
+		Route: r.FormValue("route"),
+		Limit: 100,
+	}
+	var err error
+	if f.Start, err = parseAuditTime(r.FormValue("start")); err != nil {
+		return nil, err
+	}
+	if f.End, err = parseAuditTime(r.FormValue("end")); err != nil {
+		return nil, err
+	}
+	if s := r.FormValue("limit"); s != "" {
+		if f.Limit, err = strconv.Atoi(s); err != nil {
+			return nil, err
+		}
+		if f.Limit <= 0 || f.Limit > auditMaxLimit {
+			return nil, fmt.Errorf("limit must be between 1 and %d", auditMaxLimit)
+		}
+	}
+	return schedule.DataAccess.Audit().GetAuditEvents(f)
+}
+
+func parseAuditTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	for _, layout := range silenceLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognized time format: %s", s)
+}
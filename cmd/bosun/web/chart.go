@@ -245,6 +245,7 @@ func ExprGraph(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (in
 		InfluxConfig:    schedule.SystemConf.GetInfluxContext(),
 		LogstashHosts:   schedule.SystemConf.GetLogstashContext(),
 		ElasticHosts:    schedule.SystemConf.GetElasticContext(),
+		PromContext:     schedule.SystemConf.GetPromContext(),
 	}
 	providers := &expr.BosunProviders{
 		Cache:     cacheObj,
@@ -252,6 +253,8 @@ func ExprGraph(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (in
 		Annotate:  AnnotateBackend,
 		Squelched: nil,
 		History:   nil,
+
+		QueryCache: schedule.QueryCache,
 	}
 	res, _, err := e.Execute(backends, providers, t, now, autods, false)
 	if err != nil {
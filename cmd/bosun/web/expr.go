@@ -51,9 +51,11 @@ func Expr(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (v inter
 		if line == "" || strings.HasPrefix(line, "#") {
 			continue
 		}
-		// last line is expression we care about
-		if i == len(lines)-1 {
-			expression = schedule.RuleConf.Expand(line, vars, false)
+		// last line is expression we care about, or it starts at the first let or func
+		// definition and may span several lines
+		if i == len(lines)-1 || strings.HasPrefix(line, "let ") || strings.HasPrefix(line, "func ") {
+			expression = schedule.RuleConf.Expand(strings.Join(lines[i:], "\n"), vars, false)
+			break
 		} else { // must be a variable declatation
 			matches := varRegex.FindStringSubmatch(line)
 			if len(matches) == 0 {
@@ -72,6 +74,10 @@ func Expr(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (v inter
 	if err != nil {
 		return nil, err
 	}
+	snap, err := getSnapshot(r)
+	if err != nil {
+		return nil, err
+	}
 	// it may not strictly be necessary to recreate the contexts each time, but we do to be safe
 	backends := &expr.Backends{
 		TSDBContext:     schedule.SystemConf.GetTSDBContext(),
@@ -79,6 +85,7 @@ func Expr(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (v inter
 		InfluxConfig:    schedule.SystemConf.GetInfluxContext(),
 		LogstashHosts:   schedule.SystemConf.GetLogstashContext(),
 		ElasticHosts:    schedule.SystemConf.GetElasticContext(),
+		PromContext:     schedule.SystemConf.GetPromContext(),
 	}
 	providers := &expr.BosunProviders{
 		Cache:     cacheObj,
@@ -86,6 +93,12 @@ func Expr(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (v inter
 		Squelched: nil,
 		History:   nil,
 		Annotate:  AnnotateBackend,
+
+		QueryCache: schedule.QueryCache,
+	}
+	if snap != nil {
+		now = snap.Time
+		providers.Snapshot = expr.ReplaySnapshot(snap)
 	}
 	res, queries, err := e.Execute(backends, providers, t, now, 0, false)
 	if err != nil {
@@ -129,18 +142,45 @@ func getTime(r *http.Request) (now time.Time, err error) {
 	return
 }
 
+// getSnapshot returns the snapshot of the incident in the replay parameter, or nil
+// if there is no such parameter.
+func getSnapshot(r *http.Request) (*models.Snapshot, error) {
+	v := r.FormValue("replay")
+	if v == "" {
+		return nil, nil
+	}
+	id, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	snap, err := schedule.DataAccess.State().GetSnapshot(id)
+	if err != nil {
+		return nil, err
+	}
+	if snap == nil {
+		return nil, fmt.Errorf("no snapshot of incident %d", id)
+	}
+	return snap, nil
+}
+
 type Res struct {
 	*models.Event
 	Key models.AlertKey
 }
 
-func procRule(t miniprofiler.Timer, ruleConf conf.RuleConfProvider, a *conf.Alert, now time.Time, summary bool, email string, template_group string) (*ruleResult, error) {
+// procRule evaluates an alert at now. If snap is not nil, the backend responses are
+// replayed from it instead of querying the backends.
+func procRule(t miniprofiler.Timer, ruleConf conf.RuleConfProvider, a *conf.Alert, now time.Time, snap *models.Snapshot, summary bool, email string, template_group string) (*ruleResult, error) {
 	s := &sched.Schedule{}
 	s.Search = schedule.Search
+	s.QueryCache = schedule.QueryCache
 	if err := s.Init(schedule.SystemConf, ruleConf, schedule.DataAccess, AnnotateBackend, false, false); err != nil {
 		return nil, err
 	}
 	rh := s.NewRunHistory(now, cacheObj)
+	if snap != nil {
+		rh.Snapshot = expr.ReplaySnapshot(snap)
+	}
 	if _, err, _ := s.CheckExpr(t, rh, a, a.Warn, models.StWarning, nil); err != nil {
 		return nil, err
 	}
@@ -243,8 +283,8 @@ func procRule(t miniprofiler.Timer, ruleConf conf.RuleConfProvider, a *conf.Aler
 				warning = append(warning, b_err.Error())
 			} else if s_err != nil {
 				warning = append(warning, s_err.Error())
-			} else {
-				n.DoEmail(email_subject, email, schedule.SystemConf, string(primaryIncident.AlertKey), attachments...)
+			} else if err := n.DoEmail(email_subject, email, schedule.SystemConf, string(primaryIncident.AlertKey), attachments...); err != nil {
+				warning = append(warning, err.Error())
 			}
 		}
 		data = s.Data(rh, primaryIncident, a, false)
@@ -300,6 +340,19 @@ func Rule(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interfa
 			return nil, fmt.Errorf("must be > 0 intervals")
 		}
 	}
+	snap, err := getSnapshot(r)
+	if err != nil {
+		return nil, err
+	}
+	if snap != nil {
+		if !from.IsZero() || !to.IsZero() {
+			return nil, fmt.Errorf("cannot specify from or to with replay")
+		}
+		if intervals != 1 {
+			return nil, fmt.Errorf("cannot specify intervals with replay")
+		}
+		from = snap.Time
+	}
 	if fz, tz := from.IsZero(), to.IsZero(); fz && tz {
 		from = time.Now()
 	} else if fz && !tz {
@@ -326,7 +379,7 @@ func Rule(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interfa
 		for interval := range ch {
 			t.Step(fmt.Sprintf("interval %v", interval), func(t miniprofiler.Timer) {
 				now := from.Add(diff * time.Duration(interval))
-				res, err := procRule(t, c, a, now, interval != 0, r.FormValue("email"), r.FormValue("template_group"))
+				res, err := procRule(t, c, a, now, snap, interval != 0, r.FormValue("email"), r.FormValue("template_group"))
 				resch <- res
 				errch <- err
 			})
@@ -443,6 +496,28 @@ func Rule(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interfa
 	return &ret, nil
 }
 
+// SimulateRule simulates the checks of the alert of the posted rule configuration
+// from from to to, or now, against a throw-away data store. It returns the status
+// changes, notifications and incidents that would have happened.
+func SimulateRule(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	from, err := time.Parse(tsdbFormatSecs, r.FormValue("from"))
+	if err != nil {
+		return nil, err
+	}
+	to := time.Now().UTC()
+	if f := r.FormValue("to"); len(f) > 0 {
+		to, err = time.Parse(tsdbFormatSecs, f)
+		if err != nil {
+			return nil, err
+		}
+	}
+	c, a, _, err := buildConfig(r)
+	if err != nil {
+		return nil, err
+	}
+	return schedule.Simulate(c, a, from, to)
+}
+
 func buildConfig(r *http.Request) (c conf.RuleConfProvider, a *conf.Alert, hash string, err error) {
 	config, err := ioutil.ReadAll(r.Body)
 	if err != nil {
@@ -7,7 +7,6 @@ import (
 	"github.com/captncraig/easyauth"
 	"github.com/captncraig/easyauth/providers/ldap"
 	"github.com/captncraig/easyauth/providers/token"
-	"github.com/captncraig/easyauth/providers/token/redisStore"
 	"github.com/gorilla/mux"
 
 	"bosun.org/cmd/bosun/conf"
@@ -72,7 +71,11 @@ func buildAuth(cfg *conf.AuthConf) (easyauth.AuthManager, *token.TokenProvider,
 	if cfg.CookieSecret == "" {
 		cfg.CookieSecret = defaultCookieSecret
 	}
-	auth, err := easyauth.New(easyauth.CookieSecret(cfg.CookieSecret))
+	opts := []easyauth.Option{easyauth.CookieSecret(cfg.CookieSecret)}
+	if cfg.OIDC.Issuer != "" {
+		opts = append(opts, easyauth.LoginTemplate(oidcLoginTemplate))
+	}
+	auth, err := easyauth.New(opts...)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -81,6 +84,13 @@ func buildAuth(cfg *conf.AuthConf) (easyauth.AuthManager, *token.TokenProvider,
 	} else {
 		authEnabled = true
 	}
+	// before the providers, so that their roles can grant the scopes
+	if err := buildScopes(cfg.Scopes); err != nil {
+		return nil, nil, err
+	}
+	if err := checkScopeBits(schedule.DataAccess.Configs(), schedule.DataAccess.Tokens()); err != nil {
+		return nil, nil, err
+	}
 	if cfg.LDAP.LdapAddr != "" {
 		l, err := buildLDAPConfig(cfg.LDAP)
 		if err != nil {
@@ -88,10 +98,17 @@ func buildAuth(cfg *conf.AuthConf) (easyauth.AuthManager, *token.TokenProvider,
 		}
 		auth.AddProvider("ldap", l)
 	}
+	if cfg.OIDC.Issuer != "" {
+		o, err := buildOIDCConfig(cfg.OIDC)
+		if err != nil {
+			return nil, nil, err
+		}
+		auth.AddProvider("oidc", o)
+	}
 	var authTokens *token.TokenProvider
 	if cfg.TokenSecret != "" {
 		tokensEnabled = true
-		authTokens = token.NewToken(cfg.TokenSecret, redisStore.New(schedule.DataAccess))
+		authTokens = token.NewToken(cfg.TokenSecret, schedule.DataAccess.Tokens())
 		auth.AddProvider("tok", authTokens)
 	}
 	return auth, authTokens, nil
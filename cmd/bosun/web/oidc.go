@@ -0,0 +1,524 @@
+package web
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/captncraig/easyauth"
+	"golang.org/x/oauth2"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/slog"
+)
+
+// oidcProvider logs users in with an OpenID Connect identity provider by the authorization
+// code flow, and keeps them logged in with a cookie like the LDAP provider. easyauth routes
+// /login/oidc/ to it, which redirects to the identity provider, which redirects back to
+// /login/oidc/callback.
+type oidcProvider struct {
+	conf.OIDCConf
+	defaultRole easyauth.Role
+	groups      map[string]easyauth.Role
+	users       map[string]easyauth.Role
+	// client makes the requests to the identity provider
+	client *http.Client
+
+	sync.Mutex
+	discovery *oidcDiscovery
+	keys      map[string]crypto.PublicKey // by key id
+}
+
+// ensure at compile time we implement the interfaces we intend to
+var _ easyauth.Logoutable = (*oidcProvider)(nil)
+var _ easyauth.HTTPProvider = (*oidcProvider)(nil)
+
+const (
+	oidcAuthCookie  = "oidc-auth"
+	oidcStateCookie = "oidc-state"
+	// the login page shows this cookie of easyauth
+	loginErrorCookie = "errMsg"
+	// how long a login may take at the identity provider, in seconds
+	oidcLoginTimeout = 10 * 60
+)
+
+// oidcDiscovery is the part of the provider metadata at /.well-known/openid-configuration
+// that bosun uses.
+type oidcDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// oidcState is kept in a cookie during a login to check the response of the identity provider.
+type oidcState struct {
+	State string
+	Nonce string
+}
+
+func buildOIDCConfig(oc conf.OIDCConf) (*oidcProvider, error) {
+	if oc.ClientID == "" {
+		return nil, fmt.Errorf("OIDC: ClientID is required")
+	}
+	o := &oidcProvider{
+		OIDCConf: oc,
+		groups:   map[string]easyauth.Role{},
+		users:    map[string]easyauth.Role{},
+		client:   http.DefaultClient,
+	}
+	o.Issuer = strings.TrimSuffix(o.Issuer, "/")
+	if o.Scopes == nil {
+		o.Scopes = []string{"profile", "email"}
+	}
+	if o.UsernameClaim == "" {
+		o.UsernameClaim = "preferred_username"
+	}
+	if o.GroupsClaim == "" {
+		o.GroupsClaim = "groups"
+	}
+	if oc.DefaultPermission != "" {
+		var err error
+		if o.defaultRole, err = parseRole(oc.DefaultPermission); err != nil {
+			return nil, err
+		}
+	}
+	for _, g := range oc.Groups {
+		role, err := parseRole(g.Role)
+		if err != nil {
+			return nil, err
+		}
+		o.groups[g.Name] |= role
+	}
+	for name, perm := range oc.Users {
+		role, err := parseRole(perm)
+		if err != nil {
+			return nil, err
+		}
+		o.users[name] = role
+	}
+	return o, nil
+}
+
+func (o *oidcProvider) GetUser(r *http.Request) (*easyauth.User, error) {
+	u := &easyauth.User{}
+	err := easyauth.GetCookieManager(r).ReadCookie(r, oidcAuthCookie, 0, u)
+	if err != nil {
+		if err == http.ErrNoCookie {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return u, nil
+}
+
+func (o *oidcProvider) Logout(w http.ResponseWriter, r *http.Request) {
+	easyauth.GetCookieManager(r).ClearCookie(w, oidcAuthCookie)
+}
+
+func (o *oidcProvider) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if strings.HasSuffix(r.URL.Path, "/callback") {
+		o.callback(w, r)
+		return
+	}
+	o.login(w, r)
+}
+
+// fail logs err and shows msg on the login page.
+func (o *oidcProvider) fail(w http.ResponseWriter, r *http.Request, msg string, err error) {
+	slog.Errorf("OIDC login: %s: %v", msg, err)
+	easyauth.GetCookieManager(r).SetCookiePlain(w, loginErrorCookie, 60, msg)
+	http.Redirect(w, r, "/login/", http.StatusFound)
+}
+
+func (o *oidcProvider) login(w http.ResponseWriter, r *http.Request) {
+	d, err := o.discover()
+	if err != nil {
+		o.fail(w, r, "Error contacting identity provider", err)
+		return
+	}
+	st := &oidcState{
+		State: easyauth.RandomString(24),
+		Nonce: easyauth.RandomString(24),
+	}
+	if err := easyauth.GetCookieManager(r).SetCookie(w, oidcStateCookie, oidcLoginTimeout, st); err != nil {
+		o.fail(w, r, "Error starting login", err)
+		return
+	}
+	u := o.oauth2Config(d, r).AuthCodeURL(st.State, oauth2.SetAuthURLParam("nonce", st.Nonce))
+	http.Redirect(w, r, u, http.StatusFound)
+}
+
+func (o *oidcProvider) callback(w http.ResponseWriter, r *http.Request) {
+	cm := easyauth.GetCookieManager(r)
+	st := &oidcState{}
+	if err := cm.ReadCookie(r, oidcStateCookie, oidcLoginTimeout, st); err != nil {
+		o.fail(w, r, "Login expired, please try again", err)
+		return
+	}
+	cm.ClearCookie(w, oidcStateCookie)
+	if e := r.FormValue("error"); e != "" {
+		o.fail(w, r, "Login denied by identity provider", fmt.Errorf("%s: %s", e, r.FormValue("error_description")))
+		return
+	}
+	if r.FormValue("state") != st.State {
+		o.fail(w, r, "Invalid login, please try again", fmt.Errorf("state does not match"))
+		return
+	}
+	d, err := o.discover()
+	if err != nil {
+		o.fail(w, r, "Error contacting identity provider", err)
+		return
+	}
+	ctx := context.WithValue(r.Context(), oauth2.HTTPClient, o.client)
+	cfg := o.oauth2Config(d, r)
+	tok, err := cfg.Exchange(ctx, r.FormValue("code"))
+	if err != nil {
+		o.fail(w, r, "Error contacting identity provider", err)
+		return
+	}
+	raw, _ := tok.Extra("id_token").(string)
+	if raw == "" {
+		o.fail(w, r, "Invalid login", fmt.Errorf("no id_token in token response"))
+		return
+	}
+	claims, err := o.verify(d, raw, st.Nonce)
+	if err != nil {
+		o.fail(w, r, "Invalid login", err)
+		return
+	}
+	if err := o.userinfo(ctx, cfg, tok, d, claims); err != nil {
+		o.fail(w, r, "Error contacting identity provider", err)
+		return
+	}
+	user, err := o.user(claims)
+	if err != nil {
+		o.fail(w, r, "Invalid login", err)
+		return
+	}
+	if err := cm.SetCookie(w, oidcAuthCookie, 0, user); err != nil {
+		o.fail(w, r, "Error finishing login", err)
+		return
+	}
+	easyauth.GetRedirector(r)()
+}
+
+func (o *oidcProvider) oauth2Config(d *oidcDiscovery, r *http.Request) *oauth2.Config {
+	redirect := o.RedirectURL
+	if redirect == "" {
+		scheme := "http"
+		if r.TLS != nil {
+			scheme = "https"
+		}
+		redirect = scheme + "://" + r.Host
+	}
+	return &oauth2.Config{
+		ClientID:     o.ClientID,
+		ClientSecret: o.ClientSecret,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  d.AuthorizationEndpoint,
+			TokenURL: d.TokenEndpoint,
+		},
+		RedirectURL: strings.TrimSuffix(redirect, "/") + "/login/oidc/callback",
+		Scopes:      append([]string{"openid"}, o.Scopes...),
+	}
+}
+
+// getJSON decodes the response to a GET of u into v.
+func (o *oidcProvider) getJSON(client *http.Client, u string, v interface{}) error {
+	resp, err := client.Get(u)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: %s", u, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// discover returns the provider metadata, which is fetched once.
+func (o *oidcProvider) discover() (*oidcDiscovery, error) {
+	o.Lock()
+	defer o.Unlock()
+	if o.discovery != nil {
+		return o.discovery, nil
+	}
+	d := &oidcDiscovery{}
+	if err := o.getJSON(o.client, o.Issuer+"/.well-known/openid-configuration", d); err != nil {
+		return nil, err
+	}
+	if strings.TrimSuffix(d.Issuer, "/") != o.Issuer {
+		return nil, fmt.Errorf("issuer %s of the provider metadata is not %s", d.Issuer, o.Issuer)
+	}
+	if d.AuthorizationEndpoint == "" || d.TokenEndpoint == "" || d.JWKSURI == "" {
+		return nil, fmt.Errorf("incomplete provider metadata of %s", o.Issuer)
+	}
+	o.discovery = d
+	return d, nil
+}
+
+// key returns the key of the provider with the id kid. The keys are fetched again when
+// kid is unknown, since the provider may have rotated them.
+func (o *oidcProvider) key(d *oidcDiscovery, kid string) (crypto.PublicKey, error) {
+	o.Lock()
+	defer o.Unlock()
+	find := func() crypto.PublicKey {
+		if kid == "" && len(o.keys) == 1 {
+			for _, k := range o.keys {
+				return k
+			}
+		}
+		return o.keys[kid]
+	}
+	if k := find(); k != nil {
+		return k, nil
+	}
+	var jwks struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			Use string `json:"use"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+			Crv string `json:"crv"`
+			X   string `json:"x"`
+			Y   string `json:"y"`
+		} `json:"keys"`
+	}
+	if err := o.getJSON(o.client, d.JWKSURI, &jwks); err != nil {
+		return nil, err
+	}
+	keys := make(map[string]crypto.PublicKey)
+	for _, k := range jwks.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		switch k.Kty {
+		case "RSA":
+			n, err1 := base64BigInt(k.N)
+			e, err2 := base64BigInt(k.E)
+			if err1 != nil || err2 != nil || !e.IsInt64() {
+				return nil, fmt.Errorf("invalid RSA key %s", k.Kid)
+			}
+			keys[k.Kid] = &rsa.PublicKey{N: n, E: int(e.Int64())}
+		case "EC":
+			if k.Crv != "P-256" {
+				continue
+			}
+			x, err1 := base64BigInt(k.X)
+			y, err2 := base64BigInt(k.Y)
+			if err1 != nil || err2 != nil {
+				return nil, fmt.Errorf("invalid EC key %s", k.Kid)
+			}
+			keys[k.Kid] = &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}
+		}
+	}
+	o.keys = keys
+	if k := find(); k != nil {
+		return k, nil
+	}
+	return nil, fmt.Errorf("unknown key %q", kid)
+}
+
+func base64BigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+// verify checks the signature, issuer, audience, expiry and nonce of the ID token and
+// returns its claims.
+func (o *oidcProvider) verify(d *oidcDiscovery, raw, nonce string) (map[string]interface{}, error) {
+	parts := strings.Split(raw, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("malformed ID token")
+	}
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, err
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("malformed ID token signature: %v", err)
+	}
+	key, err := o.key(d, header.Kid)
+	if err != nil {
+		return nil, err
+	}
+	h := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		if header.Alg != "RS256" {
+			return nil, fmt.Errorf("unsupported ID token algorithm %q for RSA key", header.Alg)
+		}
+		if err := rsa.VerifyPKCS1v15(k, crypto.SHA256, h[:], sig); err != nil {
+			return nil, fmt.Errorf("invalid ID token signature")
+		}
+	case *ecdsa.PublicKey:
+		if header.Alg != "ES256" {
+			return nil, fmt.Errorf("unsupported ID token algorithm %q for EC key", header.Alg)
+		}
+		if len(sig) != 64 || !ecdsa.Verify(k, h[:], new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:])) {
+			return nil, fmt.Errorf("invalid ID token signature")
+		}
+	}
+	claims := map[string]interface{}{}
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, err
+	}
+	if iss, _ := claims["iss"].(string); strings.TrimSuffix(iss, "/") != o.Issuer {
+		return nil, fmt.Errorf("ID token issued by %q", iss)
+	}
+	audOK := false
+	switch aud := claims["aud"].(type) {
+	case string:
+		audOK = aud == o.ClientID
+	case []interface{}:
+		for _, a := range aud {
+			audOK = audOK || a == o.ClientID
+		}
+	}
+	if !audOK {
+		return nil, fmt.Errorf("ID token is not for client %s", o.ClientID)
+	}
+	exp, _ := claims["exp"].(float64)
+	if time.Now().After(time.Unix(int64(exp), 0)) {
+		return nil, fmt.Errorf("ID token expired")
+	}
+	if n, _ := claims["nonce"].(string); n != nonce {
+		return nil, fmt.Errorf("ID token nonce does not match")
+	}
+	return claims, nil
+}
+
+func decodeSegment(s string, v interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return fmt.Errorf("malformed ID token: %v", err)
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return fmt.Errorf("malformed ID token: %v", err)
+	}
+	return nil
+}
+
+// userinfo adds the claims of the userinfo endpoint that are not in the ID token, which
+// some providers only put the groups in.
+func (o *oidcProvider) userinfo(ctx context.Context, cfg *oauth2.Config, tok *oauth2.Token, d *oidcDiscovery, claims map[string]interface{}) error {
+	_, hasName := claims[o.UsernameClaim]
+	_, hasGroups := claims[o.GroupsClaim]
+	if d.UserinfoEndpoint == "" || (hasName && hasGroups) {
+		return nil
+	}
+	info := map[string]interface{}{}
+	if err := o.getJSON(cfg.Client(ctx, tok), d.UserinfoEndpoint, &info); err != nil {
+		return err
+	}
+	if info["sub"] != claims["sub"] {
+		return fmt.Errorf("userinfo is for subject %v, not %v", info["sub"], claims["sub"])
+	}
+	for k, v := range info {
+		if _, ok := claims[k]; !ok {
+			claims[k] = v
+		}
+	}
+	return nil
+}
+
+// user returns the user of the claims with the permissions of its groups.
+func (o *oidcProvider) user(claims map[string]interface{}) (*easyauth.User, error) {
+	name, _ := claims[o.UsernameClaim].(string)
+	if name == "" {
+		return nil, fmt.Errorf("no %s claim", o.UsernameClaim)
+	}
+	role := o.defaultRole | o.groups["*"] | o.users[name]
+	switch groups := claims[o.GroupsClaim].(type) {
+	case string:
+		role |= o.groups[groups]
+	case []interface{}:
+		for _, g := range groups {
+			if g, ok := g.(string); ok {
+				role |= o.groups[g]
+			}
+		}
+	}
+	return &easyauth.User{
+		Username: name,
+		Method:   "oidc",
+		Access:   role,
+	}, nil
+}
+
+// oidcLoginTemplate is the login page of easyauth with a button for the OpenID Connect login.
+const oidcLoginTemplate = `
+<html>
+<head>
+<link rel="stylesheet" href="https://maxcdn.bootstrapcdn.com/bootstrap/3.3.7/css/bootstrap.min.css" integrity="sha384-BVYiiSIFeK1dGmJRAkycuHAHRg32OmUcww7on3RYdg4Va+PmSTsz/K68vbdEjh4u" crossorigin="anonymous">
+<style>
+.tab-content {
+    border-left: 1px solid #ddd;
+    border-right: 1px solid #ddd;
+}
+.nav-tabs {
+    margin-bottom: 0;
+}
+</style>
+<script src="http://code.jquery.com/jquery-3.1.1.min.js" integrity="sha256-hVVnYaiADRTO2PzUGmuLJr8BLUSjGIZsDYGmIJLv2b8=" crossorigin="anonymous"></script>
+<script src="https://maxcdn.bootstrapcdn.com/bootstrap/3.3.7/js/bootstrap.min.js" integrity="sha384-Tc5IQib027qvyjSMfHjOMaLkfuWVxZxUPnCJA7l2mCWNIpG9mGCD8wGNIcPD7Txa" crossorigin="anonymous"></script>
+</head>
+<body>
+<div class="container">
+    {{if .Message}}<div class="alert alert-danger" role="alert">{{.Message}}</div>{{end}}
+    <div class='well' style='width:500px; margin:auto;margin-top:45px;'>
+        <h2>Login</h2>
+        {{if gt (len .Auth.FormProviders) 1}}
+        <ul class='nav nav-tabs nav-justified'>
+            {{range $index, $p := .Auth.FormProviders}}
+            <li role="presentation" {{if eq $index 0}}class='active'{{end}}>
+                <a href="#{{$p.Name}}" role="tab" data-toggle="tab">{{$p.Name}}</a>
+            </li>
+            {{end}}
+        </ul>
+        {{end}}
+        <div class="tab-content">
+            {{range $index, $p := .Auth.FormProviders}}
+                <div role="tabpanel" class="tab-pane{{if eq $index 0}} active{{end}}" id="{{$p.Name}}" style='background-color: white'>
+                    <form style='padding:10px;' action="./{{$p.Name}}" method="post">
+                        {{range $p.Provider.GetRequiredFields}}
+                            <label for="{{.}}">{{.}}</label>
+							{{if eq . "Password"}}
+							<input type="password" id="{{.}}" name="{{.}}" class="form-control" placeholder="{{.}}" required>
+							{{else}}
+                            <input type="text" id="{{.}}" name="{{.}}" class="form-control" placeholder="{{.}}" required>
+							{{end}}
+                        {{end}}
+                        <button class="btn btn-primary" type="submit" style="margin-top: 15px">Sign in</button>
+                    </form>
+                </div>
+            {{end}}
+        </div>
+        {{range .Auth.HTTPProviders}}
+            <a class="btn btn-primary btn-block" href="./{{.Name}}/" style="margin-top: 15px">Sign in with single sign-on</a>
+        {{end}}
+    </div>
+</div> 
+</body>
+</html>`
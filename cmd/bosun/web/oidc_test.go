@@ -0,0 +1,273 @@
+package web
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/captncraig/easyauth"
+	"github.com/gorilla/mux"
+
+	"bosun.org/cmd/bosun/conf"
+)
+
+// stubIdentityProvider is an OpenID Connect provider that issues an ID token for
+// jsmith for the code "thecode", with the groups only in its userinfo.
+type stubIdentityProvider struct {
+	*httptest.Server
+	key   *rsa.PrivateKey
+	nonce string // of the ID token to issue
+}
+
+func newStubIdentityProvider(t *testing.T) *stubIdentityProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &stubIdentityProvider{key: key}
+	m := http.NewServeMux()
+	m.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 p.URL,
+			"authorization_endpoint": p.URL + "/authorize",
+			"token_endpoint":         p.URL + "/token",
+			"userinfo_endpoint":      p.URL + "/userinfo",
+			"jwks_uri":               p.URL + "/jwks",
+		})
+	})
+	m.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": "k1",
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+	m.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		id, secret, _ := r.BasicAuth()
+		if r.FormValue("code") != "thecode" || id != "bosun" || secret != "secret" {
+			http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": "theaccesstoken",
+			"token_type":   "Bearer",
+			"id_token": p.sign(t, map[string]interface{}{
+				"iss":                p.URL,
+				"sub":                "u1",
+				"aud":                "bosun",
+				"exp":                time.Now().Add(time.Minute).Unix(),
+				"nonce":              p.nonce,
+				"preferred_username": "jsmith",
+			}),
+		})
+	})
+	m.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer theaccesstoken" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"sub":    "u1",
+			"groups": []string{"ops", "other"},
+		})
+	})
+	p.Server = httptest.NewServer(m)
+	return p
+}
+
+func (p *stubIdentityProvider) sign(t *testing.T, claims map[string]interface{}) string {
+	enc := func(v interface{}) string {
+		b, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return base64.RawURLEncoding.EncodeToString(b)
+	}
+	signed := enc(map[string]string{"alg": "RS256", "kid": "k1"}) + "." + enc(claims)
+	h := sha256.Sum256([]byte(signed))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, p.key, crypto.SHA256, h[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func TestOIDCLogin(t *testing.T) {
+	idp := newStubIdentityProvider(t)
+	defer idp.Close()
+
+	o, err := buildOIDCConfig(conf.OIDCConf{
+		Issuer:            idp.URL + "/",
+		ClientID:          "bosun",
+		ClientSecret:      "secret",
+		RedirectURL:       "https://bosun.example.com",
+		DefaultPermission: "ViewDashboard",
+		Groups:            []conf.OIDCGroup{{Name: "ops", Role: "Writer"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	auth, err := easyauth.New(easyauth.CookieSecret("aTestSecret"), easyauth.LoginTemplate(oidcLoginTemplate))
+	if err != nil {
+		t.Fatal(err)
+	}
+	auth.AddProvider("oidc", o)
+	router := mux.NewRouter()
+	router.PathPrefix("/login").Handler(http.StripPrefix("/login", auth.LoginHandler()))
+	router.Handle("/whoami", auth.Wrap(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if u := easyauth.GetUser(r); u != nil {
+			fmt.Fprintf(w, "%s %d", u.Username, u.Access)
+		}
+	}), fullyOpen))
+	bosun := httptest.NewServer(router)
+	defer bosun.Close()
+
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &http.Client{
+		Jar: jar,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	get := func(u string) *http.Response {
+		resp, err := client.Get(u)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp
+	}
+	login := func() url.Values {
+		resp := get(bosun.URL + "/login/oidc/")
+		loc, err := resp.Location()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.HasPrefix(loc.String(), idp.URL+"/authorize?") {
+			t.Fatalf("login redirected to %s", loc)
+		}
+		return loc.Query()
+	}
+	loginError := func(resp *http.Response) string {
+		if loc := resp.Header.Get("Location"); loc != "/login/" {
+			t.Fatalf("expected redirect to the login page, got %q", loc)
+		}
+		for _, c := range resp.Cookies() {
+			if c.Name == loginErrorCookie {
+				return c.Value
+			}
+		}
+		return ""
+	}
+	whoami := func() string {
+		resp, err := client.Get(bosun.URL + "/whoami")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		b, _ := ioutil.ReadAll(resp.Body)
+		return string(b)
+	}
+
+	q := login()
+	if q.Get("client_id") != "bosun" || q.Get("redirect_uri") != "https://bosun.example.com/login/oidc/callback" || q.Get("scope") != "openid profile email" {
+		t.Fatalf("unexpected authorization request %v", q)
+	}
+	// a response to another login is refused
+	resp := get(bosun.URL + "/login/oidc/callback?code=thecode&state=other")
+	if msg := loginError(resp); msg == "" {
+		t.Fatal("expected a login error for the wrong state")
+	}
+	if u := whoami(); u != "" {
+		t.Fatalf("logged in as %s with the wrong state", u)
+	}
+
+	// an ID token for another login is refused
+	q = login()
+	idp.nonce = "other"
+	resp = get(bosun.URL + "/login/oidc/callback?code=thecode&state=" + url.QueryEscape(q.Get("state")))
+	if msg := loginError(resp); msg == "" {
+		t.Fatal("expected a login error for the wrong nonce")
+	}
+
+	q = login()
+	idp.nonce = q.Get("nonce")
+	resp = get(bosun.URL + "/login/oidc/callback?code=thecode&state=" + url.QueryEscape(q.Get("state")))
+	if loc := resp.Header.Get("Location"); loc != "/" {
+		t.Fatalf("expected redirect to the dashboard, got %q", loc)
+	}
+	if u, expected := whoami(), fmt.Sprintf("jsmith %d", canViewDash|roleWriter); u != expected {
+		t.Fatalf("expected %q, got %q", expected, u)
+	}
+
+	get(bosun.URL + "/login/out")
+	if u := whoami(); u != "" {
+		t.Fatalf("still logged in as %s after logout", u)
+	}
+}
+
+func TestOIDCVerify(t *testing.T) {
+	idp := newStubIdentityProvider(t)
+	defer idp.Close()
+	o, err := buildOIDCConfig(conf.OIDCConf{Issuer: idp.URL, ClientID: "bosun"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := o.discover()
+	if err != nil {
+		t.Fatal(err)
+	}
+	valid := func() map[string]interface{} {
+		return map[string]interface{}{
+			"iss":   idp.URL,
+			"aud":   []string{"other", "bosun"},
+			"exp":   time.Now().Add(time.Minute).Unix(),
+			"nonce": "n",
+		}
+	}
+	if _, err := o.verify(d, idp.sign(t, valid()), "n"); err != nil {
+		t.Errorf("valid token: %v", err)
+	}
+	for name, change := range map[string]func(map[string]interface{}){
+		"issuer":   func(c map[string]interface{}) { c["iss"] = "https://evil.example.com" },
+		"audience": func(c map[string]interface{}) { c["aud"] = "other" },
+		"expired":  func(c map[string]interface{}) { c["exp"] = time.Now().Add(-time.Minute).Unix() },
+		"nonce":    func(c map[string]interface{}) { delete(c, "nonce") },
+	} {
+		c := valid()
+		change(c)
+		if _, err := o.verify(d, idp.sign(t, c), "n"); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+	tampered := idp.sign(t, valid())
+	parts := strings.Split(tampered, ".")
+	c := valid()
+	c["aud"] = "bosun"
+	b, _ := json.Marshal(c)
+	parts[1] = base64.RawURLEncoding.EncodeToString(b)
+	if _, err := o.verify(d, strings.Join(parts, "."), "n"); err == nil {
+		t.Error("tampered: expected an error")
+	}
+}
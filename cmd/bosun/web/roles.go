@@ -21,6 +21,7 @@ const (
 	canSilence
 	canManageTokens
 	canOverwriteUsername
+	canViewAudit
 )
 
 const (
@@ -43,6 +44,7 @@ var roleDefs = &roleMetadata{
 		{canSilence, "Silence", "Can add and manage silences"},
 		{canManageTokens, "Manage Tokens", "Can manage authorization tokens"},
 		{canOverwriteUsername, "Set Username", "Allows external services to set username in api requests"},
+		{canViewAudit, "View Audit", "Can view the audit log of api requests that change state"},
 	},
 	Roles: []bitDesc{
 		{roleReader, "Reader", "Read access to dashboard and alert data"},
@@ -66,16 +68,17 @@ func parseRole(s string) (easyauth.Role, error) {
 	perms := fullyOpen
 	for _, part := range parts {
 		this := fullyOpen
+		name := strings.Replace(strings.ToLower(part), " ", "", -1)
 		for _, perm := range roleDefs.Permissions {
 			pname := strings.Replace(strings.ToLower(perm.Name), " ", "", -1)
-			if strings.ToLower(part) == pname {
+			if name == pname {
 				this = perm.Bits
 				break
 			}
 		}
 		for _, perm := range roleDefs.Roles {
 			pname := strings.Replace(strings.ToLower(perm.Name), " ", "", -1)
-			if strings.ToLower(part) == pname {
+			if name == pname {
 				this = perm.Bits
 				break
 			}
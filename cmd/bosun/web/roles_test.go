@@ -27,6 +27,7 @@ func TestRoleParse(t *testing.T) {
 		{"Admin", roleAdmin, false},
 		{"ViewDashboard,PutData", canViewDash | canPutData, false},
 		{"ViewDashboard,Thisdoesnotexist", 0, true},
+		{"Actions,Create Annotations", canPerformActions | canCreateAnnotations, false},
 	}
 	for i, test := range tests {
 		found, err := parseRole(test.s)
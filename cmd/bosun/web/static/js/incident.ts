@@ -3,6 +3,9 @@ interface IIncidentScope extends ng.IScope {
 	incident: IncidentState;
 	events: any;
 	actions: any;
+	notifications: any;
+	dependsOn: any;
+	dependents: any;
 	body: any;
 	shown: any;
 	collapse: any;
@@ -53,6 +56,18 @@ bosunControllers.controller('IncidentCtrl', ['$scope', '$http', '$location', '$r
 				v.doneLoading = true;
 			});
 	};
+	// flatten the tree of alerts the incident depends on into rows indented by depth
+	var flattenDependencies = (nodes: any, depth: number, rows: any[]) => {
+		angular.forEach(nodes, (n: any) => {
+			var tags: string[] = [];
+			angular.forEach(n.Tags, (pk: string, k: string) => {
+				tags.push(k == pk ? k : k + '=' + pk);
+			});
+			rows.push({ Alert: n.Alert, Tags: tags.sort().join(','), Down: n.Down, Depth: depth });
+			flattenDependencies(n.DependsOn, depth + 1, rows);
+		});
+		return rows;
+	};
 	$scope.shown = {};
 	$scope.collapse = (i: any, v: any) => {
 		$scope.shown[i] = !$scope.shown[i];
@@ -65,6 +80,11 @@ bosunControllers.controller('IncidentCtrl', ['$scope', '$http', '$location', '$r
 			$scope.incident = data;
 			$scope.state = $scope.incident;
 			$scope.actions = data.Actions;
+			$scope.notifications = data.Notifications;
+			if (data.Dependencies) {
+				$scope.dependsOn = flattenDependencies(data.Dependencies.DependsOn, 0, []);
+				$scope.dependents = data.Dependencies.Dependents;
+			}
 			$scope.body = $sce.trustAsHtml(data.Body);
 			$scope.events = data.Events.reverse();
 			$scope.configLink = configUrl($scope.incident.AlertKey, moment.unix($scope.incident.LastAbnormalTime));
@@ -133,6 +133,7 @@ class IncidentState {
     NeedAck: boolean;
     Open: boolean;
     Unevaluated: boolean;
+    Flapping: boolean;
 
     CurrentStatus: string;
     WorstStatus: string;
@@ -165,6 +166,7 @@ class IncidentState {
         this.NeedAck = is.NeedAck;
         this.Open = is.Open;
         this.Unevaluated = is.Unevaluated;
+        this.Flapping = is.Flapping;
         this.CurrentStatus = is.CurrentStatus;
         this.WorstStatus = is.WorstStatus;
         this.LastAbnormalStatus = is.LastAbnormalStatus;
@@ -0,0 +1,36 @@
+/// <reference path="0-bosun.ts" />
+
+interface IShardsScope extends ng.IScope {
+	id: string;
+	workers: any[];
+	loading: boolean;
+	status: string;
+}
+
+bosunControllers.controller('ShardsCtrl', ['$scope', '$http', function($scope: IShardsScope, $http: ng.IHttpService) {
+	$scope.loading = true;
+	$http.get('/api/shards')
+		.success(function(data: any) {
+			$scope.id = data.Id;
+			// alerts without a worker are under the empty id
+			var alerts: { [worker: string]: string[] } = {};
+			_(data.Workers).forEach(function(worker: string) {
+				alerts[worker] = [];
+			});
+			_(data.Alerts).forEach(function(worker: string, alert: string) {
+				alerts[worker] = alerts[worker] || [];
+				alerts[worker].push(alert);
+			});
+			$scope.workers = [];
+			_(alerts).forEach(function(a: string[], worker: string) {
+				a.sort();
+				$scope.workers.push({ Id: worker, Alerts: a });
+			});
+		})
+		.error(function(error) {
+			$scope.status = 'Unable to fetch shards: ' + error;
+		})
+		.finally(function() {
+			$scope.loading = false;
+		});
+}]);
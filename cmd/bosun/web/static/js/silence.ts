@@ -20,6 +20,9 @@ interface ISilenceScope extends ng.IScope {
 	forget: string;
 	user: string;
 	message: string;
+	recurrence: string;
+	window: string;
+	timezone: string;
 }
 
 bosunControllers.controller('SilenceCtrl', ['$scope', '$http', '$location', '$route', function($scope: ISilenceScope, $http: ng.IHttpService, $location: ng.ILocationService, $route: ng.route.IRouteService) {
@@ -33,6 +36,9 @@ bosunControllers.controller('SilenceCtrl', ['$scope', '$http', '$location', '$ro
 	$scope.edit = search.edit;
 	$scope.forget = search.forget;
 	$scope.message = search.message;
+	$scope.recurrence = search.recurrence;
+	$scope.window = search.window;
+	$scope.timezone = search.timezone;
 	if (!$scope.end && !$scope.duration) {
 		$scope.duration = '1h';
 	}
@@ -43,8 +49,9 @@ bosunControllers.controller('SilenceCtrl', ['$scope', '$http', '$location', '$ro
 			if (limit && count >= limit){
 				return
 			}
-			var s = moment(v.Start).utc();
-			var e = moment(v.End).utc();
+			// recurring silences use their current or next window
+			var s = moment(v.NextStart || v.Start).utc();
+			var e = moment(v.NextEnd || v.End).utc();
 			if (startBefore && s > startBefore) {
 				return;
 			}
@@ -99,10 +106,13 @@ bosunControllers.controller('SilenceCtrl', ['$scope', '$http', '$location', '$ro
 			edit: $scope.edit,
 			forget: $scope.forget ? 'true' : null,
 			message: $scope.message,
+			recurrence: $scope.recurrence,
+			window: $scope.window,
+			timezone: $scope.timezone,
 		};
 		return data;
 	}
-	var any = search.start || search.end || search.duration || search.alert || search.hosts || search.tags || search.forget;
+	var any = search.start || search.end || search.duration || search.alert || search.hosts || search.tags || search.forget || search.recurrence;
 	var state = getData();
 	$scope.change = () => {
 		$scope.disableConfirm = true;
@@ -129,6 +139,9 @@ bosunControllers.controller('SilenceCtrl', ['$scope', '$http', '$location', '$ro
 		$location.search('tags', $scope.tags || null);
 		$location.search('forget', $scope.forget || null);
 		$location.search('message', $scope.message || null);
+		$location.search('recurrence', $scope.recurrence || null);
+		$location.search('window', $scope.window || null);
+		$location.search('timezone', $scope.timezone || null);
 		$route.reload();
 	};
 	$scope.confirm = () => {
@@ -119,6 +119,10 @@ func Listen(httpAddr, httpsAddr, certFile, keyFile string, devMode bool, tsdbHos
 	handleFunc := func(route string, h http.HandlerFunc, perms easyauth.Role) *mux.Route {
 		return handle(route, h, perms)
 	}
+	// for routes that change state, whose requests are recorded in the audit log
+	handleAudited := func(route string, h http.Handler, perms easyauth.Role) *mux.Route {
+		return handle(route, audited(h, perms), perms)
+	}
 
 	const (
 		GET  = http.MethodGet
@@ -131,26 +135,32 @@ func Listen(httpAddr, httpsAddr, certFile, keyFile string, devMode bool, tsdbHos
 	}
 	router.PathPrefix("/auth/").Handler(auth.LoginHandler())
 	handleFunc("/api/", APIRedirect, fullyOpen).Name("api_redir")
-	handle("/api/action", JSON(Action), canPerformActions).Name("action").Methods(POST)
+	handleAudited("/api/action", leaderOnly(JSON(Action)), scopedRole(canPerformActions)).Name("action").Methods(POST)
 	handle("/api/alerts", JSON(Alerts), canViewDash).Name("alerts").Methods(GET)
+	handle("/api/audit", JSON(Audit), canViewAudit).Name("audit").Methods(GET)
 	handle("/api/config", JSON(Config), canViewConfig).Name("get_config").Methods(GET)
 
 	handle("/api/config_test", JSON(ConfigTest), canViewConfig).Name("config_test").Methods(POST)
+	handle("/api/config/lint", JSON(ConfigLint), canViewConfig).Name("config_lint").Methods(GET, POST)
 	handle("/api/save_enabled", JSON(SaveEnabled), fullyOpen).Name("seve_enabled").Methods(GET)
 
 	if schedule.SystemConf.ReloadEnabled() {
-		handle("/api/reload", JSON(Reload), canSaveConfig).Name("can_save").Methods(POST)
+		handleAudited("/api/reload", leaderOnly(JSON(Reload)), canSaveConfig).Name("can_save").Methods(POST)
 	}
 
 	if schedule.SystemConf.SaveEnabled() {
-		handle("/api/config/bulkedit", JSON(BulkEdit), canSaveConfig).Name("bulk_edit").Methods(POST)
-		handle("/api/config/save", JSON(SaveConfig), canSaveConfig).Name("config_save").Methods(POST)
+		handleAudited("/api/config/bulkedit", leaderOnly(JSON(BulkEdit)), scopedRole(canSaveConfig)).Name("bulk_edit").Methods(POST)
+		handleAudited("/api/config/save", leaderOnly(JSON(SaveConfig)), canSaveConfig).Name("config_save").Methods(POST)
 		handle("/api/config/diff", JSON(DiffConfig), canSaveConfig).Name("config_diff").Methods(POST)
 		handle("/api/config/running_hash", JSON(ConfigRunningHash), canViewConfig).Name("config_hash").Methods(GET)
 	}
 
 	handle("/api/egraph/{bs}.{format:svg|png}", JSON(ExprGraph), canRunTests).Name("expr_graph")
-	handle("/api/errors", JSON(ErrorHistory), canViewDash).Name("errors").Methods(GET, POST)
+	handleAudited("/api/errors", leaderOnly(JSON(ErrorHistory)), canViewDash).Name("errors").Methods(GET, POST)
+	handle("/api/notifications/failed", JSON(FailedNotifications), canViewDash).Name("failed_notifications").Methods(GET)
+	handleAudited("/api/notifications/failed", leaderOnly(JSON(FailedNotifications)), canPerformActions).Name("failed_notifications_dismiss").Methods(POST)
+	handle("/api/cache", JSON(QueryCache), canViewConfig).Name("query_cache").Methods(GET)
+	handleAudited("/api/cache", JSON(QueryCacheFlush), canRunTests).Name("query_cache_flush").Methods(http.MethodDelete)
 	handle("/api/expr", JSON(Expr), canRunTests).Name("expr").Methods(POST)
 	handle("/api/graph", JSON(Graph), canViewDash).Name("graph").Methods(GET)
 
@@ -162,16 +172,18 @@ func Listen(httpAddr, httpsAddr, certFile, keyFile string, devMode bool, tsdbHos
 	handle("/api/incidents/events", JSON(IncidentEvents), canViewDash).Name("incident_events").Methods(GET)
 	handle("/api/metadata/get", JSON(GetMetadata), canViewDash).Name("meta_get").Methods(GET)
 	handle("/api/metadata/metrics", JSON(MetadataMetrics), canViewDash).Name("meta_metrics").Methods(GET)
-	handle("/api/metadata/put", JSON(PutMetadata), canPutData).Name("meta_put").Methods(POST)
-	handle("/api/metadata/delete", JSON(DeleteMetadata), canPutData).Name("meta_delete").Methods(http.MethodDelete)
+	handleAudited("/api/metadata/put", JSON(PutMetadata), canPutData).Name("meta_put").Methods(POST)
+	handleAudited("/api/metadata/delete", JSON(DeleteMetadata), canPutData).Name("meta_delete").Methods(http.MethodDelete)
 	handle("/api/metric", JSON(UniqueMetrics), canViewDash).Name("meta_uniqe_metrics").Methods(GET)
 	handle("/api/metric/{tagk}", JSON(MetricsByTagKey), canViewDash).Name("meta_metrics_by_tag").Methods(GET)
 	handle("/api/metric/{tagk}/{tagv}", JSON(MetricsByTagPair), canViewDash).Name("meta_metric_by_tag_pair").Methods(GET)
 	handle("/api/rule", JSON(Rule), canRunTests).Name("rule_test").Methods(POST)
+	handle("/api/rule/simulate", JSON(SimulateRule), canRunTests).Name("rule_simulate").Methods(POST)
+	handle("/api/shards", JSON(Shards), canViewDash).Name("shards").Methods(GET)
 	handle("/api/shorten", JSON(Shorten), canViewDash).Name("shorten")
-	handle("/api/silence/clear", JSON(SilenceClear), canSilence).Name("silence_clear")
+	handleAudited("/api/silence/clear", leaderOnly(JSON(SilenceClear)), scopedRole(canSilence)).Name("silence_clear").Methods(POST)
 	handle("/api/silence/get", JSON(SilenceGet), canViewDash).Name("silence_get").Methods(GET)
-	handle("/api/silence/set", JSON(SilenceSet), canSilence).Name("silence_set")
+	handleAudited("/api/silence/set", leaderOnly(JSON(SilenceSet)), scopedRole(canSilence)).Name("silence_set").Methods(POST)
 	handle("/api/status", JSON(Status), canViewDash).Name("status").Methods(GET)
 	handle("/api/tagk/{metric}", JSON(TagKeysByMetric), canViewDash).Name("search_tkeys_by_metric").Methods(GET)
 	handle("/api/tagv/{tagk}", JSON(TagValuesByTagKey), canViewDash).Name("search_tvals_by_metric").Methods(GET)
@@ -183,7 +195,9 @@ func Listen(httpAddr, httpsAddr, certFile, keyFile string, devMode bool, tsdbHos
 	// Annotations
 	if schedule.SystemConf.AnnotateEnabled() {
 		read := baseChain.Append(auth.Wrapper(canViewAnnotations)).ThenFunc
-		write := baseChain.Append(auth.Wrapper(canCreateAnnotations)).ThenFunc
+		write := baseChain.Append(auth.Wrapper(canCreateAnnotations), func(h http.Handler) http.Handler {
+			return audited(h, canCreateAnnotations)
+		}).ThenFunc
 		web.AddRoutesWithMiddleware(router, "/api", []backend.Backend{AnnotateBackend}, false, false, read, write)
 	}
 
@@ -192,7 +206,7 @@ func Listen(httpAddr, httpsAddr, certFile, keyFile string, devMode bool, tsdbHos
 		router.PathPrefix("/login").Handler(http.StripPrefix("/login", auth.LoginHandler())).Name("auth")
 	}
 	if tokens != nil {
-		handle("/api/tokens", tokens.AdminHandler(), canManageTokens).Name("tokens")
+		handleAudited("/api/tokens", tokens.AdminHandler(), canManageTokens).Name("tokens")
 	}
 
 	router.Handle("/api/version", baseChain.ThenFunc(Version)).Name("version").Methods(GET)
@@ -312,6 +326,7 @@ func IndexTSDB(w http.ResponseWriter, r *http.Request) {
 type appSetings struct {
 	SaveEnabled     bool
 	AnnotateEnabled bool
+	ShardsEnabled   bool
 	Quiet           bool
 	Version         opentsdb.Version
 
@@ -349,6 +364,7 @@ func Index(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interf
 	as := &appSetings{
 		SaveEnabled:     schedule.SystemConf.SaveEnabled(),
 		AnnotateEnabled: schedule.SystemConf.AnnotateEnabled(),
+		ShardsEnabled:   schedule.Shards != nil,
 		Quiet:           schedule.GetQuiet(),
 		Version:         openTSDBVersion,
 		AuthEnabled:     authEnabled,
@@ -403,6 +419,23 @@ func JSON(h func(miniprofiler.Timer, http.ResponseWriter, *http.Request) (interf
 	})
 }
 
+// leaderOnly refuses requests other than GET with 503 Service Unavailable unless this
+// instance is the leader, so that followers are read-only. The routes it wraps must
+// not change state on GET.
+func leaderOnly(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet || schedule.IsLeader() {
+			h.ServeHTTP(w, r)
+			return
+		}
+		msg := "this bosun is a follower"
+		if st, err := schedule.Election.Status(); err == nil && st.LeaderId != "" {
+			msg += ", the leader is " + st.LeaderId
+		}
+		http.Error(w, msg, http.StatusServiceUnavailable)
+	})
+}
+
 func Shorten(_ miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
 	u := url.URL{
 		Scheme: "https",
@@ -448,6 +481,10 @@ type Health struct {
 	Quiet         bool
 	UptimeSeconds int64
 	StartEpoch    int64
+	// Leader is true if this instance runs checks and sends notifications, which it always
+	// does without leader election.
+	Leader   bool
+	Election *sched.LeaderStatus `json:",omitempty"`
 }
 
 func Reload(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
@@ -479,6 +516,13 @@ func HealthCheck(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (
 	h.Quiet = schedule.GetQuiet()
 	h.UptimeSeconds = int64(time.Since(startTime).Seconds())
 	h.StartEpoch = startTime.Unix()
+	h.Leader = schedule.IsLeader()
+	if schedule.Election != nil {
+		var err error
+		if h.Election, err = schedule.Election.Status(); err != nil {
+			return nil, err
+		}
+	}
 	return h, nil
 }
 
@@ -606,6 +650,8 @@ type ExtStatus struct {
 	AlertName string
 	*models.IncidentState
 	*models.RenderedTemplates
+	Notifications []*models.NotificationRecord `json:",omitempty"`
+	Dependencies  *sched.AlertDependencies     `json:",omitempty"`
 }
 
 func IncidentEvents(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
@@ -625,7 +671,15 @@ func IncidentEvents(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request
 	if err != nil {
 		return nil, err
 	}
-	st := ExtStatus{IncidentState: state, RenderedTemplates: rt}
+	nots, err := schedule.DataAccess.State().GetNotificationRecords(state.Id)
+	if err != nil {
+		return nil, err
+	}
+	deps, err := schedule.GetAlertDependencies(state.AlertKey)
+	if err != nil {
+		return nil, err
+	}
+	st := ExtStatus{IncidentState: state, RenderedTemplates: rt, Notifications: nots, Dependencies: deps}
 	return st, nil
 }
 
@@ -734,12 +788,21 @@ func Action(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (inter
 		data.User = getUsername(r)
 	}
 
-	for _, key := range data.Keys {
+	keys := make([]models.AlertKey, len(data.Keys))
+	for i, key := range data.Keys {
 		ak, err := models.ParseAlertKey(key)
 		if err != nil {
 			return nil, err
 		}
-		err = schedule.ActionByAlertKey(data.User, data.Message, at, data.Time, ak)
+		keys[i] = ak
+	}
+	if err := checkActions(r, keys, data.Ids); err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return nil, nil
+	}
+	for i, key := range data.Keys {
+		ak := keys[i]
+		err := schedule.ActionByAlertKey(data.User, data.Message, at, data.Time, ak)
 		if err != nil {
 			errs[key] = err
 		} else {
@@ -772,12 +835,33 @@ func (m MultiError) Error() string {
 	return fmt.Sprint(map[string]error(m))
 }
 
+// silenceView is a silence with its current or next window. For recurring silences the
+// window is the next occurrence, otherwise it is from Start to End.
+type silenceView struct {
+	*models.Silence
+	NextStart *time.Time `json:",omitempty"`
+	NextEnd   *time.Time `json:",omitempty"`
+}
+
 func SilenceGet(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
-	endingAfter := time.Now().UTC().Unix()
+	now := time.Now().UTC()
+	endingAfter := now.Unix()
 	if t := r.FormValue("t"); t != "" {
 		endingAfter, _ = strconv.ParseInt(t, 10, 64)
 	}
-	return schedule.DataAccess.Silence().ListSilences(endingAfter)
+	silences, err := schedule.DataAccess.Silence().ListSilences(endingAfter)
+	if err != nil {
+		return nil, err
+	}
+	views := make(map[string]*silenceView, len(silences))
+	for id, si := range silences {
+		v := &silenceView{Silence: si}
+		if start, end, ok := si.Window(now); ok {
+			v.NextStart, v.NextEnd = &start, &end
+		}
+		views[id] = v
+	}
+	return views, nil
 }
 
 var silenceLayouts = []string{
@@ -838,11 +922,27 @@ func SilenceSet(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (i
 	} else if ok {
 		username = data["user"]
 	}
-	return schedule.AddSilence(start, end, data["alert"], data["tags"], data["forget"] == "true", len(data["confirm"]) > 0, data["edit"], username, data["message"])
+	if err := checkSilenceSet(r, data["alert"], data["tags"], data["edit"]); err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return nil, nil
+	}
+	var recurrence *models.Recurrence
+	if data["recurrence"] != "" {
+		recurrence = &models.Recurrence{
+			Cron:     data["recurrence"],
+			Window:   data["window"],
+			TimeZone: data["timezone"],
+		}
+	}
+	return schedule.AddSilence(start, end, data["alert"], data["tags"], data["forget"] == "true", len(data["confirm"]) > 0, data["edit"], username, data["message"], recurrence)
 }
 
 func SilenceClear(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
 	id := r.FormValue("id")
+	if err := checkSilenceIds(r, id); err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return nil, nil
+	}
 	return nil, schedule.ClearSilence(id)
 }
 
@@ -861,6 +961,31 @@ func ConfigTest(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (i
 	return nil, nil
 }
 
+// ConfigLint returns the lint warnings of the posted rule configuration, or of the
+// running one if none is posted.
+func ConfigLint(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	b, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	name, text := "test", string(b)
+	if len(b) == 0 {
+		name, text = schedule.SystemConf.GetRuleFilePath(), schedule.RuleConf.GetRawText()
+	}
+	c, err := rule.NewConf(name, schedule.SystemConf.EnabledBackends(), schedule.SystemConf.GetRuleVars(), text)
+	if err != nil {
+		return nil, err
+	}
+	warnings, err := c.Lint(schedule.Search)
+	if err != nil {
+		return nil, err
+	}
+	if warnings == nil {
+		warnings = []*rule.LintWarning{}
+	}
+	return warnings, nil
+}
+
 func Config(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
 	var text string
 	var err error
@@ -906,6 +1031,86 @@ func Version(w http.ResponseWriter, r *http.Request) {
 	io.WriteString(w, version.GetVersionInfo("bosun"))
 }
 
+// QueryCache returns the size, hit and miss counts by backend and entries of the query cache.
+func QueryCache(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	return schedule.QueryCache.Status(), nil
+}
+
+// QueryCacheFlush drops all results from the query cache.
+func QueryCacheFlush(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	return struct{ Flushed int }{schedule.QueryCache.Flush()}, nil
+}
+
+// FailedNotifications lists notification deliveries that failed, grouped by incident id. Notifications
+// not tied to a single incident are under id 0. A POST of a list of failed notification ids dismisses
+// them, which also stops any further retries.
+func FailedNotifications(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	if r.Method == "POST" {
+		ids := []int64{}
+		if err := json.NewDecoder(r.Body).Decode(&ids); err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			if err := schedule.DataAccess.Notifications().DeleteFailedNotification(id); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	}
+	fs, err := schedule.DataAccess.Notifications().GetFailedNotifications()
+	if err != nil {
+		return nil, err
+	}
+	type failure struct {
+		Id           int64
+		AlertKey     string
+		Notification string
+		Transport    string
+		Target       string
+		Subject      string
+		Attempts     int
+		LastError    string
+		FirstFailed  time.Time
+		LastAttempt  time.Time
+		NextAttempt  *time.Time `json:",omitempty"`
+		GaveUp       bool
+	}
+	m := make(map[int64][]*failure)
+	for _, f := range fs {
+		v := &failure{
+			Id:           f.Id,
+			AlertKey:     f.AlertKey,
+			Notification: f.Notification,
+			Transport:    f.Transport,
+			Target:       f.Target,
+			Subject:      f.Subject,
+			Attempts:     f.Attempts,
+			LastError:    f.LastError,
+			FirstFailed:  f.FirstFailed,
+			LastAttempt:  f.LastAttempt,
+			GaveUp:       f.GaveUp(),
+		}
+		if !f.GaveUp() {
+			next := f.NextAttempt
+			v.NextAttempt = &next
+		}
+		m[f.IncidentId] = append(m[f.IncidentId], v)
+	}
+	return m, nil
+}
+
+// Shards returns the workers that share the alerts and the worker of each alert.
+func Shards(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	if schedule.Shards == nil {
+		return nil, fmt.Errorf("sharding is not enabled")
+	}
+	var alerts []string
+	for name := range schedule.RuleConf.GetAlerts() {
+		alerts = append(alerts, name)
+	}
+	return schedule.Shards.Status(alerts), nil
+}
+
 func ErrorHistory(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
 	if r.Method == "GET" {
 		data, err := schedule.DataAccess.Errors().GetFullErrorHistory()
@@ -21,7 +21,7 @@ func TestErrorTemplate(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	_, err = procRule(nil, c, c.Alerts["a"], time.Time{}, false, "", "")
+	_, err = procRule(nil, c, c.Alerts["a"], time.Time{}, nil, false, "", "")
 	if err != nil {
 		t.Fatal(err)
 	}
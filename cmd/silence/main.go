@@ -20,8 +20,8 @@ var (
 	flagHost     = flag.String("h", "bosun", "Hostname of your bosun server, defaults to bosun.")
 	flagUser     = flag.String("u", "", "Username, defaults to the username returned from the OS.")
 	flagDuration = flag.String("d", "30m", "A duration to silence this host for. Defaults to 30m and the format is defined at http://golang.org/pkg/time/#ParseDuration")
-	flagTags     = flag.String("t", "", "OpenTSDB tags to be silenced in the format of tagKey=value,tagKey=value. Defaults to host=<hostname>, use -t= to use empty tag set.")
-	flagAlert    = flag.String("a", "", "Name of the alert to silence, defaults to empty which means all alerts.")
+	flagTags     = flag.String("t", "", "OpenTSDB tags to be silenced in the format of tagKey=value,tagKey=value. Values are globs. Use tagKey!=value to exclude a glob, tagKey=~regexp to match a regular expression and tagKey!~regexp to exclude one. Defaults to host=<hostname>, use -t= to use empty tag set.")
+	flagAlert    = flag.String("a", "", "Name of the alert to silence, a glob like disk.* or a regular expression prefixed with ~. Defaults to empty which means all alerts.")
 	flagMessage  = flag.String("m", "", "Reason for the silence, defaults to an empty string.")
 	flagForget   = flag.String("f", "", "Set to 'true' to forget anything that goes unknown during the silence. Used when decommissioning something.")
 )
@@ -0,0 +1,42 @@
+package models
+
+import "time"
+
+// AuditEvent is a request to the bosun api that changed, or tried to change, state.
+type AuditEvent struct {
+	Id          int64
+	Time        time.Time
+	User        string
+	Permissions string // the permissions of the user the route admitted the request for, i.e. "Actions" or "Scope:storage"
+	Method      string
+	Route       string // name of the route, i.e. "silence_set"
+	Path        string
+	Params      string // query string and request body, truncated
+	Status      int
+	Error       string `json:",omitempty"` // response body of failed requests, truncated
+}
+
+// AuditFilter selects audit events. Empty fields select all events.
+type AuditFilter struct {
+	Start, End time.Time
+	User       string
+	Route      string
+	Limit      int // of the most recent events
+}
+
+// Matches reports whether the event is selected by the filter, regardless of Limit.
+func (f *AuditFilter) Matches(e *AuditEvent) bool {
+	if !f.Start.IsZero() && e.Time.Before(f.Start) {
+		return false
+	}
+	if !f.End.IsZero() && e.Time.After(f.End) {
+		return false
+	}
+	if f.User != "" && e.User != f.User {
+		return false
+	}
+	if f.Route != "" && e.Route != f.Route {
+		return false
+	}
+	return true
+}
@@ -32,6 +32,9 @@ type IncidentState struct {
 	Open    bool
 
 	Unevaluated bool
+	// Flapping is true while the alert key changes state more often than the
+	// flapThreshold of its alert allows. No notifications are sent while flapping.
+	Flapping bool `json:",omitempty"`
 
 	CurrentStatus Status
 	WorstStatus   Status
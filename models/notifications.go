@@ -0,0 +1,45 @@
+package models
+
+import (
+	"time"
+)
+
+// FailedNotification is a notification delivery that failed. It holds everything
+// needed to retry the delivery through the same transport.
+type FailedNotification struct {
+	Id           int64
+	IncidentId   int64  // zero for notifications not tied to a single incident, i.e. unknown groups
+	AlertKey     string // the alert key, or the group name for notifications that cover several alerts
+	Notification string
+	Transport    string
+	Target       string
+
+	Attempts    int
+	LastError   string
+	FirstFailed time.Time
+	LastAttempt time.Time
+	// NextAttempt is zero once retries are exhausted.
+	NextAttempt time.Time
+
+	Subject      string
+	Body         string
+	EmailSubject []byte
+	EmailBody    []byte
+	Attachments  []*Attachment
+}
+
+// GaveUp returns true if there will be no further attempts to deliver the notification.
+func (f *FailedNotification) GaveUp() bool {
+	return f.NextAttempt.IsZero()
+}
+
+// NotificationRecord is an attempt to deliver a notification about an incident.
+type NotificationRecord struct {
+	Notification string
+	Transport    string
+	Target       string
+	Time         time.Time
+	Attempt      int // 1 for the first delivery, incremented for each retry
+	Success      bool
+	Error        string `json:",omitempty"` // the SMTP error or HTTP status of a failed attempt
+}
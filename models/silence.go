@@ -11,12 +11,51 @@ import (
 
 type Silence struct {
 	Start, End time.Time
-	Alert      string
+	Alert      string // alert name, glob or regular expression, see MatchAlert
 	Tags       opentsdb.TagSet
 	TagString  string
 	Forget     bool
 	User       string
 	Message    string
+	// Filters are the tag matchers with other operators than the globs of Tags.
+	Filters []TagFilter `json:",omitempty"`
+	// Recurrence limits the silence to repeating windows between Start and End.
+	Recurrence *Recurrence `json:",omitempty"`
+
+	compiled *silenceRegexps
+}
+
+// Recurrence is a maintenance window that repeats. A window of length Window starts at
+// every time matching the cron expression Cron, evaluated in TimeZone.
+type Recurrence struct {
+	Cron     string
+	Window   string // an OpenTSDB duration, i.e. "2h"
+	TimeZone string `json:",omitempty"` // IANA time zone name, i.e. "Europe/Berlin". UTC if empty.
+}
+
+func (r *Recurrence) parse() (*util.Cron, time.Duration, *time.Location, error) {
+	cron, err := util.ParseCron(r.Cron)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	d, err := opentsdb.ParseDuration(r.Window)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	if d <= 0 {
+		return nil, 0, nil, fmt.Errorf("recurrence window must be positive")
+	}
+	loc, err := time.LoadLocation(r.TimeZone)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	return cron, time.Duration(d), loc, nil
+}
+
+// Validate checks that the cron expression, window and time zone are valid.
+func (r *Recurrence) Validate() error {
+	_, _, _, err := r.parse()
+	return err
 }
 
 func (s *Silence) Silenced(now time.Time, alert string, tags opentsdb.TagSet) bool {
@@ -27,16 +66,50 @@ func (s *Silence) Silenced(now time.Time, alert string, tags opentsdb.TagSet) bo
 }
 
 func (s *Silence) ActiveAt(now time.Time) bool {
-	if now.Before(s.Start) || now.After(s.End) {
-		return false
+	start, _, ok := s.Window(now)
+	return ok && !now.Before(start)
+}
+
+// Window returns the start and end of the window that is active at now, or else of the
+// next window. ok is false if there are no more windows. For silences without a
+// Recurrence the only window is from Start to End.
+func (s *Silence) Window(now time.Time) (start, end time.Time, ok bool) {
+	if now.After(s.End) {
+		return
 	}
-	return true
+	if s.Recurrence == nil {
+		return s.Start, s.End, true
+	}
+	cron, window, loc, err := s.Recurrence.parse()
+	if err != nil {
+		return
+	}
+	// the earliest window that can still be active starts one window length ago
+	from := now.Add(-window)
+	if from.Before(s.Start) {
+		from = s.Start
+	}
+	start = cron.Next(from.In(loc))
+	if start.IsZero() || start.After(s.End) {
+		return
+	}
+	end = start.Add(window)
+	if end.After(s.End) {
+		end = s.End
+	}
+	return start.In(now.Location()), end.In(now.Location()), true
 }
 
 func (s *Silence) Matches(alert string, tags opentsdb.TagSet) bool {
-	if s.Alert != "" && s.Alert != alert {
+	re := s.regexps()
+	if !matchAlert(s.Alert, alert, re.alert) {
 		return false
 	}
+	for i, f := range s.Filters {
+		if !f.matches(tags, re.filters[i]) {
+			return false
+		}
+	}
 	for k, pattern := range s.Tags {
 		tagv, ok := tags[k]
 		if !ok {
@@ -53,5 +126,11 @@ func (s *Silence) Matches(alert string, tags opentsdb.TagSet) bool {
 func (s Silence) ID() string {
 	h := sha1.New()
 	fmt.Fprintf(h, "%s|%s|%s%s", s.Start, s.End, s.Alert, s.Tags)
+	if len(s.Filters) > 0 {
+		fmt.Fprintf(h, "|%v", s.Filters)
+	}
+	if r := s.Recurrence; r != nil {
+		fmt.Fprintf(h, "|%s|%s|%s", r.Cron, r.Window, r.TimeZone)
+	}
 	return fmt.Sprintf("%x", h.Sum(nil))
 }
@@ -0,0 +1,211 @@
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"bosun.org/opentsdb"
+	"bosun.org/util"
+)
+
+// TagFilter matches a tag of an alert key with an operator other than the glob
+// equality of Silence.Tags:
+//
+//	host!=db*    the tag value does not match the glob (or the tag is missing)
+//	host=~ny-.*  the tag value matches the regular expression
+//	host!~ny-.*  the tag value does not match the regular expression (or the tag is missing)
+//
+// Regular expressions must match the whole tag value.
+type TagFilter struct {
+	Key   string
+	Op    string
+	Value string
+}
+
+const (
+	opGlob      = "="
+	opNotGlob   = "!="
+	opRegex     = "=~"
+	opNotRegex  = "!~"
+	alertRegexp = "~"
+)
+
+// ordered so that the two character operators are found before "="
+var tagFilterOps = []string{opNotGlob, opRegex, opNotRegex, opGlob}
+
+var tagFilterStart = regexp.MustCompile(`^\s*[^=!~,\s]+\s*(!=|=~|!~|=)`)
+
+func (f TagFilter) String() string {
+	return f.Key + f.Op + f.Value
+}
+
+// Matches returns true if the tags satisfy the filter.
+func (f TagFilter) Matches(tags opentsdb.TagSet) bool {
+	var re *regexp.Regexp
+	if f.Op == opRegex || f.Op == opNotRegex {
+		re, _ = compileRegexp(f.Value)
+	}
+	return f.matches(tags, re)
+}
+
+// matches is Matches with the compiled regular expression of the filter, which is nil
+// for globs and invalid expressions.
+func (f TagFilter) matches(tags opentsdb.TagSet, re *regexp.Regexp) bool {
+	tagv, ok := tags[f.Key]
+	switch f.Op {
+	case opNotGlob:
+		if !ok {
+			return true
+		}
+		matched, _ := util.Match(f.Value, tagv)
+		return !matched
+	case opRegex:
+		return ok && re != nil && re.MatchString(tagv)
+	case opNotRegex:
+		return !ok || re == nil || !re.MatchString(tagv)
+	}
+	matched, _ := util.Match(f.Value, tagv)
+	return ok && matched
+}
+
+// ParseTagFilters parses a comma separated list of tag matchers like
+// "dc=ny,host!=db*,cluster=~web[0-9]+". Matches with = are returned as a TagSet of
+// globs, all others as filters. A comma only separates matchers if it is followed by
+// another matcher, so regular expressions may contain commas.
+func ParseTagFilters(s string) (opentsdb.TagSet, []TagFilter, error) {
+	tags := make(opentsdb.TagSet)
+	var filters []TagFilter
+	// join back the parts that are not the start of a new matcher
+	var parts []string
+	for _, p := range strings.Split(s, ",") {
+		if len(parts) > 0 && !tagFilterStart.MatchString(p) {
+			parts[len(parts)-1] += "," + p
+			continue
+		}
+		parts = append(parts, p)
+	}
+	for _, p := range parts {
+		var f TagFilter
+		for _, op := range tagFilterOps {
+			if i := strings.Index(p, op); i > 0 {
+				if j := strings.IndexAny(p, "=!~"); j < i {
+					// an earlier operator character, i.e. "=" in "a=b!=c"
+					continue
+				}
+				f = TagFilter{Key: strings.TrimSpace(p[:i]), Op: op, Value: strings.TrimSpace(p[i+len(op):])}
+				break
+			}
+		}
+		if f.Op == "" {
+			return nil, nil, fmt.Errorf("bad tag matcher: %s", p)
+		}
+		if !opentsdb.ValidTSDBString(f.Key) {
+			return nil, nil, fmt.Errorf("invalid character in tag key %s", f.Key)
+		}
+		if f.Value == "" {
+			return nil, nil, fmt.Errorf("empty value in tag matcher: %s", p)
+		}
+		switch f.Op {
+		case opGlob:
+			if _, present := tags[f.Key]; present {
+				return nil, nil, fmt.Errorf("duplicated tag: %s", f.Key)
+			}
+			tags[f.Key] = f.Value
+			continue
+		case opRegex, opNotRegex:
+			if _, err := compileRegexp(f.Value); err != nil {
+				return nil, nil, err
+			}
+		}
+		filters = append(filters, f)
+	}
+	sort.Slice(filters, func(i, j int) bool { return filters[i].String() < filters[j].String() })
+	return tags, filters, nil
+}
+
+// TagFilterString returns the canonical string of the tags and filters, which
+// ParseTagFilters parses back.
+func TagFilterString(tags opentsdb.TagSet, filters []TagFilter) string {
+	parts := make([]string, 0, len(filters)+1)
+	if len(tags) > 0 {
+		parts = append(parts, tags.Tags())
+	}
+	for _, f := range filters {
+		parts = append(parts, f.String())
+	}
+	return strings.Join(parts, ",")
+}
+
+// ValidateAlertPattern checks an alert pattern of a silence, see MatchAlert.
+func ValidateAlertPattern(pattern string) error {
+	if strings.HasPrefix(pattern, alertRegexp) {
+		_, err := compileRegexp(pattern[len(alertRegexp):])
+		return err
+	}
+	_, err := util.Match(pattern, "")
+	return err
+}
+
+// MatchAlert returns true if the alert name matches pattern. An empty pattern matches
+// all alerts. A pattern starting with ~ is a regular expression that must match the
+// whole name, a pattern with any of *?[| is a glob and anything else must be equal.
+func MatchAlert(pattern, alert string) bool {
+	var re *regexp.Regexp
+	if strings.HasPrefix(pattern, alertRegexp) {
+		re, _ = compileRegexp(pattern[len(alertRegexp):])
+	}
+	return matchAlert(pattern, alert, re)
+}
+
+// matchAlert is MatchAlert with the compiled regular expression of pattern, which is
+// nil for other patterns and invalid expressions.
+func matchAlert(pattern, alert string, re *regexp.Regexp) bool {
+	switch {
+	case pattern == "":
+		return true
+	case strings.HasPrefix(pattern, alertRegexp):
+		return re != nil && re.MatchString(alert)
+	case strings.ContainsAny(pattern, "*?[|"):
+		matched, _ := util.Match(pattern, alert)
+		return matched
+	}
+	return pattern == alert
+}
+
+func compileRegexp(expr string) (*regexp.Regexp, error) {
+	return regexp.Compile("^(?:" + expr + ")$")
+}
+
+// silenceRegexps are the compiled regular expressions of a silence, which is checked
+// for every alert key.
+type silenceRegexps struct {
+	alert   *regexp.Regexp
+	filters []*regexp.Regexp // by index in Filters
+}
+
+// Compile compiles the regular expressions of the silence for Matches. It must be
+// called before the silence is shared between goroutines, as the data store does for
+// the silences it returns. Matches of a silence that was not compiled compiles them on
+// each call.
+func (s *Silence) Compile() {
+	s.compiled = s.regexps()
+}
+
+// regexps returns the compiled regular expressions of the silence.
+func (s *Silence) regexps() *silenceRegexps {
+	if s.compiled != nil {
+		return s.compiled
+	}
+	c := &silenceRegexps{filters: make([]*regexp.Regexp, len(s.Filters))}
+	if strings.HasPrefix(s.Alert, alertRegexp) {
+		c.alert, _ = compileRegexp(s.Alert[len(alertRegexp):])
+	}
+	for i, f := range s.Filters {
+		if f.Op == opRegex || f.Op == opNotRegex {
+			c.filters[i], _ = compileRegexp(f.Value)
+		}
+	}
+	return c
+}
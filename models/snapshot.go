@@ -0,0 +1,17 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Snapshot holds the raw responses of the backend queries that fed an alert
+// evaluation, so that the alert can be evaluated again against the same data
+// after it has changed in the backends.
+type Snapshot struct {
+	Alert string
+	Time  time.Time // the time the alert was evaluated at
+	// Responses are the json encoded responses by backend and query, where the
+	// query is the key of the response in the expression cache.
+	Responses map[string]map[string]json.RawMessage
+}
@@ -0,0 +1,165 @@
+// Package prom defines structures for interacting with a Prometheus compatible
+// HTTP query API.
+package prom // import "bosun.org/prom"
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const requestErrFmt = "prom RequestError (%s): %s"
+
+// Request holds a range query. Expr is the PromQL expression to evaluate.
+// Only absolute times are supported.
+type Request struct {
+	Expr  string
+	Start time.Time
+	End   time.Time
+	Step  time.Duration
+	URL   *url.URL
+}
+
+// Response is the envelope returned by the /api/v1/query_range endpoint.
+type Response struct {
+	Status    string `json:"status"`
+	Data      Data   `json:"data"`
+	ErrorType string `json:"errorType,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// Data holds the result of a range query. ResultType is expected to be "matrix".
+type Data struct {
+	ResultType string   `json:"resultType"`
+	Result     []Series `json:"result"`
+}
+
+// Series is a single labeled time series in a matrix result.
+type Series struct {
+	Metric map[string]string `json:"metric"`
+	Values []DataPoint       `json:"values"`
+}
+
+// DataPoint is a [timestamp, "value"] pair as returned by Prometheus. The
+// timestamp is a float of unix seconds and the value is a quoted float.
+type DataPoint []json.RawMessage
+
+// Time returns the timestamp of the data point.
+func (d DataPoint) Time() (time.Time, error) {
+	if len(d) != 2 {
+		return time.Time{}, fmt.Errorf("data point has %d fields, expected 2", len(d))
+	}
+	f, err := strconv.ParseFloat(string(d[0]), 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("bad timestamp %s: %v", d[0], err)
+	}
+	sec := int64(f)
+	return time.Unix(sec, int64((f-float64(sec))*1e9)), nil
+}
+
+// Float returns the value of the data point. Prometheus encodes values as
+// strings so that NaN and Inf survive JSON encoding.
+func (d DataPoint) Float() (float64, error) {
+	if len(d) != 2 {
+		return 0, fmt.Errorf("data point has %d fields, expected 2", len(d))
+	}
+	var s string
+	if err := json.Unmarshal(d[1], &s); err != nil {
+		return 0, fmt.Errorf("bad value %s: %v", d[1], err)
+	}
+	return strconv.ParseFloat(s, 64)
+}
+
+// CacheKey returns a key that uniquely identifies the request.
+func (r *Request) CacheKey() string {
+	return fmt.Sprintf("prom-%d-%d-%d-%s", r.Start.Unix(), r.End.Unix(), int64(r.Step/time.Second), r.Expr)
+}
+
+// Query performs a range query against the given host. host specifies a
+// hostname with optional port, and may optionally begin with a scheme (http,
+// https) and a path prefix for servers that do not serve the API at the root.
+// header is the headers to send.
+func (r *Request) Query(host string, header http.Header) (Response, error) {
+	var resp Response
+	v := url.Values{
+		"query": []string{r.Expr},
+		"start": []string{fmt.Sprint(r.Start.Unix())},
+		"end":   []string{fmt.Sprint(r.End.Unix())},
+		"step":  []string{fmt.Sprint(int64(r.Step / time.Second))},
+	}
+	r.URL = &url.URL{
+		Scheme:   "http",
+		Host:     host,
+		Path:     "/api/v1/query_range",
+		RawQuery: v.Encode(),
+	}
+	if u, _ := url.Parse(host); u != nil && u.Scheme != "" && u.Host != "" {
+		r.URL.Scheme = u.Scheme
+		r.URL.Host = u.Host
+		r.URL.Path = strings.TrimSuffix(u.Path, "/") + r.URL.Path
+		r.URL.User = u.User
+	}
+	req, err := http.NewRequest("GET", r.URL.String(), nil)
+	if err != nil {
+		return resp, fmt.Errorf(requestErrFmt, r.URL, "NewRequest failed: "+err.Error())
+	}
+	if header != nil {
+		req.Header = header
+	}
+	res, err := DefaultClient.Do(req)
+	if err != nil {
+		return resp, fmt.Errorf(requestErrFmt, r.URL, "Get failed: "+err.Error())
+	}
+	defer res.Body.Close()
+	b, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return resp, fmt.Errorf(requestErrFmt, r.URL, "read failed: "+err.Error())
+	}
+	if err := json.Unmarshal(b, &resp); err != nil {
+		if res.StatusCode != http.StatusOK {
+			return resp, fmt.Errorf(requestErrFmt, r.URL, fmt.Sprintf("Get failed: %s\n%s", res.Status, strings.TrimSpace(string(b))))
+		}
+		return resp, fmt.Errorf(requestErrFmt, r.URL, "Json decode failed: "+err.Error())
+	}
+	if resp.Status != "success" {
+		return resp, fmt.Errorf(requestErrFmt, r.URL, fmt.Sprintf("%s: %s: %s", res.Status, resp.ErrorType, resp.Error))
+	}
+	if resp.Data.ResultType != "matrix" {
+		return resp, fmt.Errorf(requestErrFmt, r.URL, fmt.Sprintf("unexpected result type %q", resp.Data.ResultType))
+	}
+	return resp, nil
+}
+
+// DefaultClient is the default HTTP client for requests.
+var DefaultClient = &http.Client{
+	Timeout: time.Minute,
+}
+
+// Context is the interface for querying a Prometheus compatible server.
+type Context interface {
+	Query(*Request) (Response, error)
+}
+
+// Host is a simple Prometheus Context with no additional features.
+type Host string
+
+// Query performs a request to a Prometheus server.
+func (h Host) Query(r *Request) (Response, error) {
+	return r.Query(string(h), nil)
+}
+
+// HostHeader is a Prometheus Context that sends Header with each request.
+type HostHeader struct {
+	Host   string
+	Header http.Header
+}
+
+// Query performs a request to a Prometheus server.
+func (h HostHeader) Query(r *Request) (Response, error) {
+	return r.Query(h.Host, h.Header)
+}
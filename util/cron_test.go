@@ -0,0 +1,51 @@
+package util
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCronNext(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	// Wednesday
+	from := time.Date(2017, 3, 1, 10, 30, 15, 0, time.UTC)
+	tests := []struct {
+		spec string
+		from time.Time
+		next time.Time
+	}{
+		{"* * * * *", from, time.Date(2017, 3, 1, 10, 31, 0, 0, time.UTC)},
+		{"*/15 * * * *", from, time.Date(2017, 3, 1, 10, 45, 0, 0, time.UTC)},
+		{"0 2 * * sun", from, time.Date(2017, 3, 5, 2, 0, 0, 0, time.UTC)},
+		{"0 2 * * 7", from, time.Date(2017, 3, 5, 2, 0, 0, 0, time.UTC)},
+		{"30 10 1 * *", time.Date(2017, 3, 1, 10, 30, 0, 0, time.UTC), time.Date(2017, 3, 1, 10, 30, 0, 0, time.UTC)},
+		{"0 0 1,15 * mon", from, time.Date(2017, 3, 6, 0, 0, 0, 0, time.UTC)},
+		{"0 9-17/4 * * mon-fri", from, time.Date(2017, 3, 1, 13, 0, 0, 0, time.UTC)},
+		{"@monthly", from, time.Date(2017, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 feb *", from, time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"0 0 30 feb *", from, time.Time{}},
+		// 02:30 does not exist on 2017-03-12 in New York, the next match is a day later
+		{"30 2 * * *", time.Date(2017, 3, 12, 0, 0, 0, 0, ny), time.Date(2017, 3, 13, 2, 30, 0, 0, ny)},
+	}
+	for _, test := range tests {
+		c, err := ParseCron(test.spec)
+		if err != nil {
+			t.Errorf("%s: %v", test.spec, err)
+			continue
+		}
+		if next := c.Next(test.from); !next.Equal(test.next) {
+			t.Errorf("%s: expected %v, got %v", test.spec, test.next, next)
+		}
+	}
+}
+
+func TestParseCronErrors(t *testing.T) {
+	for _, spec := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "* * * * 8", "*/0 * * * *", "5-1 * * * *", "x * * * *"} {
+		if _, err := ParseCron(spec); err == nil {
+			t.Errorf("%q: expected an error", spec)
+		}
+	}
+}